@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/unbracketed/ccmgr-ultra/internal/analytics"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/storage"
+	"github.com/unbracketed/ccmgr-ultra/internal/storage/sqlite"
+)
+
+// openAnalyticsStorage opens the analytics database, returning nil when none
+// has been created yet; tests replace it
+var openAnalyticsStorage = func() (storage.Storage, error) {
+	path := storage.DefaultConfig().DatabasePath
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	return sqlite.NewDB(path)
+}
+
+var analyticsCmd = &cobra.Command{
+	Use:   "analytics",
+	Short: "Work with collected analytics data",
+	Long: `Work with the session and Claude Code usage events collected by
+ccmgr-ultra, for example to analyze them in a spreadsheet.`,
+}
+
+// Analytics export command
+var analyticsExportCmd = &cobra.Command{
+	Use:   "export [flags]",
+	Short: "Export analytics events to CSV",
+	Long: `Export stored analytics events to CSV, newest first.
+
+Columns are the event type, timestamp and session ID followed by the event
+data: project, worktree, branch and the fields specific to each event type.
+Data without a column of its own is written as JSON in the last column.
+Events are streamed from the database page by page, sized to stay within
+analytics.performance.max_memory_usage_mb.`,
+	Args: cobra.NoArgs,
+	RunE: runAnalyticsExportCommand,
+}
+
+// Analytics cleanup command
+var analyticsCleanupCmd = &cobra.Command{
+	Use:   "cleanup",
+	Short: "Remove analytics data past its retention period",
+	Long: `Remove session events older than analytics.retention.session_events_days
+and aggregated statistics older than analytics.retention.aggregated_data_days.
+A retention period of 0 keeps that data forever.
+
+This runs the same cleanup as the automatic background cleanup, on demand.
+With --dry-run, nothing is deleted and the number of rows that would be
+removed is reported.`,
+	Args: cobra.NoArgs,
+	RunE: runAnalyticsCleanupCommand,
+}
+
+var analyticsExportFlags struct {
+	out   string
+	since string
+}
+
+func init() {
+	analyticsExportCmd.Flags().StringVar(&analyticsExportFlags.out, "out", "-", "File to write the CSV to, or - for stdout")
+	analyticsExportCmd.Flags().StringVar(&analyticsExportFlags.since, "since", "", "Only export events newer than this age, e.g. 30d or 12h")
+
+	analyticsCmd.AddCommand(analyticsExportCmd)
+	analyticsCmd.AddCommand(analyticsCleanupCmd)
+	rootCmd.AddCommand(analyticsCmd)
+}
+
+func runAnalyticsExportCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	opts := analytics.ExportOptions{
+		PageSize: analytics.ExportPageSize(cfg.Analytics.Performance.MaxMemoryUsageMB),
+	}
+	if analyticsExportFlags.since != "" {
+		age, err := parseAge(analyticsExportFlags.since)
+		if err != nil {
+			return handleCLIError(cli.NewErrorWithSuggestion(
+				fmt.Sprintf("invalid --since value: %v", err),
+				"Use a number of days such as 30d, or a duration such as 12h",
+			))
+		}
+		opts.Since = time.Now().Add(-age)
+	}
+
+	store, err := openAnalyticsStorage()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to open analytics database", err))
+	}
+	var events storage.SessionEventRepository
+	if store != nil {
+		defer store.Close()
+		events = store.Events()
+	}
+
+	var out io.Writer = os.Stdout
+	status := os.Stderr
+	if analyticsExportFlags.out != "-" {
+		file, err := os.Create(analyticsExportFlags.out)
+		if err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to create export file", err))
+		}
+		defer file.Close()
+		out = file
+		status = os.Stdout
+	}
+
+	rows, err := analytics.ExportEventsCSV(context.Background(), events, out, opts)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to export analytics events", err))
+	}
+
+	if !isQuiet() {
+		if analyticsExportFlags.out == "-" {
+			fmt.Fprintf(status, "Exported %d events\n", rows)
+		} else {
+			fmt.Fprintf(status, "Exported %d events to %s\n", rows, analyticsExportFlags.out)
+		}
+	}
+
+	return nil
+}
+
+func runAnalyticsCleanupCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	store, err := openAnalyticsStorage()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to open analytics database", err))
+	}
+	if store == nil {
+		if !isQuiet() {
+			fmt.Println("No analytics data to clean up")
+		}
+		return nil
+	}
+	defer store.Close()
+
+	retention := cfg.Analytics.Retention
+	manager := analytics.NewRetentionManager(store, &analytics.RetentionConfig{
+		SessionEventsDays:  retention.SessionEventsDays,
+		AggregatedDataDays: retention.AggregatedDataDays,
+		CleanupInterval:    retention.CleanupInterval,
+		EnableAutoCleanup:  retention.EnableAutoCleanup,
+	})
+
+	result, err := manager.Cleanup(context.Background(), isDryRun())
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to clean up analytics data", err))
+	}
+
+	if !isQuiet() {
+		writeCleanupResult(os.Stdout, result)
+	}
+	return nil
+}
+
+// writeCleanupResult reports what a retention cleanup removed, or would
+// remove for a dry run
+func writeCleanupResult(w io.Writer, result *analytics.CleanupResult) {
+	verb := "Removed"
+	if result.DryRun {
+		verb = "Would remove"
+	}
+
+	if result.EventsCutoff.IsZero() {
+		fmt.Fprintln(w, "Session events are kept forever")
+	} else {
+		fmt.Fprintf(w, "%s %d session events older than %s\n",
+			verb, result.EventsRemoved, result.EventsCutoff.Format("2006-01-02"))
+	}
+
+	if result.AggregatesCutoff.IsZero() {
+		fmt.Fprintln(w, "Aggregated data is kept forever")
+	} else {
+		fmt.Fprintf(w, "%s %d aggregated rows older than %s\n",
+			verb, result.AggregatesRemoved, result.AggregatesCutoff.Format("2006-01-02"))
+	}
+}
+
+// parseAge parses an age given in days, such as "30d", or as a Go duration
+// such as "12h"
+func parseAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%q is not a number of days", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	age, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if age < 0 {
+		return 0, fmt.Errorf("%q is negative", value)
+	}
+	return age, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/analytics"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"30d", 30 * 24 * time.Hour},
+		{"0d", 0},
+		{"12h", 12 * time.Hour},
+		{"90m", 90 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		got, err := parseAge(tt.value)
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+
+	for _, value := range []string{"d", "-3d", "1.5d", "soon", "-1h"} {
+		_, err := parseAge(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestWriteCleanupResult(t *testing.T) {
+	cutoff := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	writeCleanupResult(&buf, &analytics.CleanupResult{
+		DryRun:        true,
+		EventsCutoff:  cutoff,
+		EventsRemoved: 12,
+	})
+	assert.Equal(t, "Would remove 12 session events older than 2025-03-03\nAggregated data is kept forever\n", buf.String())
+
+	buf.Reset()
+	writeCleanupResult(&buf, &analytics.CleanupResult{
+		EventsCutoff:      cutoff,
+		EventsRemoved:     12,
+		AggregatesCutoff:  cutoff.AddDate(-1, 0, 0),
+		AggregatesRemoved: 3,
+	})
+	assert.Equal(t, "Removed 12 session events older than 2025-03-03\nRemoved 3 aggregated rows older than 2024-03-03\n", buf.String())
+}
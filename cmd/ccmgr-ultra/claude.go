@@ -0,0 +1,549 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+)
+
+// ClaudeListData represents data for claude list output
+type ClaudeListData struct {
+	Processes []ClaudeProcessItem `json:"processes" yaml:"processes"`
+	Total     int                 `json:"total" yaml:"total"`
+	Timestamp time.Time           `json:"timestamp" yaml:"timestamp"`
+}
+
+// ClaudeProcessItem represents a single Claude Code process in list output
+type ClaudeProcessItem struct {
+	ID           string    `json:"id" yaml:"id"`
+	PID          int       `json:"pid" yaml:"pid"`
+	State        string    `json:"state" yaml:"state"`
+	StateChanged time.Time `json:"state_changed" yaml:"state_changed"`
+	Worktree     string    `json:"worktree" yaml:"worktree"`
+	TmuxSession  string    `json:"tmux_session" yaml:"tmux_session"`
+	Directory    string    `json:"directory" yaml:"directory"`
+	StartTime    time.Time `json:"start_time" yaml:"start_time"`
+	CPUPercent   float64   `json:"cpu_percent" yaml:"cpu_percent"`
+	MemoryMB     int64     `json:"memory_mb" yaml:"memory_mb"`
+}
+
+// claudeProcessController is the process management surface used by the
+// claude commands
+type claudeProcessController interface {
+	ListProcesses() ([]*claude.ProcessInfo, error)
+	FindProcess(id string) (*claude.ProcessInfo, error)
+	KillProcess(pid int, timeout time.Duration) (bool, error)
+	StopProcess(id string, timeout time.Duration) (*claude.ProcessInfo, error)
+	RestartProcess(id string, timeout time.Duration) (*claude.ProcessInfo, error)
+	InterruptProcess(pid int) error
+	WaitForExit(pid int, timeout time.Duration) bool
+}
+
+// newClaudeProcessController creates the controller used by the claude
+// commands; tests replace it with a mock
+var newClaudeProcessController = func(cfg *config.Config) (claudeProcessController, error) {
+	processConfig, err := claude.NewConfigAdapter(&cfg.Claude).ToProcessConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid claude configuration: %w", err)
+	}
+
+	manager, err := claude.NewProcessManager(processConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &processManagerController{manager: manager, tmux: tmux.NewTmuxCmd()}, nil
+}
+
+var claudeCmd = &cobra.Command{
+	Use:   "claude",
+	Short: "Manage Claude Code processes",
+	Long: `Manage Claude Code processes running in ccmgr-ultra sessions including:
+- List running processes with state, PID and worktree
+- Stop processes gracefully
+- Kill processes, interrupting them first
+- Restart processes in their tmux session`,
+}
+
+// Claude list command
+var claudeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Claude Code processes",
+	Long: `List running Claude Code processes including:
+- Process ID, PID and current state
+- When the state last changed
+- Associated worktree and tmux session
+- Uptime and working directory`,
+	RunE: runClaudeListCommand,
+}
+
+var claudeListFlags struct {
+	format   string
+	worktree string
+	state    string
+}
+
+// Claude stop command
+var claudeStopCmd = &cobra.Command{
+	Use:   "stop <id> [flags]",
+	Short: "Stop a Claude Code process",
+	Long: `Stop a Claude Code process by process ID, PID or tmux session name.
+Sends SIGTERM and falls back to SIGKILL if the process does not exit
+within the timeout.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClaudeStopCommand,
+}
+
+var claudeStopFlags struct {
+	force   bool
+	timeout int
+}
+
+// Claude kill command
+var claudeKillCmd = &cobra.Command{
+	Use:   "kill <pid|session> [flags]",
+	Short: "Kill a Claude Code process",
+	Long: `Kill a Claude Code process by PID, process ID or tmux session name.
+Sends SIGINT and falls back to SIGKILL if the process has not exited
+within the timeout (default: claude.startup_timeout).
+Only processes known to the process manager are killed; use --force to
+kill a PID that is not.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClaudeKillCommand,
+}
+
+var claudeKillFlags struct {
+	force   bool
+	timeout int
+}
+
+// Claude restart command
+var claudeRestartCmd = &cobra.Command{
+	Use:   "restart <id> [flags]",
+	Short: "Restart a Claude Code process",
+	Long: `Restart a Claude Code process by process ID, PID or tmux session name.
+Stops the process and relaunches the same command in its tmux session.
+Only processes running inside a tmux session can be restarted.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClaudeRestartCommand,
+}
+
+var claudeRestartFlags struct {
+	timeout int
+}
+
+func init() {
+	// List command flags
+	claudeListCmd.Flags().StringVarP(&claudeListFlags.format, "format", "f", "table", "Output format (table, json, yaml, compact)")
+	claudeListCmd.Flags().StringVarP(&claudeListFlags.worktree, "worktree", "w", "", "Filter by worktree name")
+	claudeListCmd.Flags().StringVarP(&claudeListFlags.state, "state", "s", "", "Filter by state (starting, idle, busy, waiting, error, stopped)")
+
+	// Stop command flags
+	claudeStopCmd.Flags().BoolVarP(&claudeStopFlags.force, "force", "f", false, "Skip confirmation prompts")
+	claudeStopCmd.Flags().IntVar(&claudeStopFlags.timeout, "timeout", 10, "Timeout for graceful shutdown (seconds)")
+
+	// Kill command flags
+	claudeKillCmd.Flags().BoolVarP(&claudeKillFlags.force, "force", "f", false, "Kill without confirmation, even a PID that is not a known Claude Code process")
+	claudeKillCmd.Flags().IntVar(&claudeKillFlags.timeout, "timeout", 0, "Seconds to wait after SIGINT before SIGKILL (default: claude.startup_timeout)")
+
+	// Restart command flags
+	claudeRestartCmd.Flags().IntVar(&claudeRestartFlags.timeout, "timeout", 10, "Timeout for graceful shutdown (seconds)")
+
+	// Add subcommands to claude command
+	claudeCmd.AddCommand(claudeListCmd)
+	claudeCmd.AddCommand(claudeStopCmd)
+	claudeCmd.AddCommand(claudeKillCmd)
+	claudeCmd.AddCommand(claudeRestartCmd)
+
+	// Add claude command to root
+	rootCmd.AddCommand(claudeCmd)
+}
+
+func runClaudeListCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner("Discovering Claude Code processes...")
+		spinner.Start()
+		defer spinner.Stop()
+	}
+
+	controller, err := newClaudeProcessController(cfg)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to initialize process manager", err))
+	}
+
+	processes, err := controller.ListProcesses()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list Claude Code processes", err))
+	}
+
+	listData := buildClaudeListData(processes, claudeListFlags.worktree, claudeListFlags.state)
+
+	if spinner != nil {
+		spinner.StopWithMessage(fmt.Sprintf("Found %d processes", listData.Total))
+	}
+
+	formatter, err := setupProcessOutputFormatter(claudeListFlags.format)
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	return formatter.Format(listData)
+}
+
+func runClaudeStopCommand(cmd *cobra.Command, args []string) error {
+	processID := args[0]
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	// Safety check - confirm termination
+	if !claudeStopFlags.force && !isDryRun() {
+		if err := checkConfirmable("stopping the process", forceConfirmSuggestion); err != nil {
+			return handleCLIError(err)
+		}
+		fmt.Printf("This will stop Claude Code process: %s\n", processID)
+		fmt.Printf("Proceed? [y/N]: ")
+		var response string
+		fmt.Scanln(&response)
+		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+			fmt.Println("Stop cancelled")
+			return nil
+		}
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would stop Claude Code process '%s'\n", processID)
+		return nil
+	}
+
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner(fmt.Sprintf("Stopping Claude Code process '%s'...", processID))
+		spinner.Start()
+		defer spinner.Stop()
+	}
+
+	controller, err := newClaudeProcessController(cfg)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to initialize process manager", err))
+	}
+
+	process, err := controller.StopProcess(processID, time.Duration(claudeStopFlags.timeout)*time.Second)
+	if err != nil {
+		return handleCLIError(newClaudeProcessError("failed to stop Claude Code process", err))
+	}
+
+	if spinner != nil {
+		spinner.StopWithMessage(fmt.Sprintf("Process '%s' stopped", processID))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Claude Code process %s (PID %d) stopped\n", process.SessionID, process.PID)
+	}
+
+	return nil
+}
+
+func runClaudeKillCommand(cmd *cobra.Command, args []string) error {
+	processID := args[0]
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	timeout := cfg.Claude.StartupTimeout
+	if claudeKillFlags.timeout > 0 {
+		timeout = time.Duration(claudeKillFlags.timeout) * time.Second
+	}
+	if timeout <= 0 {
+		timeout = claude.DefaultStopTimeout
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would kill Claude Code process '%s'\n", processID)
+		return nil
+	}
+
+	controller, err := newClaudeProcessController(cfg)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to initialize process manager", err))
+	}
+
+	process, err := resolveClaudeKillTarget(controller, processID, claudeKillFlags.force)
+	if err != nil {
+		cliErr := newClaudeProcessError("failed to kill Claude Code process", err)
+		if errors.Is(err, claude.ErrProcessNotFound) {
+			cliErr = cliErr.WithSuggestion("Run 'ccmgr-ultra claude list' to see running processes, or use --force to kill an untracked PID")
+		}
+		return handleCLIError(cliErr)
+	}
+
+	label := fmt.Sprintf("PID %d", process.PID)
+	if process.SessionID != "" {
+		label = fmt.Sprintf("Claude Code process %s (PID %d)", process.SessionID, process.PID)
+	}
+
+	// Safety check - confirm the kill
+	if !claudeKillFlags.force {
+		if err := checkConfirmable("killing the process", forceConfirmSuggestion); err != nil {
+			return handleCLIError(err)
+		}
+		fmt.Printf("This will kill %s\n", label)
+		fmt.Printf("Proceed? [y/N]: ")
+		var response string
+		fmt.Scanln(&response)
+		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+			fmt.Println("Kill cancelled")
+			return nil
+		}
+	}
+
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner(fmt.Sprintf("Interrupting process %d...", process.PID))
+		spinner.Start()
+		defer spinner.Stop()
+	}
+
+	forced, err := controller.KillProcess(process.PID, timeout)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("failed to kill process %d", process.PID), err))
+	}
+
+	if spinner != nil {
+		spinner.Stop()
+	}
+
+	if !isQuiet() {
+		if forced {
+			fmt.Printf("%s did not exit within %s and was killed\n", label, timeout)
+		} else {
+			fmt.Printf("%s exited after interrupt\n", label)
+		}
+	}
+
+	return nil
+}
+
+// resolveClaudeKillTarget finds the process to kill. A PID the process
+// manager does not know is only accepted with force.
+func resolveClaudeKillTarget(controller claudeProcessController, id string, force bool) (*claude.ProcessInfo, error) {
+	process, err := controller.FindProcess(id)
+	if err == nil || !force || !errors.Is(err, claude.ErrProcessNotFound) {
+		return process, err
+	}
+
+	pid, convErr := strconv.Atoi(id)
+	if convErr != nil || pid <= 0 {
+		return nil, err
+	}
+	return &claude.ProcessInfo{PID: pid}, nil
+}
+
+func runClaudeRestartCommand(cmd *cobra.Command, args []string) error {
+	processID := args[0]
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would restart Claude Code process '%s'\n", processID)
+		return nil
+	}
+
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner(fmt.Sprintf("Restarting Claude Code process '%s'...", processID))
+		spinner.Start()
+		defer spinner.Stop()
+	}
+
+	controller, err := newClaudeProcessController(cfg)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to initialize process manager", err))
+	}
+
+	process, err := controller.RestartProcess(processID, time.Duration(claudeRestartFlags.timeout)*time.Second)
+	if err != nil {
+		return handleCLIError(newClaudeProcessError("failed to restart Claude Code process", err))
+	}
+
+	if spinner != nil {
+		spinner.StopWithMessage(fmt.Sprintf("Process '%s' restarted", processID))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Claude Code restarted in session '%s'\n", process.TmuxSession)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+// setupProcessOutputFormatter creates an output formatter for process data
+func setupProcessOutputFormatter(format string) (cli.OutputFormatter, error) {
+	outputFormat, err := cli.ValidateFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.NewProcessFormatter(outputFormat, nil), nil
+}
+
+// buildClaudeListData converts processes to list output, applying the worktree
+// and state filters and sorting by start time
+func buildClaudeListData(processes []*claude.ProcessInfo, worktree, state string) *ClaudeListData {
+	listData := &ClaudeListData{
+		Processes: make([]ClaudeProcessItem, 0, len(processes)),
+		Timestamp: time.Now(),
+	}
+
+	for _, process := range processes {
+		item := newClaudeProcessItem(process)
+
+		if worktree != "" && item.Worktree != worktree {
+			continue
+		}
+		if state != "" && item.State != state {
+			continue
+		}
+
+		listData.Processes = append(listData.Processes, item)
+	}
+
+	sort.Slice(listData.Processes, func(i, j int) bool {
+		return listData.Processes[i].StartTime.Before(listData.Processes[j].StartTime)
+	})
+	listData.Total = len(listData.Processes)
+
+	return listData
+}
+
+// newClaudeProcessItem converts a process for list output
+func newClaudeProcessItem(process *claude.ProcessInfo) ClaudeProcessItem {
+	return ClaudeProcessItem{
+		ID:           process.SessionID,
+		PID:          process.PID,
+		State:        process.GetState().String(),
+		StateChanged: process.LastStateChange(),
+		Worktree:     process.WorktreeID,
+		TmuxSession:  process.TmuxSession,
+		Directory:    process.WorkingDir,
+		StartTime:    process.StartTime,
+		CPUPercent:   process.CPUPercent,
+		MemoryMB:     process.MemoryMB,
+	}
+}
+
+// newClaudeProcessError wraps a stop/restart failure, suggesting `claude list`
+// when the process could not be found
+func newClaudeProcessError(message string, err error) *cli.CLIError {
+	if errors.Is(err, claude.ErrProcessNotFound) {
+		return cli.NewErrorWithCause(message, err).
+			WithSuggestion("Run 'ccmgr-ultra claude list' to see running processes")
+	}
+	return cli.NewErrorWithCause(message, err)
+}
+
+// processManagerController implements claudeProcessController on top of a
+// claude.ProcessManager, relaunching restarted processes through tmux
+type processManagerController struct {
+	manager *claude.ProcessManager
+	tmux    tmux.TmuxInterface
+}
+
+func (c *processManagerController) ListProcesses() ([]*claude.ProcessInfo, error) {
+	processes := c.manager.GetAllProcesses()
+
+	discovered, err := c.manager.DiscoverProcesses()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool, len(processes))
+	for _, process := range processes {
+		seen[process.PID] = true
+	}
+	for _, process := range discovered {
+		if !seen[process.PID] {
+			processes = append(processes, process)
+		}
+	}
+
+	return processes, nil
+}
+
+func (c *processManagerController) StopProcess(id string, timeout time.Duration) (*claude.ProcessInfo, error) {
+	return c.manager.StopProcess(id, timeout)
+}
+
+func (c *processManagerController) FindProcess(id string) (*claude.ProcessInfo, error) {
+	return c.manager.FindProcess(id)
+}
+
+func (c *processManagerController) KillProcess(pid int, timeout time.Duration) (bool, error) {
+	return claude.KillProcess(pid, timeout)
+}
+
+func (c *processManagerController) InterruptProcess(pid int) error {
+	return claude.InterruptProcess(pid)
+}
+
+func (c *processManagerController) WaitForExit(pid int, timeout time.Duration) bool {
+	return claude.WaitForExit(pid, timeout)
+}
+
+// shellCommandLine joins args into a command line the shell splits back into
+// the same arguments
+func shellCommandLine(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = tmux.ShellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func (c *processManagerController) RestartProcess(id string, timeout time.Duration) (*claude.ProcessInfo, error) {
+	process, err := c.manager.FindProcess(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if process.TmuxSession == "" {
+		return nil, fmt.Errorf("process %s is not running in a tmux session and cannot be restarted", process.SessionID)
+	}
+	if len(process.Command) == 0 {
+		return nil, fmt.Errorf("command line for process %s is unknown", process.SessionID)
+	}
+
+	if _, err := c.manager.StopProcess(process.SessionID, timeout); err != nil {
+		return nil, err
+	}
+
+	if err := c.tmux.SendKeys(process.TmuxSession, shellCommandLine(process.Command)); err != nil {
+		return nil, fmt.Errorf("failed to relaunch in session %s: %w", process.TmuxSession, err)
+	}
+
+	return process, nil
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+type mockClaudeController struct {
+	processes   []*claude.ProcessInfo
+	stopped     []string
+	restarted   []string
+	lastTimeout time.Duration
+	err         error
+	// events records interrupts and waits in call order
+	events []string
+	// running lists PIDs that do not exit when waited for or interrupted
+	running map[int]bool
+	killed  []int
+}
+
+func (m *mockClaudeController) ListProcesses() ([]*claude.ProcessInfo, error) {
+	return m.processes, m.err
+}
+
+func (m *mockClaudeController) StopProcess(id string, timeout time.Duration) (*claude.ProcessInfo, error) {
+	m.stopped = append(m.stopped, id)
+	m.lastTimeout = timeout
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &claude.ProcessInfo{SessionID: id, PID: 4242}, nil
+}
+
+func (m *mockClaudeController) RestartProcess(id string, timeout time.Duration) (*claude.ProcessInfo, error) {
+	m.restarted = append(m.restarted, id)
+	m.lastTimeout = timeout
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &claude.ProcessInfo{SessionID: id, PID: 4242, TmuxSession: "ccmgr-app-main"}, nil
+}
+
+func (m *mockClaudeController) FindProcess(id string) (*claude.ProcessInfo, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	for _, process := range m.processes {
+		if process.SessionID == id || process.TmuxSession == id || strconv.Itoa(process.PID) == id {
+			return process, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", claude.ErrProcessNotFound, id)
+}
+
+func (m *mockClaudeController) KillProcess(pid int, timeout time.Duration) (bool, error) {
+	m.killed = append(m.killed, pid)
+	m.lastTimeout = timeout
+	return m.running[pid], nil
+}
+
+func (m *mockClaudeController) InterruptProcess(pid int) error {
+	m.events = append(m.events, fmt.Sprintf("interrupt %d", pid))
+	return m.err
+}
+
+func (m *mockClaudeController) WaitForExit(pid int, timeout time.Duration) bool {
+	m.events = append(m.events, fmt.Sprintf("wait %d", pid))
+	m.lastTimeout = timeout
+	return !m.running[pid]
+}
+
+// useMockClaudeController installs mock as the process controller and points
+// config loading at an empty config file for the duration of the test
+func useMockClaudeController(t *testing.T, mock *mockClaudeController) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("version: \"1.0.0\"\n"), 0600))
+
+	originalFactory := newClaudeProcessController
+	originalConfigPath, originalQuiet := configPath, quiet
+	t.Cleanup(func() {
+		newClaudeProcessController = originalFactory
+		configPath, quiet = originalConfigPath, originalQuiet
+	})
+
+	newClaudeProcessController = func(cfg *config.Config) (claudeProcessController, error) {
+		return mock, nil
+	}
+	configPath = path
+	quiet = true
+}
+
+func TestBuildClaudeListData(t *testing.T) {
+	now := time.Now()
+	processes := []*claude.ProcessInfo{
+		{SessionID: "claude-2", PID: 2, State: claude.StateBusy, WorktreeID: "feature", StartTime: now.Add(-time.Minute)},
+		{SessionID: "claude-1", PID: 1, State: claude.StateIdle, WorktreeID: "main", StartTime: now.Add(-time.Hour)},
+		{SessionID: "claude-3", PID: 3, State: claude.StateIdle, WorktreeID: "feature", StartTime: now},
+	}
+
+	tests := []struct {
+		name     string
+		worktree string
+		state    string
+		expected []string
+	}{
+		{"all processes sorted by start time", "", "", []string{"claude-1", "claude-2", "claude-3"}},
+		{"filter by worktree", "feature", "", []string{"claude-2", "claude-3"}},
+		{"filter by state", "", "idle", []string{"claude-1", "claude-3"}},
+		{"filter by worktree and state", "feature", "busy", []string{"claude-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := buildClaudeListData(processes, tt.worktree, tt.state)
+
+			ids := make([]string, 0, len(data.Processes))
+			for _, item := range data.Processes {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+			assert.Equal(t, len(tt.expected), data.Total)
+		})
+	}
+}
+
+func TestClaudeListData_JSONFormat(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	mock := &mockClaudeController{processes: []*claude.ProcessInfo{
+		{SessionID: "claude-1", PID: 101, State: claude.StateBusy, StateChanged: now.Add(-2 * time.Minute), WorktreeID: "main", TmuxSession: "ccmgr-app-main", StartTime: now.Add(-time.Hour)},
+		{SessionID: "claude-2", PID: 202, State: claude.StateWaiting, StateChanged: now.Add(-30 * time.Second), WorktreeID: "feature", TmuxSession: "ccmgr-app-feature", StartTime: now.Add(-time.Minute)},
+	}}
+
+	processes, err := mock.ListProcesses()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	formatter := cli.NewProcessFormatter(cli.FormatJSON, &buf)
+	require.NoError(t, formatter.Format(buildClaudeListData(processes, "", "")))
+
+	var decoded ClaudeListData
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(t, decoded.Processes, 2)
+	assert.Equal(t, 2, decoded.Total)
+
+	first, second := decoded.Processes[0], decoded.Processes[1]
+	assert.Equal(t, 101, first.PID)
+	assert.Equal(t, "busy", first.State)
+	assert.True(t, first.StateChanged.Equal(now.Add(-2*time.Minute)))
+	assert.Equal(t, "ccmgr-app-main", first.TmuxSession)
+	assert.Equal(t, "main", first.Worktree)
+	assert.Equal(t, "waiting", second.State)
+	assert.True(t, second.StateChanged.Equal(now.Add(-30*time.Second)))
+	assert.Contains(t, buf.String(), `"state_changed"`)
+}
+
+func TestClaudeListData_TableFormat(t *testing.T) {
+	processes := []*claude.ProcessInfo{
+		{
+			SessionID:   "claude-4242-1",
+			PID:         4242,
+			State:       claude.StateWaiting,
+			WorktreeID:  "feature-auth",
+			TmuxSession: "ccmgr-app-feature",
+			WorkingDir:  "/work/app/feature-auth",
+			StartTime:   time.Now().Add(-5 * time.Minute),
+		},
+	}
+
+	var buf bytes.Buffer
+	formatter := cli.NewProcessFormatter(cli.FormatTable, &buf)
+	require.NoError(t, formatter.Format(buildClaudeListData(processes, "", "")))
+
+	output := buf.String()
+	assert.Contains(t, output, "claude-4242-1")
+	assert.Contains(t, output, "4242")
+	assert.Contains(t, output, "waiting")
+	assert.Contains(t, output, "feature-auth")
+	assert.Contains(t, output, "ccmgr-app-feature")
+	assert.Contains(t, output, "Total processes: 1")
+}
+
+func TestRunClaudeStopCommand(t *testing.T) {
+	mock := &mockClaudeController{}
+	useMockClaudeController(t, mock)
+
+	originalFlags := claudeStopFlags
+	defer func() { claudeStopFlags = originalFlags }()
+	claudeStopFlags.force = true
+	claudeStopFlags.timeout = 3
+
+	require.NoError(t, runClaudeStopCommand(claudeStopCmd, []string{"claude-4242-1"}))
+	assert.Equal(t, []string{"claude-4242-1"}, mock.stopped)
+	assert.Equal(t, 3*time.Second, mock.lastTimeout)
+}
+
+func TestRunClaudeStopCommand_NotFound(t *testing.T) {
+	mock := &mockClaudeController{err: fmt.Errorf("%w: missing", claude.ErrProcessNotFound)}
+	useMockClaudeController(t, mock)
+
+	originalFlags := claudeStopFlags
+	defer func() { claudeStopFlags = originalFlags }()
+	claudeStopFlags.force = true
+
+	err := runClaudeStopCommand(claudeStopCmd, []string{"missing"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to stop Claude Code process")
+
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Contains(t, cliErr.Suggestion, "claude list")
+}
+
+func TestRunClaudeRestartCommand(t *testing.T) {
+	mock := &mockClaudeController{}
+	useMockClaudeController(t, mock)
+
+	originalFlags := claudeRestartFlags
+	defer func() { claudeRestartFlags = originalFlags }()
+	claudeRestartFlags.timeout = 5
+
+	require.NoError(t, runClaudeRestartCommand(claudeRestartCmd, []string{"ccmgr-app-main"}))
+	assert.Equal(t, []string{"ccmgr-app-main"}, mock.restarted)
+	assert.Equal(t, 5*time.Second, mock.lastTimeout)
+	assert.Empty(t, mock.stopped)
+}
+
+func TestShellCommandLine(t *testing.T) {
+	command := []string{"claude", "--prompt", "fix the user's login", "$HOME"}
+
+	assert.Equal(t, `'claude' '--prompt' 'fix the user'\''s login' '$HOME'`, shellCommandLine(command))
+}
+
+func TestRunClaudeKillCommand_Resolution(t *testing.T) {
+	processes := []*claude.ProcessInfo{
+		{SessionID: "claude-101-1", PID: 101, TmuxSession: "ccmgr-app-main"},
+		{SessionID: "claude-202-1", PID: 202, TmuxSession: "ccmgr-app-feature"},
+	}
+
+	tests := []struct {
+		name string
+		id   string
+		want int
+	}{
+		{"by PID", "202", 202},
+		{"by tmux session", "ccmgr-app-main", 101},
+		{"by process ID", "claude-202-1", 202},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockClaudeController{processes: processes}
+			useMockClaudeController(t, mock)
+
+			originalFlags := claudeKillFlags
+			defer func() { claudeKillFlags = originalFlags }()
+			claudeKillFlags.force = true
+
+			require.NoError(t, runClaudeKillCommand(claudeKillCmd, []string{tt.id}))
+			assert.Equal(t, []int{tt.want}, mock.killed)
+		})
+	}
+}
+
+func TestRunClaudeKillCommand_Untracked(t *testing.T) {
+	mock := &mockClaudeController{processes: []*claude.ProcessInfo{{SessionID: "claude-101-1", PID: 101}}}
+	useMockClaudeController(t, mock)
+
+	originalFlags := claudeKillFlags
+	defer func() { claudeKillFlags = originalFlags }()
+
+	err := runClaudeKillCommand(claudeKillCmd, []string{"999"})
+	require.Error(t, err)
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Contains(t, cliErr.Suggestion, "--force")
+	assert.Empty(t, mock.killed)
+
+	claudeKillFlags.force = true
+	require.NoError(t, runClaudeKillCommand(claudeKillCmd, []string{"999"}))
+	assert.Equal(t, []int{999}, mock.killed)
+
+	// --force only covers raw PIDs, not unknown session names
+	assert.Error(t, runClaudeKillCommand(claudeKillCmd, []string{"ccmgr-app-gone"}))
+	assert.Equal(t, []int{999}, mock.killed)
+}
+
+func TestRunClaudeKillCommand_RequiresConfirmation(t *testing.T) {
+	mock := &mockClaudeController{processes: []*claude.ProcessInfo{{SessionID: "claude-101-1", PID: 101}}}
+	useMockClaudeController(t, mock)
+	withStdinTerminal(t, false)
+
+	originalFlags := claudeKillFlags
+	defer func() { claudeKillFlags = originalFlags }()
+
+	err := runClaudeKillCommand(claudeKillCmd, []string{"101"})
+	require.Error(t, err)
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Contains(t, cliErr.Message, "cannot confirm killing the process")
+	assert.Contains(t, cliErr.Suggestion, "--force")
+	assert.Empty(t, mock.killed)
+
+	claudeKillFlags.force = true
+	require.NoError(t, runClaudeKillCommand(claudeKillCmd, []string{"101"}))
+	assert.Equal(t, []int{101}, mock.killed)
+}
+
+func TestRunClaudeKillCommand_Timeout(t *testing.T) {
+	mock := &mockClaudeController{
+		processes: []*claude.ProcessInfo{{SessionID: "claude-101-1", PID: 101}},
+		running:   map[int]bool{101: true},
+	}
+	useMockClaudeController(t, mock)
+
+	originalFlags := claudeKillFlags
+	defer func() { claudeKillFlags = originalFlags }()
+	claudeKillFlags.force = true
+
+	// Defaults to claude.startup_timeout
+	require.NoError(t, runClaudeKillCommand(claudeKillCmd, []string{"101"}))
+	assert.Equal(t, config.DefaultConfig().Claude.StartupTimeout, mock.lastTimeout)
+
+	claudeKillFlags.timeout = 3
+	require.NoError(t, runClaudeKillCommand(claudeKillCmd, []string{"101"}))
+	assert.Equal(t, 3*time.Second, mock.lastTimeout)
+}
+
+func TestClaudeCommandRegistered(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"claude", "restart"})
+	require.NoError(t, err)
+	assert.Equal(t, claudeRestartCmd, cmd)
+}
@@ -1,8 +1,15 @@
 package main
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/unbracketed/ccmgr-ultra/internal/cli"
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/logging"
 )
 
 // loadConfigWithOverrides loads configuration with command-line overrides
@@ -11,24 +18,84 @@ func loadConfigWithOverrides() (*config.Config, error) {
 	var err error
 
 	if configPath != "" {
-		// Load from custom path
-		cfg, err = config.LoadFromPath(configPath)
+		// Merge the custom config over the global and project-local ones
+		cwd, _ := os.Getwd()
+		cfg, err = config.LoadLayered(config.GetGlobalConfigPath(), config.FindProjectConfig(cwd), configPath)
 		if err != nil {
-			return nil, cli.NewErrorWithCause("failed to load custom config", err)
+			return nil, newConfigLoadError("failed to load custom config", err)
 		}
 	} else {
 		// Load from default locations
 		cfg, err = config.Load()
 		if err != nil {
-			return nil, cli.NewErrorWithCause("failed to load configuration", err)
+			return nil, newConfigLoadError("failed to load configuration", err)
+		}
+	}
+
+	// Overlay the selected profile, if any
+	if profile := config.ResolveProfileName(profileName); profile != "" {
+		if err := cfg.ApplyProfile(profile); err != nil {
+			return nil, cli.NewErrorWithCause("failed to apply config profile", err).
+				WithSuggestion("Check the profiles section of your config, or unset --profile/" + config.ProfileEnvVar).
+				WithExitCode(cli.ExitConfig)
 		}
 	}
 
-	// Apply any global flag overrides here if needed
-	// For now, just return the loaded config
+	if err := logging.SetLevel(cfg.LogLevel); err != nil {
+		return nil, cli.NewErrorWithCause("invalid log_level", err).WithExitCode(cli.ExitConfig)
+	}
+
 	return cfg, nil
 }
 
+// repositoryCache holds the repository detected by loadRepository, so
+// commands that need it more than once only shell out to git once
+var repositoryCache = git.NewRepositoryCache(git.NewGitCmd())
+
+// loadRepository returns the git repository containing the current directory
+// and the git command shared by all commands
+func loadRepository() (*git.Repository, git.GitInterface, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, nil, cli.NewErrorWithCause("failed to get current directory", err)
+	}
+
+	repo, err := repositoryCache.Detect(cwd)
+	if err != nil {
+		notInRepo := cli.ErrorNotInRepository()
+		notInRepo.Cause = err
+		return nil, nil, notInRepo
+	}
+
+	return repo, repositoryCache.GitCmd(), nil
+}
+
+// newConfigLoadError wraps a config load failure with a suggestion matching
+// the kind of failure
+func newConfigLoadError(message string, err error) *cli.CLIError {
+	var loadErr *config.LoadError
+	if !errors.As(err, &loadErr) {
+		return cli.NewErrorWithCause(message, err).WithExitCode(cli.ExitConfig)
+	}
+
+	switch loadErr.Kind {
+	case config.LoadErrorNotFound:
+		return cli.ErrorConfigNotFound(loadErr.Path)
+	case config.LoadErrorParse:
+		suggestion := fmt.Sprintf("Fix the YAML syntax in %s", loadErr.Path)
+		if loadErr.Line > 0 {
+			suggestion = fmt.Sprintf("Fix the YAML syntax near line %d of %s", loadErr.Line, loadErr.Path)
+		}
+		return cli.NewErrorWithCause(message, err).WithSuggestion(suggestion).WithExitCode(cli.ExitConfig)
+	case config.LoadErrorValidation:
+		return cli.NewErrorWithCause(message, err).
+			WithSuggestion(fmt.Sprintf("Correct the invalid setting in %s", loadErr.Path)).
+			WithExitCode(cli.ExitConfig)
+	default:
+		return cli.NewErrorWithCause(message, err).WithExitCode(cli.ExitConfig)
+	}
+}
+
 // handleCLIError processes errors in a consistent way for CLI commands
 func handleCLIError(err error) error {
 	if err == nil {
@@ -68,14 +135,54 @@ func setupSessionOutputFormatter(format string) (cli.OutputFormatter, error) {
 	return cli.NewSessionFormatter(outputFormat, nil), nil
 }
 
-// setupWorktreeOutputFormatter creates an output formatter specifically for worktree data
-func setupWorktreeOutputFormatter(format string) (cli.OutputFormatter, error) {
+// setupWorktreeOutputFormatter creates an output formatter specifically for
+// worktree data, writing to w (stdout when nil). When displayPath is set, the
+// table shows worktree paths rendered by it; structured formats always keep
+// the paths as they are.
+func setupWorktreeOutputFormatter(format string, w io.Writer, displayPath func(string) string) (cli.OutputFormatter, error) {
+	outputFormat, err := cli.ValidateFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	formatter := cli.NewWorktreeFormatter(outputFormat, w)
+	if tableFormatter, ok := formatter.(*cli.WorktreeTableFormatter); ok {
+		tableFormatter.SetColorEnabled(isColorEnabled())
+		if displayPath != nil {
+			tableFormatter.SetPathFormatter(displayPath)
+		}
+	}
+	return formatter, nil
+}
+
+// CleanupItem is a session or worktree reported by a cleanup command
+type CleanupItem struct {
+	Name   string `json:"name" yaml:"name"`
+	Path   string `json:"path,omitempty" yaml:"path,omitempty"`
+	Branch string `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// CleanupResult is the structured output of session clean and worktree
+// prune. In a dry run, Removed lists what would have been removed.
+type CleanupResult struct {
+	Removed []CleanupItem `json:"removed" yaml:"removed"`
+	Skipped []CleanupItem `json:"skipped" yaml:"skipped"`
+	DryRun  bool          `json:"dry_run" yaml:"dry_run"`
+}
+
+// setupCleanupOutputFormatter validates the --format flag of a cleanup
+// command. It returns nil for table, which keeps the human readable output.
+func setupCleanupOutputFormatter(format string) (cli.OutputFormatter, error) {
 	outputFormat, err := cli.ValidateFormat(format)
 	if err != nil {
 		return nil, err
 	}
+	if outputFormat == cli.FormatTable {
+		return nil, nil
+	}
 
-	return cli.NewWorktreeFormatter(outputFormat, nil), nil
+	return cli.NewFormatter(outputFormat, nil), nil
 }
 
 // validateWorktreeArg validates a worktree name argument
@@ -93,9 +200,36 @@ func validateBranchArg(name string) error {
 	return cli.ValidateBranchName(name)
 }
 
-// shouldShowProgress determines if progress indicators should be displayed
+// stdinIsTerminal reports whether stdin is a terminal; replaced in tests
+var stdinIsTerminal = func() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// forceConfirmSuggestion tells how to skip a confirmation prompt guarded by
+// --force
+const forceConfirmSuggestion = "Pass --force to proceed without confirmation"
+
+// checkConfirmable returns an error for a destructive action that would
+// prompt for confirmation when no answer can be read: with --non-interactive
+// or when stdin is not a terminal. --quiet does not imply consent.
+func checkConfirmable(action, suggestion string) error {
+	reason := ""
+	switch {
+	case nonInteractive:
+		reason = "running with --non-interactive"
+	case !stdinIsTerminal():
+		reason = "stdin is not a terminal"
+	default:
+		return nil
+	}
+	return cli.NewErrorWithSuggestion(fmt.Sprintf("cannot confirm %s: %s", action, reason), suggestion)
+}
+
+// shouldShowProgress determines if progress indicators should be displayed:
+// never with --quiet or --non-interactive, or when stdout is not a terminal
 func shouldShowProgress() bool {
-	return !quiet && cli.ShouldShowProgress()
+	return !quiet && !nonInteractive && cli.ShouldShowProgress()
 }
 
 // isVerbose returns true if verbose output is enabled
@@ -108,6 +242,12 @@ func isQuiet() bool {
 	return quiet
 }
 
+// isColorEnabled reports whether output may be styled, following --color
+// and NO_COLOR
+func isColorEnabled() bool {
+	return cli.ColorEnabled()
+}
+
 // isDryRun returns true if dry-run mode is enabled
 func isDryRun() bool {
 	return dryRun
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+)
+
+func TestLoadConfigWithOverrides_Profile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`version: "1.0.0"
+worktree:
+  directory_pattern: "{{.Project}}-{{.Branch}}"
+profiles:
+  work:
+    worktree:
+      directory_pattern: "work-{{.Branch}}"
+`), 0600))
+
+	originalConfigPath, originalProfile := configPath, profileName
+	defer func() { configPath, profileName = originalConfigPath, originalProfile }()
+	configPath = path
+
+	tests := []struct {
+		name            string
+		flag            string
+		env             string
+		expectedPattern string
+		wantErr         bool
+	}{
+		{name: "no profile", expectedPattern: "{{.Project}}-{{.Branch}}"},
+		{name: "--profile work", flag: "work", expectedPattern: "work-{{.Branch}}"},
+		{name: "CCMGR_PROFILE work", env: "work", expectedPattern: "work-{{.Branch}}"},
+		{name: "unknown profile", flag: "personal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(config.ProfileEnvVar, tt.env)
+			profileName = tt.flag
+
+			cfg, err := loadConfigWithOverrides()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPattern, cfg.Worktree.DirectoryPattern)
+		})
+	}
+}
+
+// countingGitCmd runs real git commands and counts repository detections
+type countingGitCmd struct {
+	*git.GitCmd
+	detections int
+}
+
+func (c *countingGitCmd) Execute(dir string, args ...string) (string, error) {
+	if len(args) == 2 && args[0] == "rev-parse" && args[1] == "--git-dir" {
+		c.detections++
+	}
+	return c.GitCmd.Execute(dir, args...)
+}
+
+func TestLoadRepository_Caches(t *testing.T) {
+	repoDir := setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(repoDir) })
+
+	gitCmd := &countingGitCmd{GitCmd: git.NewGitCmd()}
+	originalCache := repositoryCache
+	t.Cleanup(func() { repositoryCache = originalCache })
+	repositoryCache = git.NewRepositoryCache(gitCmd)
+
+	t.Chdir(repoDir)
+	first, sharedGit, err := loadRepository()
+	require.NoError(t, err)
+	assert.Same(t, gitCmd, sharedGit)
+
+	second, _, err := loadRepository()
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, gitCmd.detections, "repository is detected once")
+
+	t.Chdir(t.TempDir())
+	_, _, err = loadRepository()
+
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Equal(t, "not in a git repository", cliErr.Message)
+	assert.Contains(t, cliErr.Suggestion, "ccmgr-ultra init")
+	assert.Equal(t, 2, gitCmd.detections)
+}
+
+func TestShouldShowProgress_QuietAndNonInteractive(t *testing.T) {
+	t.Cleanup(func() { quiet, nonInteractive = false, false })
+
+	quiet, nonInteractive = true, false
+	assert.False(t, shouldShowProgress())
+
+	quiet, nonInteractive = false, true
+	assert.False(t, shouldShowProgress())
+}
+
+// withStdinTerminal makes stdin look like a terminal, or not, for the test
+func withStdinTerminal(t *testing.T, terminal bool) {
+	original := stdinIsTerminal
+	t.Cleanup(func() { stdinIsTerminal = original })
+	stdinIsTerminal = func() bool { return terminal }
+}
+
+func TestCheckConfirmable(t *testing.T) {
+	t.Cleanup(func() { quiet, nonInteractive = false, false })
+
+	tests := []struct {
+		name           string
+		terminal       bool
+		nonInteractive bool
+		quiet          bool
+		wantReason     string
+	}{
+		{name: "terminal", terminal: true},
+		{name: "quiet terminal", terminal: true, quiet: true},
+		{name: "stdin not a terminal", wantReason: "stdin is not a terminal"},
+		{name: "quiet does not imply consent", quiet: true, wantReason: "stdin is not a terminal"},
+		{name: "non-interactive", terminal: true, nonInteractive: true, wantReason: "running with --non-interactive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withStdinTerminal(t, tt.terminal)
+			quiet, nonInteractive = tt.quiet, tt.nonInteractive
+
+			err := checkConfirmable("deleting the worktree", forceConfirmSuggestion)
+			if tt.wantReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var cliErr *cli.CLIError
+			require.ErrorAs(t, err, &cliErr)
+			assert.Equal(t, "cannot confirm deleting the worktree: "+tt.wantReason, cliErr.Message)
+			assert.Contains(t, cliErr.Suggestion, "--force")
+		})
+	}
+}
+
+func TestConfirmPrompts_WithoutTerminal(t *testing.T) {
+	withStdinTerminal(t, false)
+
+	prompts := map[string]func() (bool, error){
+		"session kill": func() (bool, error) {
+			return confirmSessionKill(io.Discard, []*tmux.Session{{Name: "ccmgr-app-main"}})
+		},
+		"session clean": func() (bool, error) {
+			return confirmSessionClean(io.Discard, []sessionCleanDecision{{Session: &tmux.Session{Name: "ccmgr-app-main"}}})
+		},
+		"worktree prune": func() (bool, error) {
+			return confirmWorktreePrune(io.Discard, 2)
+		},
+		"protected branch": func() (bool, error) {
+			return confirmProtectedBranch(io.Discard, "main", "delete")
+		},
+	}
+
+	for name, prompt := range prompts {
+		t.Run(name, func(t *testing.T) {
+			done := make(chan error, 1)
+			go func() {
+				confirmed, err := prompt()
+				assert.False(t, confirmed)
+				done <- err
+			}()
+
+			select {
+			case err := <-done:
+				assert.ErrorContains(t, err, "cannot confirm")
+			case <-time.After(5 * time.Second):
+				t.Fatal("confirmation prompt waited for input instead of failing")
+			}
+		})
+	}
+}
@@ -101,6 +101,10 @@ func registerCompletionFunctions() {
 	sessionResumeCmd.ValidArgsFunction = completeSessionIDs
 	sessionKillCmd.ValidArgsFunction = completeSessionIDs
 
+	// Claude process completion
+	claudeListCmd.RegisterFlagCompletionFunc("worktree", completeWorktreeNames)
+	claudeListCmd.RegisterFlagCompletionFunc("format", completeOutputFormats)
+
 	// Status command completion
 	statusCmd.RegisterFlagCompletionFunc("worktree", completeWorktreeNames)
 	statusCmd.RegisterFlagCompletionFunc("format", completeOutputFormats)
@@ -211,9 +215,7 @@ func getWorktreeNames() ([]string, error) {
 		return nil, err
 	}
 
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
 		return nil, err
 	}
@@ -239,9 +241,7 @@ func getWorktreeBranches() ([]string, error) {
 		return nil, err
 	}
 
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
 		return nil, err
 	}
@@ -264,9 +264,7 @@ func getWorktreeBranches() ([]string, error) {
 
 // getBranches returns a list of all git branches
 func getBranches() ([]string, error) {
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
 		return nil, err
 	}
@@ -391,9 +389,7 @@ func completeWorktreeNamesWithStatus(status string) func(*cobra.Command, []strin
 			return nil, cobra.ShellCompDirectiveError
 		}
 
-		gitCmd := git.NewGitCmd()
-		repoManager := git.NewRepositoryManager(gitCmd)
-		repo, err := repoManager.DetectRepository(".")
+		repo, gitCmd, err := loadRepository()
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveError
 		}
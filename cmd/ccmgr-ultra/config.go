@@ -0,0 +1,230 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect, change and validate configuration",
+	Long:  `Commands for inspecting, changing and validating the ccmgr-ultra configuration file.`,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the configuration file",
+	Long: `Load the configuration file and validate every section, including the
+worktree directory patterns. All failing sections are reported with their
+config key.
+
+Uses the file given by --config, or the global config file otherwise. Exits
+with a non-zero status when the configuration is invalid, so it can be used
+in pre-commit hooks.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigValidateCommand,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a configuration value",
+	Long: `Print the value of a configuration setting addressed by its dotted key,
+for example git.default_remote or tmux.monitor_interval. Naming a section
+such as worktree prints the whole section.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigGetCommand,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a configuration value",
+	Long: `Set a configuration setting addressed by its dotted key and save the file.
+
+Values are parsed according to the setting's type: durations use Go syntax
+(30s, 5m), booleans are true or false, and lists such as
+git.protected_branches are comma separated. The updated configuration is
+validated before it is saved.`,
+	Example: `  ccmgr-ultra config set git.default_remote upstream
+  ccmgr-ultra config set tmux.monitor_interval 5s
+  ccmgr-ultra config set git.protected_branches main,release`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSetCommand,
+}
+
+func init() {
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+
+	rootCmd.AddCommand(configCmd)
+}
+
+func runConfigValidateCommand(cmd *cobra.Command, args []string) error {
+	path := configPath
+	if path == "" {
+		path = config.GetGlobalConfigPath()
+	}
+
+	issues, err := validateConfigFile(path, config.ResolveProfileName(profileName))
+	if err != nil {
+		return handleCLIError(newConfigLoadError("failed to load configuration", err))
+	}
+
+	if len(issues) > 0 {
+		writeConfigIssues(os.Stderr, issues)
+		return handleCLIError(cli.NewError(fmt.Sprintf("configuration %s has %d invalid section(s)", path, len(issues))).
+			WithSuggestion("Correct the settings listed above").
+			WithExitCode(cli.ExitConfig))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Configuration OK: %s\n", path)
+	}
+
+	return nil
+}
+
+// validateConfigFile reads the configuration at path and returns the
+// validation issues of every section. When profile is set and the base
+// configuration is valid, the profile is applied and checked as well.
+func validateConfigFile(path, profile string) ([]config.ValidationIssue, error) {
+	cfg, err := config.ReadConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := cfg.ValidationIssues()
+	if len(issues) == 0 && profile != "" {
+		if err := cfg.ApplyProfile(profile); err != nil {
+			issues = append(issues, config.ValidationIssue{Field: "profiles." + profile, Err: err})
+		}
+	}
+
+	return issues, nil
+}
+
+// writeConfigIssues prints one line per validation issue
+func writeConfigIssues(w io.Writer, issues []config.ValidationIssue) {
+	for _, issue := range issues {
+		fmt.Fprintf(w, "✗ %s: %v\n", issue.Field, issue.Err)
+	}
+}
+
+func runConfigGetCommand(cmd *cobra.Command, args []string) error {
+	cfg, _, err := readEditableConfig()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	value, err := cfg.GetValue(args[0])
+	if err != nil {
+		return handleCLIError(newConfigKeyError(args[0], err))
+	}
+
+	formatted, err := formatConfigValue(value)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to format config value", err))
+	}
+
+	fmt.Println(formatted)
+	return nil
+}
+
+func runConfigSetCommand(cmd *cobra.Command, args []string) error {
+	key, value := args[0], args[1]
+
+	cfg, path, err := readEditableConfig()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	if err := cfg.SetValue(key, value); err != nil {
+		return handleCLIError(newConfigKeyError(key, err))
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("invalid value for %s", key), err).
+			WithSuggestion("Run 'ccmgr-ultra config validate' to check the rest of the configuration").
+			WithExitCode(cli.ExitConfig))
+	}
+
+	if isDryRun() {
+		fmt.Printf("Would set %s = %s in %s\n", key, value, path)
+		return nil
+	}
+
+	if err := config.Save(cfg, path); err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to save configuration", err))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Set %s = %s\n", key, value)
+	}
+
+	return nil
+}
+
+// readEditableConfig reads the file selected by --config, or the global
+// config file, without applying profiles. A missing file yields the defaults.
+func readEditableConfig() (*config.Config, string, error) {
+	path := configPath
+	if path == "" {
+		path = config.GetGlobalConfigPath()
+	}
+
+	cfg, err := config.ReadConfigFile(path)
+	if config.IsNotFoundError(err) {
+		return config.DefaultConfig(), path, nil
+	}
+	if err != nil {
+		return nil, path, newConfigLoadError("failed to load configuration", err)
+	}
+
+	return cfg, path, nil
+}
+
+// newConfigKeyError wraps a get/set failure, suggesting similar keys when the
+// key is unknown
+func newConfigKeyError(key string, err error) *cli.CLIError {
+	var unknown *config.UnknownKeyError
+	if errors.As(err, &unknown) {
+		suggestion := "Check the key against the configuration reference"
+		if len(unknown.Suggestions) > 0 {
+			suggestion = "Did you mean: " + strings.Join(unknown.Suggestions, ", ")
+		}
+		return cli.NewError(fmt.Sprintf("unknown config key '%s'", key)).
+			WithSuggestion(suggestion).
+			WithExitCode(cli.ExitConfig)
+	}
+
+	return cli.NewErrorWithCause(fmt.Sprintf("failed to access config key '%s'", key), err).
+		WithExitCode(cli.ExitConfig)
+}
+
+// formatConfigValue renders a config value for display: scalars as plain
+// text, lists comma separated and sections as YAML
+func formatConfigValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v.String(), nil
+	case []string:
+		return strings.Join(v, ","), nil
+	case string, bool, int, int64, float64:
+		return fmt.Sprint(v), nil
+	}
+
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\n"), nil
+}
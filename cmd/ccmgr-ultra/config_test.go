@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// writeTestConfig writes content to a config file and points --config at it
+// for the duration of the test
+func writeTestConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	originalConfigPath, originalQuiet, originalProfile := configPath, quiet, profileName
+	t.Cleanup(func() { configPath, quiet, profileName = originalConfigPath, originalQuiet, originalProfile })
+	configPath, quiet, profileName = path, true, ""
+	return path
+}
+
+func TestRunConfigValidateCommand_Valid(t *testing.T) {
+	writeTestConfig(t, `version: "2.0.0"
+worktree:
+  directory_pattern: "{{.Project}}-{{.Branch}}"
+`)
+
+	assert.NoError(t, runConfigValidateCommand(configValidateCmd, nil))
+}
+
+func TestRunConfigValidateCommand_Invalid(t *testing.T) {
+	path := writeTestConfig(t, `version: "2.0.0"
+worktree:
+  directory_pattern: "work/{{.Branch}}"
+git:
+  directory_pattern: "{{.Project}}-{{.Bogus}}"
+`)
+
+	err := runConfigValidateCommand(configValidateCmd, nil)
+	require.Error(t, err)
+
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Equal(t, cli.ExitConfig, cliErr.ExitCode)
+	assert.Contains(t, cliErr.Message, path)
+
+	issues, err := validateConfigFile(path, "")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	writeConfigIssues(&buf, issues)
+	output := buf.String()
+	assert.Contains(t, output, "worktree: invalid worktree.directory_pattern")
+	assert.Contains(t, output, "git: invalid git.directory_pattern")
+	assert.Contains(t, output, "unknown template variable: {{.Bogus}}")
+}
+
+func TestRunConfigValidateCommand_MissingFile(t *testing.T) {
+	writeTestConfig(t, "")
+	configPath = filepath.Join(t.TempDir(), "missing.yaml")
+
+	err := runConfigValidateCommand(configValidateCmd, nil)
+	require.Error(t, err)
+
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Equal(t, cli.ExitConfig, cliErr.ExitCode)
+}
+
+func TestValidateConfigFile_Profile(t *testing.T) {
+	path := writeTestConfig(t, `version: "2.0.0"
+profiles:
+  broken:
+    worktree:
+      directory_pattern: "{{.Nope}}"
+`)
+
+	issues, err := validateConfigFile(path, "")
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	issues, err = validateConfigFile(path, "broken")
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "profiles.broken", issues[0].Field)
+}
+
+func TestRunConfigSetCommand(t *testing.T) {
+	path := writeTestConfig(t, "version: \"2.0.0\"\n")
+
+	require.NoError(t, runConfigSetCommand(configSetCmd, []string{"tmux.monitor_interval", "7s"}))
+	require.NoError(t, runConfigSetCommand(configSetCmd, []string{"git.protected_branches", "main,release"}))
+
+	cfg, err := config.LoadFromPath(path)
+	require.NoError(t, err)
+	assert.Equal(t, 7*time.Second, cfg.Tmux.MonitorInterval)
+	assert.Equal(t, []string{"main", "release"}, cfg.Git.ProtectedBranches)
+	assert.False(t, cfg.LastModified.IsZero())
+}
+
+func TestRunConfigSetCommand_Errors(t *testing.T) {
+	path := writeTestConfig(t, "version: \"2.0.0\"\n")
+	original, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	err = runConfigSetCommand(configSetCmd, []string{"git.default_remot", "upstream"})
+	require.Error(t, err)
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Contains(t, cliErr.Suggestion, "git.default_remote")
+
+	// Values that fail validation are not saved
+	err = runConfigSetCommand(configSetCmd, []string{"git.max_worktrees", "-1"})
+	require.Error(t, err)
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(original), string(current))
+}
+
+func TestFormatConfigValue(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected string
+	}{
+		{"origin", "origin"},
+		{true, "true"},
+		{90 * time.Second, "1m30s"},
+		{[]string{"main", "develop"}, "main,develop"},
+		{config.HookConfig{Script: "idle.sh"}, "enabled: false\nscript: idle.sh\ntimeout: 0\nasync: false"},
+	}
+
+	for _, tt := range tests {
+		formatted, err := formatConfigValue(tt.value)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, formatted)
+	}
+}
+
+func TestConfigDirFlag(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	dir := t.TempDir()
+
+	originalConfigPath, originalConfigDir := configPath, configDir
+	t.Cleanup(func() {
+		configPath, configDir = originalConfigPath, originalConfigDir
+		config.SetConfigDir("")
+		rootCmd.SetArgs(nil)
+	})
+	configPath = ""
+
+	rootCmd.SetArgs([]string{"--config-dir", dir, "config", "set", "tui.theme", "dark"})
+	require.NoError(t, rootCmd.Execute())
+
+	assert.Equal(t, dir, config.ConfigDir())
+	cfg, err := config.LoadFromPath(filepath.Join(dir, config.ConfigFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "dark", cfg.TUI.Theme)
+}
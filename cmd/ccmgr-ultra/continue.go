@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/unbracketed/ccmgr-ultra/internal/cli"
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
-	"github.com/unbracketed/ccmgr-ultra/internal/git"
 	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
 )
 
@@ -186,17 +185,11 @@ func detectWorktreeInfo(path string, cfg *config.Config) (*WorktreeInfo, error)
 		return nil, cli.NewErrorWithCause("failed to resolve path", err)
 	}
 
-	// Create git repository manager
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-
 	// Detect repository
-	repo, err := repoManager.DetectRepository(absPath)
+	gitCmd := repositoryCache.GitCmd()
+	repo, err := repositoryCache.Detect(absPath)
 	if err != nil {
-		return nil, cli.NewErrorWithSuggestion(
-			"not in a git repository",
-			"Run this command from within a git repository or use 'ccmgr-ultra init' to create one",
-		)
+		return nil, cli.ErrorNotInRepository()
 	}
 
 	// Get current branch
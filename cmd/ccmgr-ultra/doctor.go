@@ -0,0 +1,308 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that the environment is set up for ccmgr-ultra",
+	Long: `Check the tools and configuration ccmgr-ultra depends on and print a
+checklist with a suggested fix for every problem found.
+
+The following are checked:
+- git is installed and the current directory is a git repository
+- tmux is installed and the configured tmux prefix is a valid session name
+- the configured Claude command can be found
+- configured hook scripts exist and are executable
+- the GitHub token is accepted, when one is configured
+
+Exits non-zero when any check fails.`,
+	Args: cobra.NoArgs,
+	RunE: runDoctorCommand,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+// doctorStatus is the outcome of a single doctor check
+type doctorStatus string
+
+const (
+	doctorPass doctorStatus = "pass"
+	doctorWarn doctorStatus = "warn"
+	doctorFail doctorStatus = "fail"
+)
+
+// doctorCheck is one line of the doctor checklist
+type doctorCheck struct {
+	Name       string
+	Status     doctorStatus
+	Detail     string
+	Suggestion string
+}
+
+// doctorEnv is the part of the environment the doctor probes, replaced in
+// tests
+type doctorEnv struct {
+	lookPath       func(file string) (string, error)
+	detectRepo     func() error
+	stat           func(name string) (os.FileInfo, error)
+	validateGitHub func(cfg *config.GitConfig) error
+}
+
+// newDoctorEnv returns a doctorEnv backed by the real system
+func newDoctorEnv() doctorEnv {
+	return doctorEnv{
+		lookPath: exec.LookPath,
+		detectRepo: func() error {
+			_, _, err := loadRepository()
+			return err
+		},
+		stat: os.Stat,
+		validateGitHub: func(cfg *config.GitConfig) error {
+			return git.NewRemoteManager(nil, cfg, nil).ValidateAuthentication("github")
+		},
+	}
+}
+
+func runDoctorCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	checks := runDoctorChecks(cfg, newDoctorEnv())
+	writeDoctorChecks(os.Stdout, checks)
+
+	if failed := countDoctorFailures(checks); failed > 0 {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("%d doctor check(s) failed", failed),
+			"Fix the failed checks listed above and run 'ccmgr-ultra doctor' again",
+		))
+	}
+
+	return nil
+}
+
+// runDoctorChecks runs every doctor probe in checklist order
+func runDoctorChecks(cfg *config.Config, env doctorEnv) []doctorCheck {
+	var checks []doctorCheck
+	checks = append(checks, checkGit(cfg, env)...)
+	checks = append(checks, checkTmux(cfg, env)...)
+	checks = append(checks, checkClaudeCommand(cfg, env))
+	checks = append(checks, checkHookScripts(cfg, env)...)
+	checks = append(checks, checkGitHubAuth(cfg, env))
+	return checks
+}
+
+// checkGit checks that git is installed and the current directory is a
+// repository
+func checkGit(cfg *config.Config, env doctorEnv) []doctorCheck {
+	command := commandName(cfg.Commands.GitCommand, "git")
+	path, err := env.lookPath(command)
+	if err != nil {
+		return []doctorCheck{{
+			Name:       "git installed",
+			Status:     doctorFail,
+			Detail:     fmt.Sprintf("%s not found in PATH", command),
+			Suggestion: "Install git, or set commands.git_command to its full path",
+		}}
+	}
+
+	checks := []doctorCheck{{Name: "git installed", Status: doctorPass, Detail: path}}
+
+	if err := env.detectRepo(); err != nil {
+		checks = append(checks, doctorCheck{
+			Name:       "git repository",
+			Status:     doctorWarn,
+			Detail:     "current directory is not a git repository",
+			Suggestion: "Run ccmgr-ultra from inside a git repository, or 'ccmgr-ultra init' to create one",
+		})
+	} else {
+		checks = append(checks, doctorCheck{Name: "git repository", Status: doctorPass, Detail: "current directory is a git repository"})
+	}
+
+	return checks
+}
+
+// checkTmux checks that tmux is installed and the configured prefix can be
+// used in session names
+func checkTmux(cfg *config.Config, env doctorEnv) []doctorCheck {
+	var checks []doctorCheck
+
+	if path, err := env.lookPath("tmux"); err != nil {
+		checks = append(checks, doctorCheck{
+			Name:       "tmux installed",
+			Status:     doctorFail,
+			Detail:     "tmux not found in PATH",
+			Suggestion: "Install tmux with your package manager, e.g. 'brew install tmux' or 'apt install tmux'",
+		})
+	} else {
+		checks = append(checks, doctorCheck{Name: "tmux installed", Status: doctorPass, Detail: path})
+	}
+
+	prefix := cfg.Commands.TmuxPrefix
+	if prefix == "" || tmux.SanitizeNameComponent(prefix) != prefix {
+		checks = append(checks, doctorCheck{
+			Name:       "tmux prefix",
+			Status:     doctorFail,
+			Detail:     fmt.Sprintf("%q is not a valid session name prefix", prefix),
+			Suggestion: "Set commands.tmux_prefix to letters, digits and underscores, at most 20 characters",
+		})
+	} else {
+		checks = append(checks, doctorCheck{Name: "tmux prefix", Status: doctorPass, Detail: prefix})
+	}
+
+	return checks
+}
+
+// checkClaudeCommand checks that the configured Claude command resolves
+func checkClaudeCommand(cfg *config.Config, env doctorEnv) doctorCheck {
+	command := commandName(cfg.Commands.ClaudeCommand, "claude")
+	path, err := env.lookPath(command)
+	if err != nil {
+		return doctorCheck{
+			Name:       "claude command",
+			Status:     doctorFail,
+			Detail:     fmt.Sprintf("%s not found in PATH", command),
+			Suggestion: "Install Claude Code, or set commands.claude_command to its full path",
+		}
+	}
+	return doctorCheck{Name: "claude command", Status: doctorPass, Detail: path}
+}
+
+// checkHookScripts checks that every enabled hook script exists and is
+// executable. Nothing is reported when no hooks are enabled.
+func checkHookScripts(cfg *config.Config, env doctorEnv) []doctorCheck {
+	var checks []doctorCheck
+	for _, hook := range enabledHookScripts(cfg) {
+		name := hook.name + " hook"
+		path := config.ExpandPath(hook.script)
+
+		info, err := env.stat(path)
+		switch {
+		case err != nil:
+			checks = append(checks, doctorCheck{
+				Name:       name,
+				Status:     doctorFail,
+				Detail:     fmt.Sprintf("%s does not exist", path),
+				Suggestion: fmt.Sprintf("Create the script or correct %s.script", hook.key),
+			})
+		case info.IsDir() || info.Mode().Perm()&0111 == 0:
+			checks = append(checks, doctorCheck{
+				Name:       name,
+				Status:     doctorFail,
+				Detail:     fmt.Sprintf("%s is not executable", path),
+				Suggestion: fmt.Sprintf("Run 'chmod +x %s'", path),
+			})
+		default:
+			checks = append(checks, doctorCheck{Name: name, Status: doctorPass, Detail: path})
+		}
+	}
+	return checks
+}
+
+// doctorHook is a configured hook script
+type doctorHook struct {
+	name   string
+	key    string
+	script string
+}
+
+// enabledHookScripts lists the hook scripts that would run with cfg
+func enabledHookScripts(cfg *config.Config) []doctorHook {
+	var hooks []doctorHook
+	add := func(enabled bool, name, key string, hook config.HookConfig) {
+		if enabled && hook.Enabled && hook.Script != "" {
+			hooks = append(hooks, doctorHook{name: name, key: key, script: hook.Script})
+		}
+	}
+
+	status := cfg.StatusHooks
+	add(status.Enabled, "idle", "status_hooks.idle", status.IdleHook)
+	add(status.Enabled, "busy", "status_hooks.busy", status.BusyHook)
+	add(status.Enabled, "waiting", "status_hooks.waiting", status.WaitingHook)
+
+	worktree := cfg.WorktreeHooks
+	add(worktree.Enabled, "creation", "worktree_hooks.creation", worktree.CreationHook)
+	add(worktree.Enabled, "activation", "worktree_hooks.activation", worktree.ActivationHook)
+
+	return hooks
+}
+
+// checkGitHubAuth validates the GitHub token when one is configured
+func checkGitHubAuth(cfg *config.Config, env doctorEnv) doctorCheck {
+	if cfg.Git.GitHubToken == "" {
+		return doctorCheck{
+			Name:       "github auth",
+			Status:     doctorWarn,
+			Detail:     "no GitHub token configured",
+			Suggestion: "Set GITHUB_TOKEN or git.github_token to create and list pull requests",
+		}
+	}
+
+	if err := env.validateGitHub(&cfg.Git); err != nil {
+		return doctorCheck{
+			Name:       "github auth",
+			Status:     doctorFail,
+			Detail:     fmt.Sprintf("GitHub rejected the token: %v", err),
+			Suggestion: "Generate a new token with the 'repo' scope and update GITHUB_TOKEN",
+		}
+	}
+	return doctorCheck{Name: "github auth", Status: doctorPass, Detail: "token accepted"}
+}
+
+// commandName returns the executable of a configured command line, or
+// fallback when none is configured
+func commandName(command, fallback string) string {
+	if fields := strings.Fields(command); len(fields) > 0 {
+		return fields[0]
+	}
+	return fallback
+}
+
+// writeDoctorChecks prints the checklist, with the suggestion for every
+// check that did not pass
+func writeDoctorChecks(w io.Writer, checks []doctorCheck) {
+	for _, check := range checks {
+		fmt.Fprintf(w, "%s %s: %s\n", doctorStatusIcon(check.Status), check.Name, check.Detail)
+		if check.Status != doctorPass && check.Suggestion != "" {
+			fmt.Fprintf(w, "  → %s\n", check.Suggestion)
+		}
+	}
+}
+
+// doctorStatusIcon returns the checklist marker for a status
+func doctorStatusIcon(status doctorStatus) string {
+	switch status {
+	case doctorPass:
+		return "✓"
+	case doctorWarn:
+		return "!"
+	default:
+		return "✗"
+	}
+}
+
+// countDoctorFailures returns the number of failed checks
+func countDoctorFailures(checks []doctorCheck) int {
+	failed := 0
+	for _, check := range checks {
+		if check.Status == doctorFail {
+			failed++
+		}
+	}
+	return failed
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// fakeDoctorEnv returns an environment where the given executables are
+// installed under /usr/bin, the current directory is a repository and every
+// GitHub token is accepted
+func fakeDoctorEnv(installed ...string) doctorEnv {
+	return doctorEnv{
+		lookPath: func(file string) (string, error) {
+			for _, name := range installed {
+				if name == file {
+					return "/usr/bin/" + file, nil
+				}
+			}
+			return "", errors.New("executable file not found in $PATH")
+		},
+		detectRepo:     func() error { return nil },
+		stat:           os.Stat,
+		validateGitHub: func(cfg *config.GitConfig) error { return nil },
+	}
+}
+
+func findDoctorCheck(t *testing.T, checks []doctorCheck, name string) doctorCheck {
+	t.Helper()
+	for _, check := range checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	require.Failf(t, "check not found", "no %q check in %+v", name, checks)
+	return doctorCheck{}
+}
+
+func TestCheckGit(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	checks := checkGit(cfg, fakeDoctorEnv())
+	require.Len(t, checks, 1, "repository is not probed without git")
+	assert.Equal(t, doctorFail, checks[0].Status)
+	assert.NotEmpty(t, checks[0].Suggestion)
+
+	env := fakeDoctorEnv("git")
+	checks = checkGit(cfg, env)
+	assert.Equal(t, doctorPass, findDoctorCheck(t, checks, "git installed").Status)
+	assert.Equal(t, doctorPass, findDoctorCheck(t, checks, "git repository").Status)
+
+	env.detectRepo = func() error { return errors.New("not a git repository") }
+	repo := findDoctorCheck(t, checkGit(cfg, env), "git repository")
+	assert.Equal(t, doctorWarn, repo.Status)
+	assert.Contains(t, repo.Suggestion, "init")
+
+	cfg.Commands.GitCommand = "/opt/git/bin/git --no-pager"
+	assert.Equal(t, doctorFail, checkGit(cfg, env)[0].Status, "configured git command is looked up")
+}
+
+func TestCheckTmux(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	checks := checkTmux(cfg, fakeDoctorEnv("tmux"))
+	assert.Equal(t, doctorPass, findDoctorCheck(t, checks, "tmux installed").Status)
+	assert.Equal(t, doctorPass, findDoctorCheck(t, checks, "tmux prefix").Status)
+
+	installed := findDoctorCheck(t, checkTmux(cfg, fakeDoctorEnv()), "tmux installed")
+	assert.Equal(t, doctorFail, installed.Status)
+	assert.Contains(t, installed.Suggestion, "Install tmux")
+
+	for _, prefix := range []string{"", "my.prefix", "has:colon", "a_very_long_prefix_over_twenty"} {
+		cfg.Commands.TmuxPrefix = prefix
+		check := findDoctorCheck(t, checkTmux(cfg, fakeDoctorEnv("tmux")), "tmux prefix")
+		assert.Equal(t, doctorFail, check.Status, "prefix %q", prefix)
+	}
+}
+
+func TestCheckClaudeCommand(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	assert.Equal(t, doctorPass, checkClaudeCommand(cfg, fakeDoctorEnv("claude")).Status)
+
+	cfg.Commands.ClaudeCommand = "claude-wrapper --verbose"
+	check := checkClaudeCommand(cfg, fakeDoctorEnv("claude"))
+	assert.Equal(t, doctorFail, check.Status)
+	assert.Contains(t, check.Detail, "claude-wrapper")
+
+	assert.Equal(t, doctorPass, checkClaudeCommand(cfg, fakeDoctorEnv("claude-wrapper")).Status)
+}
+
+func TestCheckHookScripts(t *testing.T) {
+	dir := t.TempDir()
+	executable := filepath.Join(dir, "idle.sh")
+	plain := filepath.Join(dir, "busy.sh")
+	require.NoError(t, os.WriteFile(executable, []byte("#!/bin/sh\n"), 0755))
+	require.NoError(t, os.WriteFile(plain, []byte("#!/bin/sh\n"), 0644))
+
+	cfg := config.DefaultConfig()
+	cfg.StatusHooks.Enabled = true
+	cfg.StatusHooks.IdleHook = config.HookConfig{Enabled: true, Script: executable}
+	cfg.StatusHooks.BusyHook = config.HookConfig{Enabled: true, Script: plain}
+	cfg.StatusHooks.WaitingHook = config.HookConfig{Enabled: false, Script: filepath.Join(dir, "disabled.sh")}
+	cfg.WorktreeHooks.Enabled = true
+	cfg.WorktreeHooks.CreationHook = config.HookConfig{Enabled: true, Script: filepath.Join(dir, "missing.sh")}
+	cfg.WorktreeHooks.ActivationHook = config.HookConfig{}
+
+	checks := checkHookScripts(cfg, fakeDoctorEnv())
+	require.Len(t, checks, 3, "disabled and unset hooks are not checked")
+
+	assert.Equal(t, doctorPass, findDoctorCheck(t, checks, "idle hook").Status)
+
+	busy := findDoctorCheck(t, checks, "busy hook")
+	assert.Equal(t, doctorFail, busy.Status)
+	assert.Equal(t, "Run 'chmod +x "+plain+"'", busy.Suggestion)
+
+	creation := findDoctorCheck(t, checks, "creation hook")
+	assert.Equal(t, doctorFail, creation.Status)
+	assert.Contains(t, creation.Suggestion, "worktree_hooks.creation.script")
+
+	cfg.StatusHooks.Enabled = false
+	cfg.WorktreeHooks.Enabled = false
+	assert.Empty(t, checkHookScripts(cfg, fakeDoctorEnv()))
+}
+
+func TestCheckGitHubAuth(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Git.GitHubToken = ""
+
+	env := fakeDoctorEnv()
+	env.validateGitHub = func(cfg *config.GitConfig) error {
+		t.Fatal("token is not validated when unset")
+		return nil
+	}
+	assert.Equal(t, doctorWarn, checkGitHubAuth(cfg, env).Status)
+
+	cfg.Git.GitHubToken = "ghp_test"
+	var validated string
+	env.validateGitHub = func(cfg *config.GitConfig) error {
+		validated = cfg.GitHubToken
+		return nil
+	}
+	assert.Equal(t, doctorPass, checkGitHubAuth(cfg, env).Status)
+	assert.Equal(t, "ghp_test", validated)
+
+	env.validateGitHub = func(cfg *config.GitConfig) error { return errors.New("401 Bad credentials") }
+	check := checkGitHubAuth(cfg, env)
+	assert.Equal(t, doctorFail, check.Status)
+	assert.Contains(t, check.Detail, "Bad credentials")
+}
+
+func TestRunDoctorChecks_Output(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Git.GitHubToken = ""
+	cfg.StatusHooks.Enabled = false
+	cfg.WorktreeHooks.Enabled = false
+
+	checks := runDoctorChecks(cfg, fakeDoctorEnv("git", "tmux", "claude"))
+	assert.Zero(t, countDoctorFailures(checks))
+
+	var out bytes.Buffer
+	writeDoctorChecks(&out, checks)
+	assert.Contains(t, out.String(), "✓ git installed: /usr/bin/git\n")
+	assert.Contains(t, out.String(), "! github auth: no GitHub token configured\n  → Set GITHUB_TOKEN")
+
+	checks = runDoctorChecks(cfg, fakeDoctorEnv("git"))
+	assert.Equal(t, 2, countDoctorFailures(checks))
+
+	out.Reset()
+	writeDoctorChecks(&out, checks)
+	assert.Contains(t, out.String(), "✗ tmux installed: tmux not found in PATH\n")
+	assert.Contains(t, out.String(), "✗ claude command: claude not found in PATH\n")
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/hooks"
+)
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "Work with hook scripts",
+	Long:  `Work with the status and worktree hook scripts configured for ccmgr-ultra.`,
+}
+
+var hooksTestCmd = &cobra.Command{
+	Use:   "test [idle|busy|waiting|creation|activation]",
+	Short: "Run hook scripts with sample event data",
+	Long: `Run a hook script the way ccmgr-ultra would for a real event, with
+representative CCMGR_* environment variables for the current directory, and
+print its output, exit code and whether it exceeded its timeout.
+
+Hooks are always run synchronously, even when configured as async. Without
+an argument every enabled hook is run.
+
+Exits non-zero when any hook fails or times out.`,
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: hookTestNames,
+	RunE:      runHooksTestCommand,
+}
+
+func init() {
+	hooksCmd.AddCommand(hooksTestCmd)
+	rootCmd.AddCommand(hooksCmd)
+}
+
+// hookTestNames are the hooks 'hooks test' can run, in configuration order
+var hookTestNames = []string{"idle", "busy", "waiting", "creation", "activation"}
+
+// hookTestTypes maps the hook names to their hook types
+var hookTestTypes = map[string]hooks.HookType{
+	"idle":       hooks.HookTypeStatusIdle,
+	"busy":       hooks.HookTypeStatusBusy,
+	"waiting":    hooks.HookTypeStatusWaiting,
+	"creation":   hooks.HookTypeWorktreeCreation,
+	"activation": hooks.HookTypeWorktreeActivation,
+}
+
+func runHooksTestCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	var names []string
+	if len(args) == 1 {
+		names = args
+	} else {
+		for _, hook := range enabledHookScripts(cfg) {
+			names = append(names, hook.name)
+		}
+		if len(names) == 0 {
+			fmt.Println("No hooks are enabled")
+			return nil
+		}
+	}
+
+	if failed := testHooks(os.Stdout, cfg, names, sampleHookContext()); failed > 0 {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("%d hook(s) failed", failed),
+			"Fix the hook scripts above and run 'ccmgr-ultra hooks test' again",
+		))
+	}
+
+	return nil
+}
+
+// sampleHookContext describes the current directory as the worktree of a
+// hook event
+func sampleHookContext() hooks.HookContext {
+	hookCtx := hooks.HookContext{
+		ProjectName: getCurrentProjectName(),
+		SessionID:   "ccmgr-hooks-test",
+		SessionType: "new",
+		CustomVars:  map[string]string{"CCMGR_HOOK_TEST": "1"},
+	}
+
+	if cwd, err := os.Getwd(); err == nil {
+		hookCtx.WorktreePath = cwd
+	}
+	if repo, _, err := loadRepository(); err == nil {
+		hookCtx.WorktreeBranch = repo.CurrentBranch
+		hookCtx.CustomVars["CCMGR_PARENT_PATH"] = repo.RootPath
+	}
+
+	return hookCtx
+}
+
+// testHooks runs each named hook with hookCtx, writes a report of every run
+// to w and returns the number of hooks that failed
+func testHooks(w io.Writer, cfg *config.Config, names []string, hookCtx hooks.HookContext) int {
+	executor := hooks.NewDefaultExecutor(cfg)
+	failed := 0
+
+	for i, name := range names {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		hookConfig := hookTestConfig(cfg, name)
+		fmt.Fprintf(w, "%s hook: %s\n", name, hookConfig.Script)
+		if hookConfig.Script == "" {
+			fmt.Fprintln(w, "  Result: no script configured")
+			failed++
+			continue
+		}
+
+		// Status hooks describe a process entering the hook's state
+		runCtx := hookCtx
+		switch name {
+		case "idle":
+			runCtx.OldState, runCtx.NewState = "busy", "idle"
+		case "busy", "waiting":
+			runCtx.OldState, runCtx.NewState = "idle", name
+		}
+
+		result, err := executor.RunHook(hookTestTypes[name], runCtx)
+		if !writeHookTestResult(w, hookConfig, result, err) {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// hookTestConfig returns the configuration of the named hook
+func hookTestConfig(cfg *config.Config, name string) config.HookConfig {
+	switch name {
+	case "idle":
+		return cfg.StatusHooks.IdleHook
+	case "busy":
+		return cfg.StatusHooks.BusyHook
+	case "waiting":
+		return cfg.StatusHooks.WaitingHook
+	case "creation":
+		return cfg.WorktreeHooks.CreationHook
+	default:
+		return cfg.WorktreeHooks.ActivationHook
+	}
+}
+
+// writeHookTestResult writes the output and outcome of a hook run and
+// reports whether the hook succeeded
+func writeHookTestResult(w io.Writer, hookConfig config.HookConfig, result *hooks.HookResult, err error) bool {
+	if result == nil {
+		fmt.Fprintf(w, "  Result: %v\n", err)
+		return false
+	}
+
+	writeHookOutput(w, "Output", result.Output)
+	writeHookOutput(w, "Stderr", result.Stderr)
+	fmt.Fprintf(w, "  Exit code: %d\n", result.ExitCode)
+	fmt.Fprintf(w, "  Duration: %s\n", result.Duration.Round(time.Millisecond))
+
+	var timeoutErr *hooks.TimeoutError
+	switch {
+	case errors.As(err, &timeoutErr):
+		fmt.Fprintf(w, "  Result: timed out after %ds\n", hookConfig.Timeout)
+	case err != nil:
+		fmt.Fprintf(w, "  Result: failed\n")
+	default:
+		fmt.Fprintf(w, "  Result: ok\n")
+	}
+
+	return err == nil
+}
+
+// writeHookOutput writes the non-empty output of a hook indented under label
+func writeHookOutput(w io.Writer, label, output string) {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return
+	}
+
+	fmt.Fprintf(w, "  %s:\n", label)
+	for _, line := range strings.Split(output, "\n") {
+		fmt.Fprintf(w, "    %s\n", line)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/hooks"
+)
+
+// writeHookScript writes an executable hook script to a temp directory
+func writeHookScript(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "hook.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+content), 0755))
+	return path
+}
+
+var testHookContext = hooks.HookContext{
+	WorktreePath:   "/work/app",
+	WorktreeBranch: "feature/auth",
+	ProjectName:    "app",
+}
+
+func TestTestHooks_Success(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StatusHooks.BusyHook = config.HookConfig{
+		Script:  writeHookScript(t, "echo \"$CCMGR_OLD_STATE -> $CCMGR_NEW_STATE on $CCMGR_BRANCH\"\n"),
+		Timeout: 10,
+		Async:   true,
+	}
+
+	var out bytes.Buffer
+	failed := testHooks(&out, cfg, []string{"busy"}, testHookContext)
+
+	assert.Equal(t, 0, failed)
+	assert.Contains(t, out.String(), "busy hook: "+cfg.StatusHooks.BusyHook.Script)
+	assert.Contains(t, out.String(), "    idle -> busy on feature/auth\n", "async hooks run synchronously")
+	assert.Contains(t, out.String(), "  Exit code: 0\n")
+	assert.Contains(t, out.String(), "  Result: ok\n")
+}
+
+func TestTestHooks_FailingScript(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorktreeHooks.CreationHook = config.HookConfig{
+		Script:  writeHookScript(t, "echo 'npm install failed' >&2\nexit 3\n"),
+		Timeout: 10,
+	}
+
+	var out bytes.Buffer
+	failed := testHooks(&out, cfg, []string{"creation"}, testHookContext)
+
+	assert.Equal(t, 1, failed)
+	assert.Contains(t, out.String(), "  Stderr:\n    npm install failed\n")
+	assert.Contains(t, out.String(), "  Exit code: 3\n")
+	assert.Contains(t, out.String(), "  Result: failed\n")
+}
+
+func TestTestHooks_Timeout(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorktreeHooks.ActivationHook = config.HookConfig{
+		Script:  writeHookScript(t, "sleep 30\n"),
+		Timeout: 1,
+	}
+
+	var out bytes.Buffer
+	failed := testHooks(&out, cfg, []string{"activation"}, testHookContext)
+
+	assert.Equal(t, 1, failed)
+	assert.Contains(t, out.String(), "  Result: timed out after 1s\n")
+}
+
+func TestTestHooks_MissingScript(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StatusHooks.IdleHook = config.HookConfig{Script: filepath.Join(t.TempDir(), "missing.sh"), Timeout: 10}
+	cfg.StatusHooks.WaitingHook = config.HookConfig{}
+
+	var out bytes.Buffer
+	failed := testHooks(&out, cfg, []string{"idle", "waiting"}, testHookContext)
+
+	assert.Equal(t, 2, failed)
+	assert.Contains(t, out.String(), "not found")
+	assert.Contains(t, out.String(), "waiting hook: \n  Result: no script configured\n")
+}
@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/logging"
 	"github.com/unbracketed/ccmgr-ultra/internal/tui"
 )
 
@@ -23,11 +26,20 @@ var (
 var (
 	nonInteractive bool
 	configPath     string
+	configDir      string
 	verbose        bool
 	quiet          bool
 	dryRun         bool
+	noColor        bool
+	colorMode      string
+	profileName    string
+	errorOutput    string
+	logFile        string
 )
 
+// closeLog closes the --log-file once the command has finished
+var closeLog = func() error { return nil }
+
 var rootCmd = &cobra.Command{
 	Use:   "ccmgr-ultra",
 	Short: "Claude Multi-Project Multi-Session Manager",
@@ -35,6 +47,16 @@ var rootCmd = &cobra.Command{
 across multiple projects and git worktrees. It combines the best features of
 CCManager and Claude Squad to provide seamless tmux session management,
 status monitoring, and workflow automation.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		config.SetConfigDir(configDir)
+		if err := setupLogging(cmd); err != nil {
+			return err
+		}
+		if err := applyColorMode(); err != nil {
+			return err
+		}
+		return applyErrorOutput(cmd)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		if nonInteractive {
 			// CLI-only mode - show help since no subcommand was specified
@@ -56,15 +78,81 @@ var versionCmd = &cobra.Command{
 func init() {
 	// Add global persistent flags
 	rootCmd.PersistentFlags().BoolVarP(&nonInteractive, "non-interactive", "n", false, "Skip TUI, use CLI-only mode")
-	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "", "Custom config file path")
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "", "Config file merged over the global and project config")
+	rootCmd.PersistentFlags().StringVar(&configDir, "config-dir", "", "Directory holding the global config, hooks and state (default $XDG_CONFIG_HOME/ccmgr-ultra)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppress non-essential output")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Show what would be done without executing")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", "auto", "When to use colored output (auto, never, always); auto colors only when stdout is a terminal and NO_COLOR is unset")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (same as --color=never)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Config profile to overlay on the base config (env: CCMGR_PROFILE)")
+	rootCmd.PersistentFlags().StringVar(&errorOutput, "output", "text", "Error output format (text, json)")
+	rootCmd.PersistentFlags().StringVar(&logFile, "log-file", "", "Append log records at the configured log_level to this file (default: stderr, or discarded in the TUI)")
+
+	// Flag parsing fails before PersistentPreRunE runs, so apply the error
+	// output here too
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		applyErrorOutput(cmd)
+		return err
+	})
 
 	// Add subcommands
 	rootCmd.AddCommand(versionCmd)
 }
 
+// applyErrorOutput configures error rendering from --output. In JSON mode
+// cobra's own error and usage text is silenced so stderr carries only the
+// JSON error.
+func applyErrorOutput(cmd *cobra.Command) error {
+	output, err := cli.ValidateErrorOutput(errorOutput)
+	if err != nil {
+		return err
+	}
+
+	cli.SetErrorOutput(output)
+	if output == cli.ErrorOutputJSON {
+		cmd.Root().SilenceErrors = true
+		cmd.Root().SilenceUsage = true
+	}
+	return nil
+}
+
+// setupLogging sends log records to --log-file, or to stderr for CLI
+// commands. The TUI owns the terminal, so without a log file its records are
+// discarded.
+func setupLogging(cmd *cobra.Command) error {
+	var fallback io.Writer = os.Stderr
+	if !cmd.HasParent() && !nonInteractive {
+		fallback = io.Discard
+	}
+
+	path := logFile
+	if path != "" {
+		path = config.ExpandPath(path)
+	}
+
+	closeFile, err := logging.Setup(path, fallback)
+	if err != nil {
+		return err
+	}
+	closeLog = closeFile
+	return nil
+}
+
+// applyColorMode configures output styling from --color and --no-color
+func applyColorMode() error {
+	mode, err := cli.ValidateColorMode(colorMode)
+	if err != nil {
+		return err
+	}
+	if noColor {
+		mode = cli.ColorNever
+	}
+
+	cli.SetColorMode(mode)
+	return nil
+}
+
 // runTUI initializes and runs the TUI application
 func runTUI() {
 	// Create context for graceful shutdown
@@ -79,8 +167,9 @@ func runTUI() {
 		cancel()
 	}()
 
-	// Load configuration
-	cfg, err := config.Load()
+	// Load configuration with the --config and --profile the CLI commands use,
+	// which the config screen also reloads with
+	cfg, err := loadConfigWithOverrides()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
 		os.Exit(1)
@@ -104,7 +193,12 @@ func runTUI() {
 }
 
 func main() {
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	closeLog()
+	if err != nil {
+		if cli.CurrentErrorOutput() == cli.ErrorOutputJSON {
+			cli.ExitWithError(err)
+		}
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
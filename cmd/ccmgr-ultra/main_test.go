@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+)
+
+// executeHelp runs rootCmd with --help for the command at path
+func executeHelp(t *testing.T, path []string) string {
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(&out)
+	rootCmd.SetArgs(append(path, "--help"))
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+	})
+
+	assert.NotPanics(t, func() {
+		require.NoError(t, rootCmd.Execute())
+	})
+	return out.String()
+}
+
+func TestSessionNewHelp_DoesNotPanic(t *testing.T) {
+	output := executeHelp(t, []string{"session", "new"})
+	assert.Contains(t, output, "--name")
+}
+
+// TestCommandFlags_NoPersistentShorthandCollisions guards against subcommands
+// reusing a shorthand of a persistent root flag such as -n, -c, -v or -q,
+// which makes cobra panic when the command is run
+func TestCommandFlags_NoPersistentShorthandCollisions(t *testing.T) {
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		for _, sub := range cmd.Commands() {
+			path := strings.Fields(sub.CommandPath())[1:]
+			t.Run(strings.Join(path, " "), func(t *testing.T) {
+				executeHelp(t, path)
+			})
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+}
+
+func TestApplyColorMode(t *testing.T) {
+	t.Cleanup(func() {
+		colorMode, noColor = "auto", false
+		cli.SetColorMode(cli.ColorAuto)
+	})
+
+	tests := []struct {
+		name     string
+		color    string
+		noColor  bool
+		expected cli.ColorMode
+	}{
+		{name: "default", color: "auto", expected: cli.ColorAuto},
+		{name: "always", color: "always", expected: cli.ColorAlways},
+		{name: "never", color: "never", expected: cli.ColorNever},
+		{name: "no-color wins", color: "always", noColor: true, expected: cli.ColorNever},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			colorMode, noColor = tt.color, tt.noColor
+			require.NoError(t, applyColorMode())
+			assert.Equal(t, tt.expected, cli.CurrentColorMode())
+		})
+	}
+
+	colorMode = "rainbow"
+	assert.Error(t, applyColorMode())
+}
+
+func TestLogFileFlag(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() {
+		closeLog()
+		slog.SetDefault(previous)
+		logFile = ""
+	})
+
+	logFile = filepath.Join(t.TempDir(), "logs", "ccmgr.log")
+	require.NoError(t, rootCmd.PersistentPreRunE(versionCmd, nil))
+	assert.FileExists(t, logFile)
+
+	slog.Error("hook failed", "hook", "worktree_creation")
+	data, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "hook=worktree_creation")
+}
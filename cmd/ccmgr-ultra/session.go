@@ -1,7 +1,9 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,7 +12,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/unbracketed/ccmgr-ultra/internal/claude"
 	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
 	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+	"gopkg.in/yaml.v3"
 )
 
 // SessionListData represents data for session list output
@@ -31,11 +36,21 @@ type SessionListItem struct {
 	Status       string    `json:"status" yaml:"status"`
 	Active       bool      `json:"active" yaml:"active"`
 	ProcessCount int       `json:"process_count" yaml:"process_count"`
+	Upstream     string    `json:"upstream,omitempty" yaml:"upstream,omitempty"`
+	Ahead        int       `json:"ahead,omitempty" yaml:"ahead,omitempty"`
+	Behind       int       `json:"behind,omitempty" yaml:"behind,omitempty"`
+	GitStatus    string    `json:"git_status,omitempty" yaml:"git_status,omitempty"`
 	Created      time.Time `json:"created" yaml:"created"`
 	LastAccess   time.Time `json:"last_access" yaml:"last_access"`
 	Uptime       string    `json:"uptime" yaml:"uptime"`
 }
 
+// SessionExportData is the file written by session export and read by
+// session import
+type SessionExportData struct {
+	Sessions []tmux.SessionDefinition `json:"sessions" yaml:"sessions"`
+}
+
 var sessionCmd = &cobra.Command{
 	Use:   "session",
 	Short: "Manage tmux sessions",
@@ -44,7 +59,8 @@ var sessionCmd = &cobra.Command{
 - Create new sessions for worktrees
 - Resume existing sessions with health validation
 - Terminate sessions with graceful shutdown
-- Clean up stale and orphaned sessions`,
+- Clean up stale and orphaned sessions
+- Export and import session definitions to share them`,
 }
 
 // Session list command
@@ -55,7 +71,8 @@ var sessionListCmd = &cobra.Command{
 - Session details and associated worktrees
 - Process status and health information
 - Activity and uptime information
-- Status classification (active, idle, stale)`,
+- Status classification (active, idle, stale)
+- Branch ahead/behind upstream counts (with --with-git)`,
 	RunE: runSessionListCommand,
 }
 
@@ -65,6 +82,7 @@ var sessionListFlags struct {
 	project       string
 	status        string
 	withProcesses bool
+	withGit       bool
 }
 
 // Session new command
@@ -73,17 +91,23 @@ var sessionNewCmd = &cobra.Command{
 	Short: "Create new tmux session for worktree",
 	Long: `Create new tmux session for specified worktree.
 Follows ccmgr-ultra session naming conventions.
-Optionally starts Claude Code process in session.`,
+If the session name is already taken, fails unless --suffix-on-collision
+is given, in which case a numeric suffix is appended.
+Optionally starts Claude Code process in session.
+Attaches to the new session when run in a terminal; with --detached, or
+when stdin or stdout is not a terminal, it keeps running in the background.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSessionNewCommand,
 }
 
 var sessionNewFlags struct {
-	name          string
-	startClaude   bool
-	detached      bool
-	config        string
-	inheritConfig bool
+	name              string
+	startClaude       bool
+	prompt            string
+	detached          bool
+	config            string
+	inheritConfig     bool
+	suffixOnCollision bool
 }
 
 // Session resume command
@@ -106,12 +130,14 @@ var sessionResumeFlags struct {
 
 // Session kill command
 var sessionKillCmd = &cobra.Command{
-	Use:   "kill <session-id> [flags]",
+	Use:   "kill [session-id] [flags]",
 	Short: "Terminate tmux session",
 	Long: `Terminate specified tmux session gracefully.
 Handles Claude Code process shutdown properly.
-Supports batch termination with filters.`,
-	Args: cobra.ExactArgs(1),
+Supports batch termination with filters: --pattern matches session names
+against a glob pattern and --all-stale selects sessions listed as stale.
+Both filters may be combined.`,
+	Args: sessionKillArgs,
 	RunE: runSessionKillCommand,
 }
 
@@ -123,6 +149,17 @@ var sessionKillFlags struct {
 	timeout  int
 }
 
+// Session rename command
+var sessionRenameCmd = &cobra.Command{
+	Use:   "rename <session-id> <new-name>",
+	Short: "Rename tmux session",
+	Long: `Rename an existing tmux session, for example after renaming its branch.
+The new name is sanitized and truncated to tmux.max_session_name like
+generated session names. Fails if another session already uses the name.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSessionRenameCommand,
+}
+
 // Session clean command
 var sessionCleanCmd = &cobra.Command{
 	Use:   "clean [flags]",
@@ -139,6 +176,34 @@ var sessionCleanFlags struct {
 	all       bool
 	olderThan string
 	verbose   bool
+	format    string
+}
+
+// Session export command
+var sessionExportCmd = &cobra.Command{
+	Use:   "export [flags]",
+	Short: "Export session definitions for this repository",
+	Long: `Write the sessions of this repository's worktrees, with their window
+names and startup commands, as YAML that 'session import' can recreate.
+Each session is recorded with the branch of the worktree it runs in.`,
+	Args: cobra.NoArgs,
+	RunE: runSessionExportCommand,
+}
+
+var sessionExportFlags struct {
+	out string
+}
+
+// Session import command
+var sessionImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Recreate sessions from an export file",
+	Long: `Recreate the sessions written by 'session export' for the matching
+worktrees of this repository, with their windows and startup commands.
+Sessions that already exist, or whose worktree is not checked out, are
+skipped. Names are used unchanged and must fit tmux.max_session_name.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionImportCommand,
 }
 
 func init() {
@@ -148,13 +213,16 @@ func init() {
 	sessionListCmd.Flags().StringVarP(&sessionListFlags.project, "project", "p", "", "Filter by project name")
 	sessionListCmd.Flags().StringVarP(&sessionListFlags.status, "status", "s", "", "Filter by status (active, idle, stale)")
 	sessionListCmd.Flags().BoolVar(&sessionListFlags.withProcesses, "with-processes", false, "Include Claude Code process details")
+	sessionListCmd.Flags().BoolVar(&sessionListFlags.withGit, "with-git", false, "Include ahead/behind status of each session's branch against its upstream")
 
 	// New command flags
-	sessionNewCmd.Flags().StringVar(&sessionNewFlags.name, "name", "", "Custom session name suffix")
+	sessionNewCmd.Flags().StringVar(&sessionNewFlags.name, "name", "", "Session name to use instead of the generated one")
 	sessionNewCmd.Flags().BoolVar(&sessionNewFlags.startClaude, "start-claude", false, "Automatically start Claude Code")
-	sessionNewCmd.Flags().BoolVarP(&sessionNewFlags.detached, "detached", "d", false, "Create session detached from terminal")
+	sessionNewCmd.Flags().StringVar(&sessionNewFlags.prompt, "prompt", "", "Initial prompt to send to Claude Code once started (used with --start-claude)")
+	sessionNewCmd.Flags().BoolVarP(&sessionNewFlags.detached, "detached", "d", false, "Leave the session running in the background instead of attaching")
 	sessionNewCmd.Flags().StringVar(&sessionNewFlags.config, "claude-config", "", "Custom Claude Code config for session")
 	sessionNewCmd.Flags().BoolVar(&sessionNewFlags.inheritConfig, "inherit-config", false, "Inherit config from parent directory")
+	sessionNewCmd.Flags().BoolVar(&sessionNewFlags.suffixOnCollision, "suffix-on-collision", false, "Append a numeric suffix if the session name is already taken")
 
 	// Resume command flags
 	sessionResumeCmd.Flags().BoolVarP(&sessionResumeFlags.attach, "attach", "a", false, "Attach to session in current terminal")
@@ -175,13 +243,20 @@ func init() {
 	sessionCleanCmd.Flags().BoolVar(&sessionCleanFlags.all, "all", false, "Clean all eligible sessions, not just stale ones")
 	sessionCleanCmd.Flags().StringVar(&sessionCleanFlags.olderThan, "older-than", "24h", "Clean sessions older than specified duration")
 	sessionCleanCmd.Flags().BoolVar(&sessionCleanFlags.verbose, "verbose", false, "Detailed cleanup information")
+	sessionCleanCmd.Flags().StringVar(&sessionCleanFlags.format, "format", "table", "Output format (table, json, yaml)")
+
+	// Export command flags
+	sessionExportCmd.Flags().StringVarP(&sessionExportFlags.out, "out", "o", "", "Write the definitions to a file instead of stdout")
 
 	// Add subcommands to session command
 	sessionCmd.AddCommand(sessionListCmd)
 	sessionCmd.AddCommand(sessionNewCmd)
 	sessionCmd.AddCommand(sessionResumeCmd)
 	sessionCmd.AddCommand(sessionKillCmd)
+	sessionCmd.AddCommand(sessionRenameCmd)
 	sessionCmd.AddCommand(sessionCleanCmd)
+	sessionCmd.AddCommand(sessionExportCmd)
+	sessionCmd.AddCommand(sessionImportCmd)
 
 	// Add session command to root
 	rootCmd.AddCommand(sessionCmd)
@@ -214,6 +289,11 @@ func runSessionListCommand(cmd *cobra.Command, args []string) error {
 		Timestamp: time.Now(),
 	}
 
+	var gitCmd git.GitInterface
+	if sessionListFlags.withGit {
+		gitCmd = repositoryCache.GitCmd()
+	}
+
 	// Optionally get process information
 	var processManager *claude.ProcessManager
 	if sessionListFlags.withProcesses {
@@ -235,14 +315,7 @@ func runSessionListCommand(cmd *cobra.Command, args []string) error {
 			Uptime:     time.Since(sess.Created).Truncate(time.Second).String(),
 		}
 
-		// Determine status
-		if sess.Active {
-			item.Status = "active"
-		} else if time.Since(sess.LastAccess) > 1*time.Hour {
-			item.Status = "stale"
-		} else {
-			item.Status = "idle"
-		}
+		item.Status = sessionStatus(sess, time.Now())
 
 		// Get process count if requested
 		if processManager != nil {
@@ -250,6 +323,16 @@ func runSessionListCommand(cmd *cobra.Command, args []string) error {
 			item.ProcessCount = 0
 		}
 
+		// Get branch upstream status if requested
+		if sessionListFlags.withGit && sess.Directory != "" {
+			ops := git.NewGitOperationsInDir(&git.Repository{RootPath: sess.Directory}, gitCmd, sess.Directory)
+			branch := ops.CurrentBranch()
+			if branch == "" {
+				branch = sess.Branch
+			}
+			collectSessionGitStatus(&item, branch, ops.GetBranchInfo)
+		}
+
 		listData.Sessions = append(listData.Sessions, item)
 	}
 
@@ -305,6 +388,11 @@ func runSessionNewCommand(cmd *cobra.Command, args []string) error {
 	if err := validateWorktreeArg(worktreeName); err != nil {
 		return handleCLIError(err)
 	}
+	if sessionNewFlags.name != "" {
+		if err := validateSessionArg(sessionNewFlags.name); err != nil {
+			return handleCLIError(err)
+		}
+	}
 
 	cfg, err := loadConfigWithOverrides()
 	if err != nil {
@@ -324,19 +412,40 @@ func runSessionNewCommand(cmd *cobra.Command, args []string) error {
 		return handleCLIError(cli.NewErrorWithCause("failed to find worktree", err))
 	}
 
-	// Generate session name
+	projectName := getCurrentProjectName()
+
+	// Use --name as the session name if given, otherwise generate one
 	sessionName := sessionNewFlags.name
 	if sessionName == "" {
-		sessionName = generateSessionName(cfg, worktreeName)
+		sessionName, err = git.NewSessionPatternManager(&cfg.Tmux).GenerateSessionName(projectName, worktreeName, worktreeName)
+		if err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to generate session name", err))
+		}
 	}
 
-	// Create the session
+	// Detect collisions with existing sessions
 	sessionManager := tmux.NewSessionManager(cfg)
-	session, err := sessionManager.CreateSession(
-		getCurrentProjectName(), // project
-		worktreeName,            // worktree
-		worktreeName,            // branch (assume branch name matches worktree name)
-		worktreeDir,             // directory
+	uniqueName, err := sessionManager.UniqueSessionName(sessionName)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to check existing sessions", err))
+	}
+	if uniqueName != sessionName {
+		if !sessionNewFlags.suffixOnCollision {
+			return handleCLIError(cli.NewErrorWithSuggestion(
+				fmt.Sprintf("session '%s' already exists", sessionName),
+				fmt.Sprintf("Use --suffix-on-collision to create '%s' instead, or choose a different name with --name", uniqueName),
+			))
+		}
+		sessionName = uniqueName
+	}
+
+	// Create the session
+	session, err := sessionManager.CreateSessionWithName(
+		sessionName,  // name
+		projectName,  // project
+		worktreeName, // worktree
+		worktreeName, // branch (assume branch name matches worktree name)
+		worktreeDir,  // directory
 	)
 	if err != nil {
 		return handleCLIError(cli.NewErrorWithCause("failed to create session", err))
@@ -352,9 +461,9 @@ func runSessionNewCommand(cmd *cobra.Command, args []string) error {
 			spinner.SetMessage("Starting Claude Code...")
 		}
 
-		// Claude Code starting functionality not yet implemented
-		if isVerbose() {
-			fmt.Printf("Warning: Claude Code auto-start not yet implemented\n")
+		if err := sessionManager.StartClaude(session.ID, sessionNewFlags.prompt); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to start Claude Code", err).
+				WithSuggestion(fmt.Sprintf("The session is still running; attach with 'tmux attach -t %s' and start it manually", session.ID)))
 		}
 	}
 
@@ -362,6 +471,8 @@ func runSessionNewCommand(cmd *cobra.Command, args []string) error {
 		spinner.StopWithMessage(fmt.Sprintf("Session '%s' created successfully", session.Name))
 	}
 
+	attach := shouldAttachNewSession(sessionNewFlags.detached, isTerminal(os.Stdin) && isTerminal(os.Stdout))
+
 	if !isQuiet() {
 		fmt.Printf("\nSession created:\n")
 		fmt.Printf("  ID: %s\n", session.ID)
@@ -371,15 +482,35 @@ func runSessionNewCommand(cmd *cobra.Command, args []string) error {
 			fmt.Printf("  Claude Code: Started\n")
 		}
 
-		if !sessionNewFlags.detached {
-			fmt.Printf("\nTo attach to this session, run:\n")
+		if !attach {
+			fmt.Printf("\nSession is running in the background. To attach to it, run:\n")
 			fmt.Printf("  tmux attach -t %s\n", session.ID)
 		}
 	}
 
+	if attach {
+		if err := sessionManager.AttachSession(session.ID); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to attach to session", err).
+				WithSuggestion(fmt.Sprintf("The session is still running; attach with 'tmux attach -t %s'", session.ID)))
+		}
+	}
+
 	return nil
 }
 
+// shouldAttachNewSession reports whether session new attaches to the session
+// it created. tmux needs a terminal to take over, so without one the session
+// always stays in the background.
+func shouldAttachNewSession(detached, terminal bool) bool {
+	return !detached && terminal
+}
+
+// isTerminal reports whether f is connected to a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
 func runSessionResumeCommand(cmd *cobra.Command, args []string) error {
 	sessionID := args[0]
 
@@ -455,6 +586,10 @@ func runSessionResumeCommand(cmd *cobra.Command, args []string) error {
 }
 
 func runSessionKillCommand(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return runSessionKillBatch()
+	}
+
 	sessionID := args[0]
 
 	if err := validateSessionArg(sessionID); err != nil {
@@ -468,6 +603,9 @@ func runSessionKillCommand(cmd *cobra.Command, args []string) error {
 
 	// Safety check - confirm termination
 	if !sessionKillFlags.force && !isDryRun() {
+		if err := checkConfirmable("terminating the session", forceConfirmSuggestion); err != nil {
+			return handleCLIError(err)
+		}
 		fmt.Printf("This will terminate session: %s\n", sessionID)
 		fmt.Printf("Proceed with termination? [y/N]: ")
 		var response string
@@ -487,18 +625,6 @@ func runSessionKillCommand(cmd *cobra.Command, args []string) error {
 
 	sessionManager := tmux.NewSessionManager(cfg)
 
-	// Clean up processes if requested
-	if sessionKillFlags.cleanup {
-		if spinner != nil {
-			spinner.SetMessage("Cleaning up Claude Code processes...")
-		}
-
-		// Process cleanup functionality not yet implemented
-		if isVerbose() {
-			fmt.Printf("Warning: Process cleanup not yet implemented\n")
-		}
-	}
-
 	if isDryRun() {
 		if spinner != nil {
 			spinner.StopWithMessage("Dry run: Would terminate session")
@@ -507,8 +633,14 @@ func runSessionKillCommand(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Shut down Claude Code first if requested
+	if sessionKillFlags.cleanup && spinner != nil {
+		spinner.SetMessage("Stopping Claude Code processes...")
+	}
+	kill := newSessionKiller(cfg, sessionManager.KillSession, sessionKillFlags.cleanup, sessionKillTimeout())
+
 	// Kill the session
-	err = sessionManager.KillSession(sessionID)
+	err = kill(sessionID)
 	if err != nil {
 		return handleCLIError(cli.NewErrorWithCause("failed to kill session", err))
 	}
@@ -524,14 +656,543 @@ func runSessionKillCommand(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runSessionKillBatch terminates every session selected by --pattern and
+// --all-stale, reporting the outcome for each one
+func runSessionKillBatch() error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	sessionManager := tmux.NewSessionManager(cfg)
+	sessions, err := sessionManager.ListSessions()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list sessions", err))
+	}
+
+	selected, err := selectSessionsToKill(sessions, sessionKillFlags.pattern, sessionKillFlags.allStale, time.Now())
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("invalid --pattern %q: %v", sessionKillFlags.pattern, err),
+			"Use a glob pattern such as 'ccmgr-myproject-*'",
+		))
+	}
+
+	if len(selected) == 0 {
+		if !isQuiet() {
+			fmt.Println("No matching sessions found")
+		}
+		return nil
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would terminate %d sessions:\n", len(selected))
+		for _, sess := range selected {
+			fmt.Printf("  - %s\n", sess.Name)
+		}
+		return nil
+	}
+
+	if !sessionKillFlags.force {
+		confirmed, err := confirmSessionKill(os.Stdout, selected)
+		if err != nil {
+			return handleCLIError(err)
+		}
+		if !confirmed {
+			fmt.Println("Termination cancelled")
+			return nil
+		}
+	}
+
+	results := killSessions(selected, newSessionKiller(cfg, sessionManager.KillSession, sessionKillFlags.cleanup, sessionKillTimeout()))
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("Failed to terminate %s: %v\n", result.Session.Name, result.Err)
+		} else if !isQuiet() {
+			fmt.Printf("Terminated %s\n", result.Session.Name)
+		}
+	}
+
+	if failed > 0 {
+		return handleCLIError(cli.NewError(fmt.Sprintf("failed to terminate %d of %d sessions", failed, len(results))))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Terminated %d sessions\n", len(results))
+	}
+
+	return nil
+}
+
+// sessionKillArgs requires a session ID unless --pattern or --all-stale
+// selects the sessions, and rejects one alongside them
+func sessionKillArgs(cmd *cobra.Command, args []string) error {
+	batch := sessionKillFlags.pattern != "" || sessionKillFlags.allStale
+	switch {
+	case batch && len(args) > 0:
+		return fmt.Errorf("a session ID cannot be combined with --pattern or --all-stale")
+	case !batch && len(args) != 1:
+		return fmt.Errorf("requires a session ID, --pattern or --all-stale")
+	}
+	return nil
+}
+
+// sessionStatus classifies a session as active, stale (not accessed for over
+// an hour) or idle
+func sessionStatus(sess *tmux.Session, now time.Time) string {
+	switch {
+	case sess.Active:
+		return "active"
+	case now.Sub(sess.LastAccess) > time.Hour:
+		return "stale"
+	default:
+		return "idle"
+	}
+}
+
+// selectSessionsToKill returns the sessions whose name matches the glob
+// pattern, if one is given, and that are stale, if allStale is set
+func selectSessionsToKill(sessions []*tmux.Session, pattern string, allStale bool, now time.Time) ([]*tmux.Session, error) {
+	if pattern != "" {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	var selected []*tmux.Session
+	for _, sess := range sessions {
+		if pattern != "" {
+			if matched, _ := filepath.Match(pattern, sess.Name); !matched {
+				continue
+			}
+		}
+		if allStale && sessionStatus(sess, now) != "stale" {
+			continue
+		}
+		selected = append(selected, sess)
+	}
+	return selected, nil
+}
+
+// confirmSessionKill lists the sessions to terminate on w and asks for
+// confirmation, failing when no answer can be read
+func confirmSessionKill(w io.Writer, sessions []*tmux.Session) (bool, error) {
+	if err := checkConfirmable("terminating the sessions", forceConfirmSuggestion); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(w, "This will terminate %d sessions:\n", len(sessions))
+	for _, sess := range sessions {
+		fmt.Fprintf(w, "  - %s\n", sess.Name)
+	}
+	fmt.Fprintf(w, "Proceed with termination? [y/N]: ")
+
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(response)
+	return response == "y" || response == "yes", nil
+}
+
+// sessionKillResult is the outcome of terminating one session
+type sessionKillResult struct {
+	Session *tmux.Session
+	Err     error
+}
+
+// killSessions kills every session, continuing past failures
+func killSessions(sessions []*tmux.Session, kill func(id string) error) []sessionKillResult {
+	results := make([]sessionKillResult, 0, len(sessions))
+	for _, sess := range sessions {
+		results = append(results, sessionKillResult{Session: sess, Err: kill(sess.ID)})
+	}
+	return results
+}
+
+// sessionKillTimeout is how long session kill waits for Claude Code to exit
+func sessionKillTimeout() time.Duration {
+	return time.Duration(sessionKillFlags.timeout) * time.Second
+}
+
+// newSessionKiller wraps kill so that, with cleanup, the Claude Code processes
+// in a session are interrupted and given up to timeout to exit before the
+// session is killed. Without cleanup, or when processes cannot be listed, kill
+// is returned unchanged and the processes are left to tmux.
+func newSessionKiller(cfg *config.Config, kill func(id string) error, cleanup bool, timeout time.Duration) func(id string) error {
+	if !cleanup {
+		return kill
+	}
+
+	controller, err := newClaudeProcessController(cfg)
+	var processes []*claude.ProcessInfo
+	if err == nil {
+		processes, err = controller.ListProcesses()
+	}
+	if err != nil {
+		if !isQuiet() {
+			fmt.Printf("Warning: Cannot find Claude Code processes, killing sessions directly: %v\n", err)
+		}
+		return kill
+	}
+
+	return func(id string) error {
+		if err := interruptProcesses(controller, sessionProcesses(processes, id), timeout); err != nil && !isQuiet() {
+			fmt.Printf("Warning: %s: %v\n", id, err)
+		}
+		return kill(id)
+	}
+}
+
+// sessionProcesses returns the processes running in the tmux session
+func sessionProcesses(processes []*claude.ProcessInfo, session string) []*claude.ProcessInfo {
+	var matched []*claude.ProcessInfo
+	for _, process := range processes {
+		if process.TmuxSession == session {
+			matched = append(matched, process)
+		}
+	}
+	return matched
+}
+
+// interruptProcesses interrupts every process and then waits for them to
+// exit, sharing timeout between them. Processes that cannot be interrupted or
+// are still running afterwards are reported in the error.
+func interruptProcesses(controller claudeProcessController, processes []*claude.ProcessInfo, timeout time.Duration) error {
+	var problems []string
+	var interrupted []int
+	for _, process := range processes {
+		if err := controller.InterruptProcess(process.PID); err != nil {
+			problems = append(problems, fmt.Sprintf("process %d: %v", process.PID, err))
+			continue
+		}
+		interrupted = append(interrupted, process.PID)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for _, pid := range interrupted {
+		remaining := time.Until(deadline)
+		if remaining < 0 {
+			remaining = 0
+		}
+		if !controller.WaitForExit(pid, remaining) {
+			problems = append(problems, fmt.Sprintf("process %d did not exit within %s", pid, timeout))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("failed to stop Claude Code processes: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func runSessionRenameCommand(cmd *cobra.Command, args []string) error {
+	sessionID, newName := args[0], args[1]
+
+	if err := validateSessionArg(sessionID); err != nil {
+		return handleCLIError(err)
+	}
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	finalName := tmux.SanitizeSessionName(newName, cfg.Tmux.MaxSessionName)
+	if finalName == "" {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("'%s' is not a usable session name", newName),
+			"Use letters, digits, '-' and '_' in session names",
+		))
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would rename session '%s' to '%s'\n", sessionID, finalName)
+		return nil
+	}
+
+	sessionManager := tmux.NewSessionManager(cfg)
+	if err := sessionManager.LoadState(); err != nil {
+		return handleCLIError(sessionStateError("failed to load session state", err))
+	}
+
+	finalName, err = sessionManager.RenameSession(sessionID, newName)
+	if err != nil {
+		if errors.Is(err, tmux.ErrSessionExists) {
+			return handleCLIError(cli.NewErrorWithCause("failed to rename session", err).
+				WithSuggestion("Choose a different name, or kill the existing session first"))
+		}
+		return handleCLIError(sessionStateError("failed to rename session", err))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Session '%s' renamed to '%s'\n", sessionID, finalName)
+	}
+
+	return nil
+}
+
+func runSessionExportCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	worktrees, err := git.NewWorktreeManager(repo, cfg, gitCmd).ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	sessionManager := tmux.NewSessionManager(cfg)
+	sessions, err := sessionManager.ListSessions()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list sessions", err))
+	}
+
+	data := &SessionExportData{Sessions: []tmux.SessionDefinition{}}
+	for _, match := range matchSessionWorktrees(sessions, worktrees) {
+		definition, err := sessionManager.ExportSession(match.session, worktreeKey(match.worktree))
+		if err != nil {
+			return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("failed to export session '%s'", match.session.Name), err))
+		}
+		data.Sessions = append(data.Sessions, *definition)
+	}
+
+	if sessionExportFlags.out == "" {
+		return writeSessionExport(os.Stdout, data)
+	}
+
+	file, err := os.Create(sessionExportFlags.out)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to create export file", err))
+	}
+	if err := writeSessionExport(file, data); err != nil {
+		file.Close()
+		return handleCLIError(cli.NewErrorWithCause("failed to write export file", err))
+	}
+	if err := file.Close(); err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to write export file", err))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Exported %d sessions to %s\n", len(data.Sessions), sessionExportFlags.out)
+	}
+
+	return nil
+}
+
+func runSessionImportCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to open import file", err))
+	}
+	data, err := readSessionExport(file)
+	file.Close()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("failed to read %s", args[0]), err).
+			WithSuggestion("Create the file with 'ccmgr-ultra session export'"))
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	worktrees, err := git.NewWorktreeManager(repo, cfg, gitCmd).ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	sessionManager := tmux.NewSessionManager(cfg)
+	if err := sessionManager.LoadState(); err != nil {
+		return handleCLIError(sessionStateError("failed to load session state", err))
+	}
+
+	exists := func(name string) bool {
+		active, err := sessionManager.IsSessionActive(name)
+		return err == nil && active
+	}
+	plans, skipped, err := planSessionImport(data.Sessions, worktrees, cfg.Tmux.MaxSessionName, exists)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("invalid session definitions", err).
+			WithSuggestion("Shorten the names in the file or raise tmux.max_session_name"))
+	}
+
+	projectName := getCurrentProjectName()
+	created := 0
+	for _, plan := range plans {
+		if isDryRun() {
+			fmt.Printf("Dry run: Would create session '%s' in %s\n", plan.definition.Name, plan.worktree.Path)
+			continue
+		}
+
+		_, err := sessionManager.ImportSession(plan.definition, projectName, plan.worktree.Branch, plan.worktree.Path)
+		if errors.Is(err, tmux.ErrSessionExists) {
+			skipped = append(skipped, sessionImportSkip{name: plan.definition.Name, reason: "already exists"})
+			continue
+		}
+		if err != nil {
+			return handleCLIError(sessionStateError(fmt.Sprintf("failed to create session '%s'", plan.definition.Name), err))
+		}
+		created++
+		if !isQuiet() {
+			fmt.Printf("Created session '%s' in %s\n", plan.definition.Name, plan.worktree.Path)
+		}
+	}
+
+	if !isQuiet() {
+		for _, skip := range skipped {
+			fmt.Printf("Skipped session '%s': %s\n", skip.name, skip.reason)
+		}
+		if !isDryRun() {
+			fmt.Printf("Imported %d sessions, skipped %d\n", created, len(skipped))
+		}
+	}
+
+	return nil
+}
+
+// sessionStateError wraps a failure that may come from the session state
+// file, suggesting a retry when another instance held its lock
+func sessionStateError(message string, err error) *cli.CLIError {
+	cliErr := cli.NewErrorWithCause(message, err)
+	if errors.Is(err, tmux.ErrStateLocked) {
+		cliErr = cliErr.WithSuggestion("Wait for the other ccmgr-ultra command or TUI to finish its change, then retry")
+	}
+	return cliErr
+}
+
+// sessionWorktree is a session together with the worktree it runs in
+type sessionWorktree struct {
+	session  *tmux.Session
+	worktree git.WorktreeInfo
+}
+
+// matchSessionWorktrees pairs each session with the worktree containing its
+// directory, preferring the deepest one, and drops sessions outside every
+// worktree
+func matchSessionWorktrees(sessions []*tmux.Session, worktrees []git.WorktreeInfo) []sessionWorktree {
+	var matches []sessionWorktree
+	for _, sess := range sessions {
+		var match *git.WorktreeInfo
+		for i := range worktrees {
+			wt := &worktrees[i]
+			if sess.Directory != "" && tmux.IsPathWithin(sess.Directory, wt.Path) && (match == nil || len(wt.Path) > len(match.Path)) {
+				match = wt
+			}
+		}
+		if match != nil {
+			matches = append(matches, sessionWorktree{session: sess, worktree: *match})
+		}
+	}
+	return matches
+}
+
+// worktreeKey names a worktree in an export file by its branch, which is
+// the same on every checkout, or by directory name when it is detached
+func worktreeKey(wt git.WorktreeInfo) string {
+	if wt.Branch != "" {
+		return wt.Branch
+	}
+	return filepath.Base(wt.Path)
+}
+
+// writeSessionExport writes data as YAML
+func writeSessionExport(w io.Writer, data *SessionExportData) error {
+	return cli.NewFormatter(cli.FormatYAML, w).Format(data)
+}
+
+// readSessionExport reads a file written by writeSessionExport, rejecting
+// unknown fields so typos are not silently ignored
+func readSessionExport(r io.Reader) (*SessionExportData, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	var data SessionExportData
+	if err := decoder.Decode(&data); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// sessionImportPlan is a session definition to create in a worktree
+type sessionImportPlan struct {
+	definition tmux.SessionDefinition
+	worktree   git.WorktreeInfo
+}
+
+// sessionImportSkip is a session definition left out of an import
+type sessionImportSkip struct {
+	name   string
+	reason string
+}
+
+// planSessionImport decides which definitions to create. Every name is
+// validated against maxLength first, so nothing is created from a file with
+// invalid names. Definitions whose session exists or whose worktree is not
+// checked out are skipped.
+func planSessionImport(definitions []tmux.SessionDefinition, worktrees []git.WorktreeInfo, maxLength int, exists func(name string) bool) ([]sessionImportPlan, []sessionImportSkip, error) {
+	var invalid []string
+	for _, definition := range definitions {
+		if err := tmux.ValidateDefinitionName(definition.Name, maxLength); err != nil {
+			invalid = append(invalid, err.Error())
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, nil, errors.New(strings.Join(invalid, "; "))
+	}
+
+	var plans []sessionImportPlan
+	var skipped []sessionImportSkip
+	seen := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		switch wt := findWorktree(worktrees, definition.Worktree); {
+		case seen[definition.Name] || exists(definition.Name):
+			skipped = append(skipped, sessionImportSkip{name: definition.Name, reason: "already exists"})
+		case wt == nil:
+			skipped = append(skipped, sessionImportSkip{name: definition.Name, reason: fmt.Sprintf("no worktree for '%s'", definition.Worktree)})
+		default:
+			plans = append(plans, sessionImportPlan{definition: definition, worktree: *wt})
+		}
+		seen[definition.Name] = true
+	}
+
+	return plans, skipped, nil
+}
+
 func runSessionCleanCommand(cmd *cobra.Command, args []string) error {
 	cfg, err := loadConfigWithOverrides()
 	if err != nil {
 		return handleCLIError(err)
 	}
 
+	olderThan, err := time.ParseDuration(sessionCleanFlags.olderThan)
+	if err != nil || olderThan <= 0 {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("invalid --older-than duration %q", sessionCleanFlags.olderThan),
+			"Use a positive Go duration such as 90m, 48h or 168h",
+		))
+	}
+
+	formatter, err := setupCleanupOutputFormatter(sessionCleanFlags.format)
+	if err != nil {
+		return handleCLIError(err)
+	}
+	structured := formatter != nil
+
 	var spinner *cli.Spinner
-	if shouldShowProgress() {
+	if shouldShowProgress() && !structured {
 		spinner = cli.NewSpinner("Scanning for stale sessions...")
 		spinner.Start()
 		defer spinner.Stop()
@@ -543,101 +1204,208 @@ func runSessionCleanCommand(cmd *cobra.Command, args []string) error {
 		return handleCLIError(cli.NewErrorWithCause("failed to list sessions", err))
 	}
 
-	// Parse older than duration
-	olderThanDuration, err := time.ParseDuration(sessionCleanFlags.olderThan)
-	if err != nil {
-		return handleCLIError(cli.NewErrorWithCause("invalid duration format", err))
-	}
+	remove, keep := planSessionClean(sessions, time.Now(), olderThan, sessionCleanFlags.all, directoryExists)
+	dryRun := sessionCleanFlags.dryRun || isDryRun()
 
-	// Find sessions to clean
-	var sessionsToClean []*tmux.Session
-	for _, sess := range sessions {
-		shouldClean := false
+	if spinner != nil {
+		spinner.Stop()
+	}
 
-		if sessionCleanFlags.all {
-			shouldClean = true
-		} else {
-			// Check if session is stale
-			if time.Since(sess.LastAccess) > olderThanDuration {
-				shouldClean = true
+	if structured {
+		if len(remove) > 0 && !dryRun && !sessionCleanFlags.force {
+			confirmed, err := confirmSessionClean(os.Stderr, remove)
+			if err != nil {
+				return handleCLIError(err)
 			}
-
-			// Check if worktree directory still exists
-			if _, err := os.Stat(sess.Directory); os.IsNotExist(err) {
-				shouldClean = true
+			if !confirmed {
+				fmt.Fprintln(os.Stderr, "Cleanup cancelled")
+				return nil
 			}
 		}
+		return formatter.Format(cleanSessions(remove, keep, dryRun, sessionManager.KillSession))
+	}
 
-		if shouldClean {
-			sessionsToClean = append(sessionsToClean, sess)
+	if sessionCleanFlags.verbose {
+		for _, d := range remove {
+			fmt.Printf("  remove %s: %s\n", d.Session.Name, d.Reason)
+		}
+		for _, d := range keep {
+			fmt.Printf("  keep   %s: %s\n", d.Session.Name, d.Reason)
 		}
 	}
 
-	if len(sessionsToClean) == 0 {
-		if spinner != nil {
-			spinner.StopWithMessage("No sessions to clean")
+	if len(remove) == 0 {
+		if !isQuiet() {
+			fmt.Println("No stale sessions found")
 		}
-		fmt.Println("No stale sessions found")
 		return nil
 	}
 
-	if sessionCleanFlags.dryRun {
-		if spinner != nil {
-			spinner.StopWithMessage(fmt.Sprintf("Dry run: Found %d sessions to clean", len(sessionsToClean)))
-		}
-
-		fmt.Printf("Dry run: Would clean %d sessions:\n", len(sessionsToClean))
-		for _, sess := range sessionsToClean {
-			fmt.Printf("  - %s (%s) - Last access: %s\n", sess.Name, sess.ID, sess.LastAccess.Format("2006-01-02 15:04:05"))
+	if dryRun {
+		fmt.Printf("Dry run: Would clean %d sessions:\n", len(remove))
+		for _, d := range remove {
+			fmt.Printf("  - %s (%s) - %s\n", d.Session.Name, d.Session.ID, d.Reason)
 		}
 		return nil
 	}
 
-	// Confirm cleanup
 	if !sessionCleanFlags.force {
-		fmt.Printf("This will clean up %d stale sessions:\n", len(sessionsToClean))
-		for _, sess := range sessionsToClean {
-			fmt.Printf("  - %s (%s)\n", sess.Name, sess.ID)
+		confirmed, err := confirmSessionClean(os.Stdout, remove)
+		if err != nil {
+			return handleCLIError(err)
 		}
-		fmt.Printf("Proceed with cleanup? [y/N]: ")
-		var response string
-		fmt.Scanln(&response)
-		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+		if !confirmed {
 			fmt.Println("Cleanup cancelled")
 			return nil
 		}
 	}
 
-	// Clean up sessions
-	if spinner != nil {
-		spinner.SetMessage(fmt.Sprintf("Cleaning up %d sessions...", len(sessionsToClean)))
+	result := cleanSessions(remove, keep, false, sessionManager.KillSession)
+	for _, item := range result.Skipped[len(keep):] {
+		if !isQuiet() {
+			fmt.Printf("Warning: Failed to clean session %s: %s\n", item.Name, item.Reason)
+		}
+	}
+	if sessionCleanFlags.verbose {
+		for _, item := range result.Removed {
+			fmt.Printf("Removed %s\n", item.Name)
+		}
 	}
 
-	cleanedCount := 0
-	for _, sess := range sessionsToClean {
-		err := sessionManager.KillSession(sess.ID)
-		if err != nil {
-			if sessionCleanFlags.verbose {
-				fmt.Printf("Warning: Failed to clean session %s: %v\n", sess.ID, err)
+	if !isQuiet() {
+		fmt.Printf("Successfully cleaned up %d out of %d sessions\n", len(result.Removed), len(remove))
+	}
+
+	return nil
+}
+
+// confirmSessionClean lists the sessions to remove on w and asks for
+// confirmation, failing when no answer can be read
+func confirmSessionClean(w io.Writer, remove []sessionCleanDecision) (bool, error) {
+	if err := checkConfirmable("the cleanup", forceConfirmSuggestion); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(w, "This will clean up %d stale sessions:\n", len(remove))
+	for _, d := range remove {
+		fmt.Fprintf(w, "  - %s (%s) - %s\n", d.Session.Name, d.Session.ID, d.Reason)
+	}
+	fmt.Fprintf(w, "Proceed with cleanup? [y/N]: ")
+
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(response)
+	return response == "y" || response == "yes", nil
+}
+
+// cleanSessions kills the sessions in remove, unless dryRun is set, and
+// reports the outcome. Kept sessions come first in Skipped, followed by
+// sessions that failed to be killed.
+func cleanSessions(remove, keep []sessionCleanDecision, dryRun bool, kill func(id string) error) CleanupResult {
+	result := CleanupResult{
+		Removed: []CleanupItem{},
+		Skipped: []CleanupItem{},
+		DryRun:  dryRun,
+	}
+
+	for _, d := range keep {
+		result.Skipped = append(result.Skipped, sessionCleanupItem(d.Session, d.Reason))
+	}
+
+	for _, d := range remove {
+		if !dryRun {
+			if err := kill(d.Session.ID); err != nil {
+				result.Skipped = append(result.Skipped, sessionCleanupItem(d.Session, err.Error()))
+				continue
 			}
-		} else {
-			cleanedCount++
 		}
+		result.Removed = append(result.Removed, sessionCleanupItem(d.Session, d.Reason))
 	}
 
-	if spinner != nil {
-		spinner.StopWithMessage(fmt.Sprintf("Cleaned up %d sessions", cleanedCount))
-	}
+	return result
+}
 
-	if !isQuiet() {
-		fmt.Printf("Successfully cleaned up %d out of %d sessions\n", cleanedCount, len(sessionsToClean))
+func sessionCleanupItem(sess *tmux.Session, reason string) CleanupItem {
+	return CleanupItem{Name: sess.Name, Path: sess.Directory, Branch: sess.Branch, Reason: reason}
+}
+
+// sessionCleanDecision records why session clean removes or keeps a session
+type sessionCleanDecision struct {
+	Session *tmux.Session
+	Reason  string
+}
+
+// planSessionClean splits sessions into those to remove and those to keep.
+// Sessions whose worktree directory no longer exists are orphaned and always
+// removed; otherwise a session is removed when it has been idle longer than
+// olderThan, or unconditionally when all is set.
+func planSessionClean(sessions []*tmux.Session, now time.Time, olderThan time.Duration, all bool, dirExists func(string) bool) (remove, keep []sessionCleanDecision) {
+	for _, sess := range sessions {
+		idle := now.Sub(sess.LastAccess).Round(time.Minute)
+
+		switch {
+		case sess.Directory != "" && !dirExists(sess.Directory):
+			remove = append(remove, sessionCleanDecision{Session: sess, Reason: fmt.Sprintf("orphaned, worktree %s no longer exists", sess.Directory)})
+		case all:
+			remove = append(remove, sessionCleanDecision{Session: sess, Reason: "--all given"})
+		case now.Sub(sess.LastAccess) > olderThan:
+			remove = append(remove, sessionCleanDecision{Session: sess, Reason: fmt.Sprintf("idle for %s, longer than %s", idle, olderThan)})
+		default:
+			keep = append(keep, sessionCleanDecision{Session: sess, Reason: fmt.Sprintf("idle for %s, within %s", idle, olderThan)})
+		}
 	}
 
-	return nil
+	return remove, keep
+}
+
+// directoryExists reports whether path exists and is a directory
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
 }
 
 // Helper functions
 
+// collectSessionGitStatus fills in the upstream ahead/behind counts for the
+// session's branch. Branches without an upstream, or that cannot be inspected,
+// are tolerated and reported in the compact status.
+func collectSessionGitStatus(item *SessionListItem, branch string, branchInfo func(string) (*git.BranchInfo, error)) {
+	if branch == "" {
+		item.GitStatus = "unknown"
+		return
+	}
+
+	info, err := branchInfo(branch)
+	if err != nil {
+		item.GitStatus = "unknown"
+		return
+	}
+
+	item.Upstream = info.Upstream
+	item.Ahead = info.Ahead
+	item.Behind = info.Behind
+	item.GitStatus = formatUpstreamStatus(info)
+}
+
+// formatUpstreamStatus renders ahead/behind counts compactly, e.g. "↑2↓1"
+func formatUpstreamStatus(info *git.BranchInfo) string {
+	if info.Upstream == "" {
+		return "no upstream"
+	}
+	if info.Ahead == 0 && info.Behind == 0 {
+		return "✓"
+	}
+
+	status := ""
+	if info.Ahead > 0 {
+		status += fmt.Sprintf("↑%d", info.Ahead)
+	}
+	if info.Behind > 0 {
+		status += fmt.Sprintf("↓%d", info.Behind)
+	}
+	return status
+}
+
 func findWorktreeDirectory(worktreeName string) (string, error) {
 	// This would need to integrate with the git worktree manager
 	// For now, return a placeholder implementation
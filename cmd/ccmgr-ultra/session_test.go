@@ -0,0 +1,470 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+)
+
+func TestCollectSessionGitStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		branch         string
+		info           *git.BranchInfo
+		err            error
+		expectedStatus string
+		expectedAhead  int
+		expectedBehind int
+	}{
+		{
+			name:           "ahead and behind upstream",
+			branch:         "feature/auth",
+			info:           &git.BranchInfo{Name: "feature/auth", Upstream: "origin/feature/auth", Ahead: 2, Behind: 1},
+			expectedStatus: "↑2↓1",
+			expectedAhead:  2,
+			expectedBehind: 1,
+		},
+		{
+			name:           "in sync with upstream",
+			branch:         "main",
+			info:           &git.BranchInfo{Name: "main", Upstream: "origin/main"},
+			expectedStatus: "✓",
+		},
+		{
+			name:           "no upstream",
+			branch:         "local-only",
+			info:           &git.BranchInfo{Name: "local-only"},
+			expectedStatus: "no upstream",
+		},
+		{
+			name:           "branch lookup fails",
+			branch:         "gone",
+			err:            errors.New("branch gone does not exist"),
+			expectedStatus: "unknown",
+		},
+		{
+			name:           "unknown branch",
+			expectedStatus: "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested string
+			lookup := func(branch string) (*git.BranchInfo, error) {
+				requested = branch
+				return tt.info, tt.err
+			}
+
+			item := SessionListItem{Name: "ccmgr-app-" + tt.branch}
+			collectSessionGitStatus(&item, tt.branch, lookup)
+
+			assert.Equal(t, tt.expectedStatus, item.GitStatus)
+			assert.Equal(t, tt.expectedAhead, item.Ahead)
+			assert.Equal(t, tt.expectedBehind, item.Behind)
+			assert.Equal(t, tt.branch, requested)
+			if tt.info != nil {
+				assert.Equal(t, tt.info.Upstream, item.Upstream)
+			}
+		})
+	}
+}
+
+func TestPlanSessionClean(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	existing := map[string]bool{"/work/fresh": true, "/work/stale": true}
+	dirExists := func(path string) bool { return existing[path] }
+
+	sessions := []*tmux.Session{
+		{ID: "fresh", Name: "fresh", Directory: "/work/fresh", LastAccess: now.Add(-time.Hour)},
+		{ID: "stale", Name: "stale", Directory: "/work/stale", LastAccess: now.Add(-30 * time.Hour)},
+		{ID: "orphan", Name: "orphan", Directory: "/work/gone", LastAccess: now.Add(-time.Minute)},
+		{ID: "nodir", Name: "nodir", LastAccess: now.Add(-time.Hour)},
+	}
+
+	names := func(decisions []sessionCleanDecision) []string {
+		var result []string
+		for _, d := range decisions {
+			result = append(result, d.Session.Name)
+		}
+		return result
+	}
+
+	t.Run("age and orphan", func(t *testing.T) {
+		remove, keep := planSessionClean(sessions, now, 24*time.Hour, false, dirExists)
+
+		assert.Equal(t, []string{"stale", "orphan"}, names(remove))
+		assert.Equal(t, []string{"fresh", "nodir"}, names(keep))
+		assert.Contains(t, remove[0].Reason, "idle for 30h0m0s")
+		assert.Contains(t, remove[1].Reason, "orphaned")
+		assert.Contains(t, keep[0].Reason, "within 24h0m0s")
+	})
+
+	t.Run("orphan removed regardless of age", func(t *testing.T) {
+		remove, _ := planSessionClean(sessions, now, 1000*time.Hour, false, dirExists)
+
+		assert.Equal(t, []string{"orphan"}, names(remove))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		remove, keep := planSessionClean(sessions, now, 24*time.Hour, true, dirExists)
+
+		assert.Len(t, remove, len(sessions))
+		assert.Empty(t, keep)
+		assert.Contains(t, remove[2].Reason, "orphaned")
+	})
+}
+
+func TestCleanSessions_JSON(t *testing.T) {
+	remove := []sessionCleanDecision{
+		{Session: &tmux.Session{ID: "s1", Name: "ccmgr-app-old", Directory: "/work/old", Branch: "old"}, Reason: "idle for 30h0m0s, longer than 24h0m0s"},
+		{Session: &tmux.Session{ID: "s2", Name: "ccmgr-app-gone", Directory: "/work/gone"}, Reason: "orphaned, worktree /work/gone no longer exists"},
+	}
+	keep := []sessionCleanDecision{
+		{Session: &tmux.Session{ID: "s3", Name: "ccmgr-app-new", Directory: "/work/new"}, Reason: "idle for 1h0m0s, within 24h0m0s"},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		var killed []string
+		result := cleanSessions(remove, keep, true, func(id string) error {
+			killed = append(killed, id)
+			return nil
+		})
+
+		assert.Empty(t, killed)
+		decoded := formatCleanupJSON(t, result)
+		assert.Equal(t, true, decoded["dry_run"])
+		assert.Equal(t, map[string]interface{}{
+			"name":   "ccmgr-app-old",
+			"path":   "/work/old",
+			"branch": "old",
+			"reason": "idle for 30h0m0s, longer than 24h0m0s",
+		}, decoded["removed"].([]interface{})[0])
+		assert.Len(t, decoded["removed"], 2)
+		assert.Len(t, decoded["skipped"], 1)
+	})
+
+	t.Run("real run", func(t *testing.T) {
+		var killed []string
+		result := cleanSessions(remove, keep, false, func(id string) error {
+			if id == "s2" {
+				return errors.New("session not found")
+			}
+			killed = append(killed, id)
+			return nil
+		})
+
+		assert.Equal(t, []string{"s1"}, killed)
+		decoded := formatCleanupJSON(t, result)
+		assert.Equal(t, false, decoded["dry_run"])
+		assert.Len(t, decoded["removed"], 1)
+		skipped := decoded["skipped"].([]interface{})
+		require.Len(t, skipped, 2)
+		assert.Equal(t, "ccmgr-app-gone", skipped[1].(map[string]interface{})["name"])
+		assert.Equal(t, "session not found", skipped[1].(map[string]interface{})["reason"])
+	})
+}
+
+func TestShouldAttachNewSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		detached bool
+		terminal bool
+		expected bool
+	}{
+		{name: "terminal attaches", terminal: true, expected: true},
+		{name: "detached on terminal stays detached", detached: true, terminal: true, expected: false},
+		{name: "no terminal stays detached", terminal: false, expected: false},
+		{name: "detached without terminal stays detached", detached: true, terminal: false, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, shouldAttachNewSession(tt.detached, tt.terminal))
+		})
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "stdout")
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.False(t, isTerminal(f), "a regular file is not a terminal")
+}
+
+func TestSessionExportImport_RoundTrip(t *testing.T) {
+	worktrees := []git.WorktreeInfo{
+		{Path: "/work/app", Branch: "main"},
+		{Path: "/work/app-login", Branch: "feature/login"},
+		{Path: "/work/app-detached", Head: "abc1234"},
+	}
+	sessions := []*tmux.Session{
+		{ID: "ccmgr-app-main", Name: "ccmgr-app-main", Directory: "/work/app"},
+		{ID: "ccmgr-app-login", Name: "ccmgr-app-login", Directory: "/work/app-login/web"},
+		{ID: "ccmgr-app-scratch", Name: "ccmgr-app-scratch", Directory: "/work/app-detached"},
+		{ID: "ccmgr-other-main", Name: "ccmgr-other-main", Directory: "/work/other"},
+	}
+
+	matches := matchSessionWorktrees(sessions, worktrees)
+	require.Len(t, matches, 3)
+
+	exported := &SessionExportData{}
+	for i, match := range matches {
+		definition := tmux.SessionDefinition{Name: match.session.Name, Worktree: worktreeKey(match.worktree)}
+		if i == 1 {
+			definition.Windows = []tmux.WindowDefinition{{Name: "editor", Command: "nvim ."}, {Command: "npm run dev"}}
+		}
+		exported.Sessions = append(exported.Sessions, definition)
+	}
+	assert.Equal(t, "feature/login", exported.Sessions[1].Worktree)
+	assert.Equal(t, "app-detached", exported.Sessions[2].Worktree)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeSessionExport(&buf, exported))
+	imported, err := readSessionExport(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, exported, imported)
+
+	plans, skipped, err := planSessionImport(imported.Sessions, worktrees, 50, func(string) bool { return false })
+	require.NoError(t, err)
+	assert.Empty(t, skipped)
+	require.Len(t, plans, 3)
+	for i, plan := range plans {
+		assert.Equal(t, exported.Sessions[i], plan.definition)
+		assert.Equal(t, matches[i].worktree, plan.worktree)
+	}
+}
+
+func TestPlanSessionImport_Skips(t *testing.T) {
+	worktrees := []git.WorktreeInfo{{Path: "/work/app", Branch: "main"}}
+	definitions := []tmux.SessionDefinition{
+		{Name: "ccmgr-app-main", Worktree: "main"},
+		{Name: "ccmgr-app-running", Worktree: "main"},
+		{Name: "ccmgr-app-gone", Worktree: "feature/gone"},
+		{Name: "ccmgr-app-main", Worktree: "main"},
+	}
+
+	plans, skipped, err := planSessionImport(definitions, worktrees, 50, func(name string) bool { return name == "ccmgr-app-running" })
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	assert.Equal(t, "ccmgr-app-main", plans[0].definition.Name)
+	assert.Equal(t, []sessionImportSkip{
+		{name: "ccmgr-app-running", reason: "already exists"},
+		{name: "ccmgr-app-gone", reason: "no worktree for 'feature/gone'"},
+		{name: "ccmgr-app-main", reason: "already exists"},
+	}, skipped)
+}
+
+func TestPlanSessionImport_ValidatesNames(t *testing.T) {
+	worktrees := []git.WorktreeInfo{{Path: "/work/app", Branch: "main"}}
+	definitions := []tmux.SessionDefinition{
+		{Name: "ccmgr-app-main", Worktree: "main"},
+		{Name: "ccmgr-app-a-much-longer-session-name", Worktree: "main"},
+		{Name: "ccmgr-app:bad", Worktree: "main"},
+	}
+
+	plans, _, err := planSessionImport(definitions, worktrees, 20, func(string) bool { return false })
+	require.Error(t, err)
+	assert.Nil(t, plans)
+	assert.Contains(t, err.Error(), "exceeding the maximum of 20")
+	assert.Contains(t, err.Error(), "characters not allowed")
+}
+
+func TestReadSessionExport_RejectsUnknownFields(t *testing.T) {
+	_, err := readSessionExport(strings.NewReader("sessions:\n  - name: ccmgr-app-main\n    worktre: main\n"))
+	assert.Error(t, err)
+
+	data, err := readSessionExport(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, data.Sessions)
+}
+
+func TestSessionStateError(t *testing.T) {
+	err := sessionStateError("failed to load session state", fmt.Errorf("%w (waited 5s)", tmux.ErrStateLocked))
+	assert.Contains(t, err.Error(), "another ccmgr-ultra instance is holding the state file")
+	assert.Contains(t, err.Suggestion, "retry")
+
+	err = sessionStateError("failed to rename session", errors.New("tmux failed"))
+	assert.Empty(t, err.Suggestion)
+}
+
+func TestSelectSessionsToKill(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	sessions := []*tmux.Session{
+		{ID: "ccmgr-app-main", Name: "ccmgr-app-main", Active: true, LastAccess: now.Add(-5 * time.Hour)},
+		{ID: "ccmgr-app-old", Name: "ccmgr-app-old", LastAccess: now.Add(-2 * time.Hour)},
+		{ID: "ccmgr-app-recent", Name: "ccmgr-app-recent", LastAccess: now.Add(-10 * time.Minute)},
+		{ID: "ccmgr-web-old", Name: "ccmgr-web-old", LastAccess: now.Add(-3 * time.Hour)},
+	}
+
+	names := func(selected []*tmux.Session) []string {
+		var result []string
+		for _, sess := range selected {
+			result = append(result, sess.Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		pattern  string
+		allStale bool
+		expected []string
+	}{
+		{"pattern", "ccmgr-app-*", false, []string{"ccmgr-app-main", "ccmgr-app-old", "ccmgr-app-recent"}},
+		{"pattern with character class", "ccmgr-[aw]*-old", false, []string{"ccmgr-app-old", "ccmgr-web-old"}},
+		{"pattern without matches", "other-*", false, nil},
+		{"all stale", "", true, []string{"ccmgr-app-old", "ccmgr-web-old"}},
+		{"pattern and stale", "ccmgr-app-*", true, []string{"ccmgr-app-old"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := selectSessionsToKill(sessions, tt.pattern, tt.allStale, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, names(selected))
+		})
+	}
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := selectSessionsToKill(sessions, "ccmgr-[", false, now)
+		assert.Error(t, err)
+	})
+}
+
+func TestSessionStatus(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "active", sessionStatus(&tmux.Session{Active: true, LastAccess: now.Add(-5 * time.Hour)}, now))
+	assert.Equal(t, "stale", sessionStatus(&tmux.Session{LastAccess: now.Add(-61 * time.Minute)}, now))
+	assert.Equal(t, "idle", sessionStatus(&tmux.Session{LastAccess: now.Add(-59 * time.Minute)}, now))
+}
+
+func TestSessionKillArgs(t *testing.T) {
+	defer func() { sessionKillFlags.pattern, sessionKillFlags.allStale = "", false }()
+
+	assert.NoError(t, sessionKillArgs(sessionKillCmd, []string{"ccmgr-app-main"}))
+	assert.Error(t, sessionKillArgs(sessionKillCmd, nil))
+
+	sessionKillFlags.pattern = "ccmgr-*"
+	assert.NoError(t, sessionKillArgs(sessionKillCmd, nil))
+	assert.Error(t, sessionKillArgs(sessionKillCmd, []string{"ccmgr-app-main"}))
+
+	sessionKillFlags.pattern, sessionKillFlags.allStale = "", true
+	assert.NoError(t, sessionKillArgs(sessionKillCmd, nil))
+}
+
+func TestKillSessions(t *testing.T) {
+	sessions := []*tmux.Session{
+		{ID: "s1", Name: "ccmgr-app-one"},
+		{ID: "s2", Name: "ccmgr-app-two"},
+		{ID: "s3", Name: "ccmgr-app-three"},
+	}
+
+	var killed []string
+	results := killSessions(sessions, func(id string) error {
+		killed = append(killed, id)
+		if id == "s2" {
+			return errors.New("session not found")
+		}
+		return nil
+	})
+
+	assert.Equal(t, []string{"s1", "s2", "s3"}, killed)
+	require.Len(t, results, 3)
+	assert.NoError(t, results[0].Err)
+	assert.EqualError(t, results[1].Err, "session not found")
+	assert.Equal(t, "ccmgr-app-two", results[1].Session.Name)
+	assert.NoError(t, results[2].Err)
+}
+
+func TestNewSessionKiller_Cleanup(t *testing.T) {
+	mock := &mockClaudeController{processes: []*claude.ProcessInfo{
+		{PID: 101, TmuxSession: "ccmgr-app-main"},
+		{PID: 202, TmuxSession: "ccmgr-app-other"},
+		{PID: 303, TmuxSession: "ccmgr-app-main"},
+	}}
+	useMockClaudeController(t, mock)
+
+	kill := newSessionKiller(config.DefaultConfig(), func(id string) error {
+		mock.events = append(mock.events, "kill "+id)
+		return nil
+	}, true, 30*time.Second)
+
+	require.NoError(t, kill("ccmgr-app-main"))
+	assert.Equal(t, []string{
+		"interrupt 101",
+		"interrupt 303",
+		"wait 101",
+		"wait 303",
+		"kill ccmgr-app-main",
+	}, mock.events)
+	assert.LessOrEqual(t, mock.lastTimeout, 30*time.Second)
+	assert.Greater(t, mock.lastTimeout, time.Duration(0))
+}
+
+func TestNewSessionKiller_KillsWhenProcessesKeepRunning(t *testing.T) {
+	mock := &mockClaudeController{
+		processes: []*claude.ProcessInfo{{PID: 101, TmuxSession: "ccmgr-app-main"}},
+		running:   map[int]bool{101: true},
+	}
+	useMockClaudeController(t, mock)
+
+	kill := newSessionKiller(config.DefaultConfig(), func(id string) error {
+		mock.events = append(mock.events, "kill "+id)
+		return errors.New("session not found")
+	}, true, time.Second)
+
+	assert.EqualError(t, kill("ccmgr-app-main"), "session not found")
+	assert.Equal(t, []string{"interrupt 101", "wait 101", "kill ccmgr-app-main"}, mock.events)
+}
+
+func TestNewSessionKiller_WithoutCleanup(t *testing.T) {
+	mock := &mockClaudeController{processes: []*claude.ProcessInfo{{PID: 101, TmuxSession: "ccmgr-app-main"}}}
+	useMockClaudeController(t, mock)
+
+	var killed []string
+	kill := newSessionKiller(config.DefaultConfig(), func(id string) error {
+		killed = append(killed, id)
+		return nil
+	}, false, time.Second)
+
+	require.NoError(t, kill("ccmgr-app-main"))
+	assert.Equal(t, []string{"ccmgr-app-main"}, killed)
+	assert.Empty(t, mock.events)
+}
+
+func TestInterruptProcesses(t *testing.T) {
+	processes := []*claude.ProcessInfo{{PID: 101}, {PID: 202}}
+
+	t.Run("all exit", func(t *testing.T) {
+		mock := &mockClaudeController{}
+		assert.NoError(t, interruptProcesses(mock, processes, time.Second))
+	})
+
+	t.Run("still running", func(t *testing.T) {
+		mock := &mockClaudeController{running: map[int]bool{202: true}}
+		err := interruptProcesses(mock, processes, time.Second)
+		assert.ErrorContains(t, err, "process 202 did not exit within 1s")
+		assert.NotContains(t, err.Error(), "process 101")
+	})
+
+	t.Run("interrupt fails", func(t *testing.T) {
+		mock := &mockClaudeController{err: errors.New("operation not permitted")}
+		err := interruptProcesses(mock, processes, time.Second)
+		assert.ErrorContains(t, err, "process 101: operation not permitted")
+		assert.Equal(t, []string{"interrupt 101", "interrupt 202"}, mock.events)
+	})
+}
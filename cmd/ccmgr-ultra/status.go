@@ -133,7 +133,7 @@ func runStatusCommand(cmd *cobra.Command, args []string) error {
 }
 
 func runWatchMode() error {
-	if !shouldShowProgress() {
+	if isQuiet() || !cli.ShouldShowProgress() {
 		return cli.NewError("watch mode requires interactive terminal")
 	}
 
@@ -200,10 +200,7 @@ func collectStatusData() (*StatusData, error) {
 		spinner.SetMessage("Collecting worktree information...")
 	}
 
-	// Create repository manager and detect repository
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
 		if isVerbose() {
 			fmt.Printf("Warning: Failed to detect repository: %v\n", err)
@@ -1,10 +1,17 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -29,13 +36,79 @@ type WorktreeListItem struct {
 	Branch       string    `json:"branch" yaml:"branch"`
 	Head         string    `json:"head" yaml:"head"`
 	Status       string    `json:"status" yaml:"status"`
+	Detached     bool      `json:"detached" yaml:"detached"`
 	IsClean      bool      `json:"is_clean" yaml:"is_clean"`
 	TmuxSession  string    `json:"tmux_session" yaml:"tmux_session"`
+	Sessions     []string  `json:"sessions,omitempty" yaml:"sessions,omitempty"`
 	ProcessCount int       `json:"process_count" yaml:"process_count"`
+	Ahead        int       `json:"ahead" yaml:"ahead"`
+	Behind       int       `json:"behind" yaml:"behind"`
+	Staged       int       `json:"staged" yaml:"staged"`
+	Modified     int       `json:"modified" yaml:"modified"`
+	Untracked    int       `json:"untracked" yaml:"untracked"`
+	Conflicted   int       `json:"conflicted" yaml:"conflicted"`
 	LastAccessed time.Time `json:"last_accessed" yaml:"last_accessed"`
 	Created      time.Time `json:"created" yaml:"created"`
 }
 
+// PullRequestListData represents data for worktree pr-list output
+type PullRequestListData struct {
+	PullRequests []PullRequestListItem `json:"pull_requests" yaml:"pull_requests"`
+	Total        int                   `json:"total" yaml:"total"`
+	Timestamp    time.Time             `json:"timestamp" yaml:"timestamp"`
+}
+
+// PullRequestListItem represents a single open pull request in list output
+type PullRequestListItem struct {
+	Number       int      `json:"number" yaml:"number"`
+	Title        string   `json:"title" yaml:"title"`
+	Author       string   `json:"author" yaml:"author"`
+	Draft        bool     `json:"draft" yaml:"draft"`
+	SourceBranch string   `json:"source_branch" yaml:"source_branch"`
+	TargetBranch string   `json:"target_branch" yaml:"target_branch"`
+	Worktree     string   `json:"worktree,omitempty" yaml:"worktree,omitempty"`
+	Labels       []string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	URL          string   `json:"url" yaml:"url"`
+}
+
+// WorktreeStatusData represents data for worktree status output
+type WorktreeStatusData struct {
+	Name          string               `json:"name" yaml:"name"`
+	Path          string               `json:"path" yaml:"path"`
+	Branch        string               `json:"branch" yaml:"branch"`
+	Head          string               `json:"head" yaml:"head"`
+	Detached      bool                 `json:"detached" yaml:"detached"`
+	Upstream      string               `json:"upstream,omitempty" yaml:"upstream,omitempty"`
+	Ahead         int                  `json:"ahead" yaml:"ahead"`
+	Behind        int                  `json:"behind" yaml:"behind"`
+	IsClean       bool                 `json:"is_clean" yaml:"is_clean"`
+	Staged        int                  `json:"staged" yaml:"staged"`
+	Modified      int                  `json:"modified" yaml:"modified"`
+	Untracked     int                  `json:"untracked" yaml:"untracked"`
+	Conflicted    int                  `json:"conflicted" yaml:"conflicted"`
+	Files         []WorktreeFileStatus `json:"files" yaml:"files"`
+	Sessions      []string             `json:"sessions" yaml:"sessions"`
+	Processes     []ClaudeProcessItem  `json:"processes" yaml:"processes"`
+	RecentCommits []WorktreeCommitItem `json:"recent_commits" yaml:"recent_commits"`
+	LastAccessed  time.Time            `json:"last_accessed" yaml:"last_accessed"`
+	Created       time.Time            `json:"created" yaml:"created"`
+	Timestamp     time.Time            `json:"timestamp" yaml:"timestamp"`
+}
+
+// WorktreeFileStatus is a changed file with its porcelain status code
+type WorktreeFileStatus struct {
+	Path   string `json:"path" yaml:"path"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// WorktreeCommitItem represents a single commit in worktree status output
+type WorktreeCommitItem struct {
+	Hash    string    `json:"hash" yaml:"hash"`
+	Author  string    `json:"author" yaml:"author"`
+	Date    time.Time `json:"date" yaml:"date"`
+	Message string    `json:"message" yaml:"message"`
+}
+
 var worktreeCmd = &cobra.Command{
 	Use:   "worktree",
 	Short: "Manage git worktrees",
@@ -44,7 +117,10 @@ var worktreeCmd = &cobra.Command{
 - Create new worktrees with optional tmux session
 - Delete worktrees with cleanup of related resources
 - Merge worktree changes back to main branch
-- Push worktree branches with PR creation support`,
+- Prune worktrees that have not been used recently
+- Push worktree branches with PR creation support
+- List open pull requests for local branches
+- Show full detail for a single worktree`,
 }
 
 // Worktree list command
@@ -53,19 +129,25 @@ var worktreeListCmd = &cobra.Command{
 	Short: "List all git worktrees",
 	Long: `List all git worktrees with comprehensive status information including:
 - Branch and HEAD commit information
-- Clean/dirty status
-- Associated tmux sessions
+- Clean/dirty status, or detached when HEAD is not on a branch
+- Ahead/behind counts
+- Staged/modified/untracked/conflicted file counts (with --with-status)
+- Associated tmux sessions (all of them with --active-sessions)
 - Claude Code process information
 - Last accessed timestamps`,
 	RunE: runWorktreeListCommand,
 }
 
 var worktreeListFlags struct {
-	format        string
-	status        string
-	branch        string
-	withProcesses bool
-	sort          string
+	format         string
+	status         string
+	branch         string
+	withProcesses  bool
+	withStatus     bool
+	activeSessions bool
+	sort           string
+	jobs           int
+	relative       bool
 }
 
 // Worktree create command
@@ -74,18 +156,28 @@ var worktreeCreateCmd = &cobra.Command{
 	Short: "Create a new git worktree",
 	Long: `Create a new git worktree from specified or current branch.
 Automatically generates worktree directory using configured pattern.
-Optionally starts tmux session and Claude Code process.`,
+Optionally starts tmux session and Claude Code process.
+Use --session-name to choose the tmux session name explicitly.
+If a step after adding the worktree fails, the worktree is removed again
+unless --keep-on-failure is given; re-running the command then resumes it.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWorktreeCreateCommand,
 }
 
 var worktreeCreateFlags struct {
-	base         string
-	directory    string
-	startSession bool
-	startClaude  bool
-	remote       bool
-	force        bool
+	base          string
+	directory     string
+	baseDirectory string
+	noAutoDir     bool
+	suffix        bool
+	startSession  bool
+	sessionName   string
+	startClaude   bool
+	prompt        string
+	remote        bool
+	track         string
+	force         bool
+	keepOnFailure bool
 }
 
 // Worktree delete command
@@ -94,7 +186,10 @@ var worktreeDeleteCmd = &cobra.Command{
 	Short: "Delete a git worktree",
 	Long: `Delete specified worktree with safety checks.
 Handles active tmux sessions and Claude Code processes.
-Optionally cleans up related sessions and processes.`,
+Optionally cleans up related sessions and processes.
+Worktrees on protected branches (git.protected_branches) are only deleted
+with --force when git.allow_force_delete is true, after a confirmation
+when git.confirm_destructive is true.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWorktreeDeleteCommand,
 }
@@ -112,8 +207,13 @@ var worktreeMergeCmd = &cobra.Command{
 	Use:   "merge <worktree> [flags]",
 	Short: "Merge worktree changes back to target branch",
 	Long: `Merge worktree changes back to main/target branch.
+Supports merge, squash and rebase strategies.
 Handles merge conflicts with clear guidance.
-Optionally pushes changes before merging.`,
+Optionally pushes changes before merging.
+Use --into to merge into another worktree's branch instead; the merge
+then runs inside that worktree's directory so its working copy updates.
+Rebasing a protected branch, or deleting its worktree with --delete-after,
+requires --force and git.allow_force_delete.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWorktreeMergeCommand,
 }
@@ -124,6 +224,42 @@ var worktreeMergeFlags struct {
 	deleteAfter bool
 	pushFirst   bool
 	message     string
+	into        string
+	force       bool
+}
+
+// Worktree prune command
+var worktreePruneCmd = &cobra.Command{
+	Use:   "prune [flags]",
+	Short: "Delete worktrees that have not been used recently",
+	Long: `Delete worktrees whose last access is older than the configured
+cleanup age (git.cleanup_age, default 7 days) or --older-than.
+Worktrees on protected branches are always skipped; worktrees with
+uncommitted changes are skipped unless --force is given.`,
+	Args: cobra.NoArgs,
+	RunE: runWorktreePruneCommand,
+}
+
+var worktreePruneFlags struct {
+	olderThan time.Duration
+	force     bool
+	format    string
+}
+
+// Worktree pr-list command
+var worktreePRListCmd = &cobra.Command{
+	Use:   "pr-list [flags]",
+	Short: "List open pull requests for local branches",
+	Long: `List the open pull requests of the origin repository whose source
+branch exists locally, along with the worktree each branch is checked
+out in. Requires a GitHub token (github_token or GITHUB_TOKEN), or a
+GitLab token for GitLab repositories.`,
+	Args: cobra.NoArgs,
+	RunE: runWorktreePRListCommand,
+}
+
+var worktreePRListFlags struct {
+	format string
 }
 
 // Worktree push command
@@ -138,28 +274,114 @@ Handles remote tracking setup if needed.`,
 }
 
 var worktreePushFlags struct {
-	createPR bool
-	prTitle  string
-	prBody   string
-	draft    bool
-	reviewer string
-	force    bool
+	createPR  bool
+	prTitle   string
+	prBody    string
+	draft     bool
+	reviewer  string
+	labels    []string
+	assignees []string
+	force     bool
+}
+
+// Worktree open command
+var worktreeOpenCmd = &cobra.Command{
+	Use:   "open <worktree> [flags]",
+	Short: "Open a worktree in its tmux session or an editor",
+	Long: `Open a worktree by name, branch or path.
+Attaches to the worktree's tmux session if one is running. Otherwise
+creates one with --start-session, or prints how to change into it.
+With --editor, opens the worktree in your editor instead.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorktreeOpenCommand,
+}
+
+var worktreeOpenFlags struct {
+	startSession bool
+	editor       bool
+}
+
+// Worktree restore command
+var worktreeRestoreCmd = &cobra.Command{
+	Use:   "restore [backup-id] [flags]",
+	Short: "Recreate a deleted worktree from a backup",
+	Long: `Recreate a worktree deleted with uncommitted changes from the backup
+taken by git.backup_on_delete. Without a backup id, lists the backups
+available for this repository. With one, checks the branch out again at
+the original path (or --directory), recreating it at the backed up commit
+if it was deleted, and reapplies the uncommitted changes.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorktreeRestoreCommand,
+}
+
+var worktreeRestoreFlags struct {
+	directory string
+}
+
+// Worktree status command
+var worktreeStatusCmd = &cobra.Command{
+	Use:   "status <worktree> [flags]",
+	Short: "Show full detail for one worktree",
+	Long: `Show everything known about a single worktree, found by name, branch
+or path:
+- Branch, HEAD and upstream with ahead/behind counts
+- Staged/modified/untracked/conflicted file counts and the changed files
+- tmux sessions running within the worktree
+- Claude Code processes and their state
+- Recent commits`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorktreeStatusCommand,
+}
+
+var worktreeStatusFlags struct {
+	format  string
+	commits int
+}
+
+// Worktree pattern-preview command
+var worktreePatternPreviewCmd = &cobra.Command{
+	Use:   "pattern-preview [flags]",
+	Short: "Preview the directory names a worktree pattern produces",
+	Long: `Validate a worktree directory pattern and show the directory names it
+produces for a few example branches, along with the template variables and
+functions it can use. Without --pattern, previews the configured
+directory_pattern. Nothing is created.`,
+	Args: cobra.NoArgs,
+	RunE: runWorktreePatternPreviewCommand,
+}
+
+var worktreePatternPreviewFlags struct {
+	pattern string
 }
 
 func init() {
 	// List command flags
 	worktreeListCmd.Flags().StringVarP(&worktreeListFlags.format, "format", "f", "table", "Output format (table, json, yaml, compact)")
-	worktreeListCmd.Flags().StringVarP(&worktreeListFlags.status, "status", "s", "", "Filter by status (clean, dirty, active, stale)")
+	worktreeListCmd.Flags().StringVarP(&worktreeListFlags.status, "status", "s", "", "Filter by status (clean, dirty, active, detached, stale)")
 	worktreeListCmd.Flags().StringVarP(&worktreeListFlags.branch, "branch", "b", "", "Filter by branch name pattern")
 	worktreeListCmd.Flags().BoolVar(&worktreeListFlags.withProcesses, "with-processes", false, "Include Claude Code process information")
+	worktreeListCmd.Flags().BoolVar(&worktreeListFlags.withStatus, "with-status", false, "Include staged, modified, untracked and conflicted file counts")
+	worktreeListCmd.Flags().BoolVar(&worktreeListFlags.activeSessions, "active-sessions", false, "Include all tmux sessions running within each worktree")
 	worktreeListCmd.Flags().StringVar(&worktreeListFlags.sort, "sort", "name", "Sort by (name, last-accessed, created, status)")
+	worktreeListCmd.Flags().BoolVar(&worktreeListFlags.relative, "relative", false, "Show a Path column relative to the worktree base directory or repository root (table only; json/yaml keep absolute paths)")
+	worktreeListCmd.Flags().IntVar(&worktreeListFlags.jobs, "jobs", 0, "Number of worktrees to query in parallel (default: number of CPUs)")
 
 	// Create command flags
 	worktreeCreateCmd.Flags().StringVarP(&worktreeCreateFlags.base, "base", "b", "", "Base branch for new worktree (default: current branch)")
 	worktreeCreateCmd.Flags().StringVarP(&worktreeCreateFlags.directory, "directory", "d", "", "Custom worktree directory path")
+	worktreeCreateCmd.Flags().StringVar(&worktreeCreateFlags.baseDirectory, "base-directory", "", "Base directory for the generated worktree path, overriding worktree.base_directory (supports templates like ../elsewhere/{{.Project}})")
+	worktreeCreateCmd.Flags().BoolVar(&worktreeCreateFlags.noAutoDir, "no-auto-directory", false, "Use the --directory path as given, bypassing the base directory and directory pattern")
+	worktreeCreateCmd.Flags().BoolVar(&worktreeCreateFlags.suffix, "suffix-on-collision", false, "Append -2, -3, ... to the generated path if it already exists (see worktree.auto_suffix_on_collision)")
 	worktreeCreateCmd.Flags().BoolVarP(&worktreeCreateFlags.startSession, "start-session", "s", false, "Automatically start tmux session")
+	worktreeCreateCmd.Flags().StringVar(&worktreeCreateFlags.sessionName, "session-name", "", "Name for the tmux session (implies --start-session)")
 	worktreeCreateCmd.Flags().BoolVar(&worktreeCreateFlags.startClaude, "start-claude", false, "Automatically start Claude Code in new session")
+	worktreeCreateCmd.Flags().StringVar(&worktreeCreateFlags.prompt, "prompt", "", "Initial prompt to send to Claude Code once started (used with --start-claude)")
 	worktreeCreateCmd.Flags().BoolVarP(&worktreeCreateFlags.remote, "remote", "r", false, "Track remote branch if exists")
+	worktreeCreateCmd.Flags().StringVar(&worktreeCreateFlags.track, "track", "", "Create the branch tracking this remote branch (<remote>/<branch>), fetching it if needed")
+	worktreeCreateCmd.MarkFlagsMutuallyExclusive("track", "remote")
+	worktreeCreateCmd.MarkFlagsMutuallyExclusive("no-auto-directory", "base-directory")
+	worktreeCreateCmd.MarkFlagsMutuallyExclusive("no-auto-directory", "suffix-on-collision")
+	worktreeCreateCmd.Flags().BoolVar(&worktreeCreateFlags.keepOnFailure, "keep-on-failure", false, "Keep a partially created worktree if a later step fails, so it can be resumed")
 	worktreeCreateCmd.Flags().BoolVar(&worktreeCreateFlags.force, "force", false, "Overwrite existing worktree if present")
 
 	// Delete command flags
@@ -175,21 +397,53 @@ func init() {
 	worktreeMergeCmd.Flags().BoolVar(&worktreeMergeFlags.deleteAfter, "delete-after", false, "Delete worktree after successful merge")
 	worktreeMergeCmd.Flags().BoolVar(&worktreeMergeFlags.pushFirst, "push-first", false, "Push worktree branch before merging")
 	worktreeMergeCmd.Flags().StringVarP(&worktreeMergeFlags.message, "message", "m", "", "Custom merge commit message")
+	worktreeMergeCmd.Flags().StringVar(&worktreeMergeFlags.into, "into", "", "Merge into another worktree's branch instead of the target branch")
+	worktreeMergeCmd.Flags().BoolVarP(&worktreeMergeFlags.force, "force", "f", false, "Allow rebasing or deleting a worktree on a protected branch")
+
+	// Prune command flags
+	worktreePruneCmd.Flags().DurationVar(&worktreePruneFlags.olderThan, "older-than", 0, "Prune worktrees not accessed within this duration, e.g. 72h (default: git.cleanup_age)")
+	worktreePruneCmd.Flags().BoolVarP(&worktreePruneFlags.force, "force", "f", false, "Skip confirmation and prune worktrees with uncommitted changes")
+	worktreePruneCmd.Flags().StringVar(&worktreePruneFlags.format, "format", "table", "Output format (table, json, yaml)")
+
+	// PR list command flags
+	worktreePRListCmd.Flags().StringVarP(&worktreePRListFlags.format, "format", "f", "table", "Output format (table, json, yaml)")
 
 	// Push command flags
 	worktreePushCmd.Flags().BoolVar(&worktreePushFlags.createPR, "create-pr", false, "Create pull request after push")
 	worktreePushCmd.Flags().StringVar(&worktreePushFlags.prTitle, "pr-title", "", "Pull request title")
 	worktreePushCmd.Flags().StringVar(&worktreePushFlags.prBody, "pr-body", "", "Pull request body")
 	worktreePushCmd.Flags().BoolVar(&worktreePushFlags.draft, "draft", false, "Create draft pull request")
-	worktreePushCmd.Flags().StringVar(&worktreePushFlags.reviewer, "reviewer", "", "Add reviewers to pull request")
+	worktreePushCmd.Flags().StringVar(&worktreePushFlags.reviewer, "reviewer", "", "Comma-separated reviewers to request on the pull request (use org/team for teams)")
+	worktreePushCmd.Flags().StringArrayVar(&worktreePushFlags.labels, "label", nil, "Label to add to the pull request (repeatable)")
+	worktreePushCmd.Flags().StringArrayVar(&worktreePushFlags.assignees, "assignee", nil, "User to assign to the pull request (repeatable)")
 	worktreePushCmd.Flags().BoolVar(&worktreePushFlags.force, "force", false, "Force push (use with caution)")
 
+	// Open command flags
+	worktreeOpenCmd.Flags().BoolVarP(&worktreeOpenFlags.startSession, "start-session", "s", false, "Create a tmux session if the worktree has none")
+	worktreeOpenCmd.Flags().BoolVarP(&worktreeOpenFlags.editor, "editor", "e", false, "Open the worktree in $EDITOR instead of tmux")
+
+	// Restore command flags
+	worktreeRestoreCmd.Flags().StringVarP(&worktreeRestoreFlags.directory, "directory", "d", "", "Restore into this path instead of the original worktree path")
+
+	// Status command flags
+	worktreeStatusCmd.Flags().StringVarP(&worktreeStatusFlags.format, "format", "f", "table", "Output format (table, json, yaml)")
+	worktreeStatusCmd.Flags().IntVar(&worktreeStatusFlags.commits, "commits", 5, "Number of recent commits to show")
+
+	// Pattern preview command flags
+	worktreePatternPreviewCmd.Flags().StringVarP(&worktreePatternPreviewFlags.pattern, "pattern", "p", "", "Pattern to preview (default: the configured directory_pattern)")
+
 	// Add subcommands to worktree command
 	worktreeCmd.AddCommand(worktreeListCmd)
 	worktreeCmd.AddCommand(worktreeCreateCmd)
 	worktreeCmd.AddCommand(worktreeDeleteCmd)
 	worktreeCmd.AddCommand(worktreeMergeCmd)
+	worktreeCmd.AddCommand(worktreePruneCmd)
 	worktreeCmd.AddCommand(worktreePushCmd)
+	worktreeCmd.AddCommand(worktreePRListCmd)
+	worktreeCmd.AddCommand(worktreeOpenCmd)
+	worktreeCmd.AddCommand(worktreeRestoreCmd)
+	worktreeCmd.AddCommand(worktreeStatusCmd)
+	worktreeCmd.AddCommand(worktreePatternPreviewCmd)
 
 	// Add worktree command to root
 	rootCmd.AddCommand(worktreeCmd)
@@ -208,12 +462,9 @@ func runWorktreeListCommand(cmd *cobra.Command, args []string) error {
 		defer spinner.Stop()
 	}
 
-	// Initialize git repository manager
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
-		return handleCLIError(cli.NewErrorWithCause("failed to detect git repository", err))
+		return handleCLIError(err)
 	}
 
 	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
@@ -224,7 +475,6 @@ func runWorktreeListCommand(cmd *cobra.Command, args []string) error {
 
 	// Convert to list format
 	listData := &WorktreeListData{
-		Worktrees: make([]WorktreeListItem, 0, len(worktrees)),
 		Total:     len(worktrees),
 		Timestamp: time.Now(),
 	}
@@ -239,41 +489,27 @@ func runWorktreeListCommand(cmd *cobra.Command, args []string) error {
 	sessionManager := tmux.NewSessionManager(cfg)
 	sessions, _ := sessionManager.ListSessions()
 
-	for _, wt := range worktrees {
-		item := WorktreeListItem{
-			Name:         filepath.Base(wt.Path),
-			Path:         wt.Path,
-			Branch:       wt.Branch,
-			Head:         wt.Head,
-			IsClean:      wt.IsClean,
-			TmuxSession:  wt.TmuxSession,
-			LastAccessed: wt.LastAccessed,
-			Created:      wt.Created,
-		}
-
-		// Determine status
-		if wt.IsClean {
-			item.Status = "clean"
-		} else {
-			item.Status = "dirty"
-		}
+	items := make([]WorktreeListItem, len(worktrees))
+	for i, wt := range worktrees {
+		items[i] = newWorktreeListItem(wt, sessions, worktreeListFlags.activeSessions)
+	}
 
-		// Check if worktree has active session
-		for _, sess := range sessions {
-			if sess.Worktree == item.Name || strings.Contains(sess.Directory, item.Path) {
-				item.Status = "active"
-				break
-			}
+	// Query git status and process counts for all worktrees concurrently
+	var progress func(done int)
+	if spinner != nil {
+		progress = func(done int) {
+			spinner.SetMessage(fmt.Sprintf("Collecting worktree information (%d/%d)...", done, len(items)))
 		}
+	}
+	collectWorktreeDetails(items, worktreeListFlags.jobs, func(item *WorktreeListItem) {
+		collectWorktreeGitStatus(item, git.NewGitOperationsInDir(repo, gitCmd, item.Path), worktreeListFlags.withStatus)
 
-		// Get process count if requested
 		if processManager != nil {
 			processes := processManager.GetProcessesByWorktree(item.Name)
 			item.ProcessCount = len(processes)
 		}
-
-		listData.Worktrees = append(listData.Worktrees, item)
-	}
+	}, progress)
+	listData.Worktrees = items
 
 	// Apply filters
 	if worktreeListFlags.status != "" {
@@ -299,13 +535,24 @@ func runWorktreeListCommand(cmd *cobra.Command, args []string) error {
 	}
 
 	// Sort results
-	sortWorktreeList(listData.Worktrees, worktreeListFlags.sort)
+	if err := sortWorktreeList(listData.Worktrees, worktreeListFlags.sort); err != nil {
+		return handleCLIError(cli.NewErrorWithSuggestion(err.Error(), "Use --sort name, last-accessed, created, or status"))
+	}
 
 	if spinner != nil {
 		spinner.StopWithMessage(fmt.Sprintf("Found %d worktrees", listData.Total))
 	}
 
-	formatter, err := setupWorktreeOutputFormatter(worktreeListFlags.format)
+	var displayPath func(string) string
+	if worktreeListFlags.relative {
+		// Without a resolvable base directory, paths are shown relative to the repository root
+		baseDir, _ := worktreeManager.BaseDirectory()
+		displayPath = func(path string) string {
+			return relativeWorktreePath(path, baseDir, repo.RootPath)
+		}
+	}
+
+	formatter, err := setupWorktreeOutputFormatter(worktreeListFlags.format, nil, displayPath)
 	if err != nil {
 		return handleCLIError(err)
 	}
@@ -320,12 +567,29 @@ func runWorktreeCreateCommand(cmd *cobra.Command, args []string) error {
 	if err := validateBranchArg(branchName); err != nil {
 		return handleCLIError(err)
 	}
+	if err := validateTrackArg(worktreeCreateFlags.track); err != nil {
+		return handleCLIError(err)
+	}
+	if worktreeCreateFlags.noAutoDir && worktreeCreateFlags.directory == "" {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			"--no-auto-directory requires an explicit --directory",
+			"For example: --no-auto-directory --directory ~/work/feature-login",
+		))
+	}
 
 	cfg, err := loadConfigWithOverrides()
 	if err != nil {
 		return handleCLIError(err)
 	}
 
+	// Check an explicit session name up front so an invalid name fails before any changes
+	startSession := worktreeCreateFlags.startSession || worktreeCreateFlags.sessionName != ""
+	if worktreeCreateFlags.sessionName != "" {
+		if err := validateSessionNameOverride(cfg, worktreeCreateFlags.sessionName); err != nil {
+			return handleCLIError(err)
+		}
+	}
+
 	var spinner *cli.Spinner
 	if shouldShowProgress() {
 		spinner = cli.NewSpinner(fmt.Sprintf("Creating worktree for branch '%s'...", branchName))
@@ -333,12 +597,13 @@ func runWorktreeCreateCommand(cmd *cobra.Command, args []string) error {
 		defer spinner.Stop()
 	}
 
-	// Initialize git repository manager
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
-		return handleCLIError(cli.NewErrorWithCause("failed to detect git repository", err))
+		return handleCLIError(err)
+	}
+
+	if err := applyBaseDirectoryOverride(cfg, worktreeCreateFlags.baseDirectory, repo.RootPath); err != nil {
+		return handleCLIError(err)
 	}
 
 	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
@@ -346,9 +611,12 @@ func runWorktreeCreateCommand(cmd *cobra.Command, args []string) error {
 	// Determine base branch
 	baseBranch := worktreeCreateFlags.base
 	if baseBranch == "" {
-		baseBranch = repo.CurrentBranch
+		baseBranch = git.NewGitOperations(repo, gitCmd).ResolveBaseBranch(cfg.Git.DefaultBranch)
 		if baseBranch == "" {
-			return handleCLIError(cli.NewError("could not determine current branch and no base branch specified"))
+			return handleCLIError(cli.NewErrorWithSuggestion(
+				"could not determine current branch and no base branch specified",
+				"Use --base to choose the base branch, or set git.default_branch",
+			))
 		}
 	}
 
@@ -366,69 +634,113 @@ func runWorktreeCreateCommand(cmd *cobra.Command, args []string) error {
 
 	// Create the worktree
 	opts := git.WorktreeOptions{
-		Path:         worktreeDir,
-		Branch:       branchName,
-		CreateBranch: true,
-		Force:        worktreeCreateFlags.force,
-		Checkout:     true,
-		TrackRemote:  worktreeCreateFlags.remote,
-		AutoName:     useAutoName,
+		Path:              worktreeDir,
+		Branch:            branchName,
+		CreateBranch:      true,
+		Force:             worktreeCreateFlags.force,
+		Checkout:          true,
+		TrackRemote:       worktreeCreateFlags.remote,
+		Track:             worktreeCreateFlags.track,
+		AutoName:          useAutoName,
+		SuffixOnCollision: worktreeCreateFlags.suffix,
+		NoAutoDirectory:   worktreeCreateFlags.noAutoDir,
+	}
+
+	if isDryRun() {
+		targetPath, err := worktreeManager.ResolveWorktreePath(branchName, opts)
+		if err != nil {
+			return handlePatternError(cli.NewErrorWithCause("failed to resolve worktree path", err))
+		}
+		if spinner != nil {
+			spinner.StopWithMessage("Dry run: Would create worktree")
+		}
+
+		_, err = gitCmd.Execute(repo.RootPath, "rev-parse", "--verify", branchName)
+		fmt.Printf("Dry run: Would create worktree for branch '%s' at %s\n", branchName, targetPath)
+		if worktreeCreateFlags.track != "" {
+			fmt.Printf("  Branch: %s (new, tracking %s)\n", branchName, worktreeCreateFlags.track)
+		} else if err != nil {
+			fmt.Printf("  Branch: %s (new, from %s)\n", branchName, baseBranch)
+		} else {
+			fmt.Printf("  Branch: %s (existing)\n", branchName)
+		}
+		fmt.Printf("  Base: %s\n", baseBranch)
+		if startSession {
+			sessionName, err := resolveWorktreeSessionName(cfg, worktreeCreateFlags.sessionName, worktreeManager, targetPath, branchName)
+			if err != nil {
+				return handleCLIError(err)
+			}
+			fmt.Printf("  Tmux session: %s\n", sessionName)
+		}
+		return nil
 	}
-	worktreeInfo, err := worktreeManager.CreateWorktree(branchName, opts)
+
+	creation, err := worktreeManager.BeginWorktreeCreation(branchName, opts)
 	if err != nil {
 		return handlePatternError(cli.NewErrorWithCause("failed to create worktree", err))
 	}
+	worktreeInfo := creation.Info
 
-	if spinner != nil {
-		spinner.SetMessage("Worktree created successfully")
+	// Get actual path for display and session creation
+	actualPath := worktreeDir
+	if (useAutoName || creation.Resumed) && worktreeInfo != nil {
+		actualPath = worktreeInfo.Path
 	}
 
-	// Start tmux session if requested
-	if worktreeCreateFlags.startSession {
-		if spinner != nil {
-			spinner.SetMessage("Starting tmux session...")
+	if spinner != nil {
+		if creation.Resumed {
+			spinner.SetMessage(fmt.Sprintf("Resuming interrupted creation of worktree at %s", actualPath))
+		} else {
+			spinner.SetMessage("Worktree created successfully")
 		}
+	}
 
-		sessionManager := tmux.NewSessionManager(cfg)
+	// Remaining steps run inside the creation so a failure rolls the worktree back
+	var steps []func() error
+	var sessionName string
+	if startSession {
+		steps = append(steps, func() error {
+			if spinner != nil {
+				spinner.SetMessage("Starting tmux session...")
+			}
 
-		// Use actual path for session creation
-		sessionPath := worktreeDir
-		if useAutoName && worktreeInfo != nil {
-			sessionPath = worktreeInfo.Path
-		}
+			sessionName, err = resolveWorktreeSessionName(cfg, worktreeCreateFlags.sessionName, worktreeManager, actualPath, branchName)
+			if err != nil {
+				return err
+			}
 
-		session, err := sessionManager.CreateSession(
-			getCurrentProjectName(), // project
-			branchName,              // worktree
-			branchName,              // branch
-			sessionPath,             // directory
-		)
-		if err != nil {
-			// Don't fail the entire operation if session creation fails
-			if isVerbose() {
-				fmt.Printf("Warning: Failed to create tmux session: %v\n", err)
+			sessionManager := tmux.NewSessionManager(cfg)
+			session, err := sessionManager.CreateSessionWithName(
+				sessionName,                   // name
+				worktreeManager.ProjectName(), // project
+				filepath.Base(actualPath),     // worktree
+				branchName,                    // branch
+				actualPath,                    // directory
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create tmux session: %w", err)
 			}
-		} else if spinner != nil {
-			spinner.SetMessage(fmt.Sprintf("Created tmux session: %s", session.Name))
-		}
 
-		// Start Claude Code if requested
-		if worktreeCreateFlags.startClaude && session != nil {
 			if spinner != nil {
-				spinner.SetMessage("Starting Claude Code...")
+				spinner.SetMessage(fmt.Sprintf("Created tmux session: %s", session.Name))
 			}
 
-			// Claude Code process management not yet implemented
-			if isVerbose() {
-				fmt.Printf("Warning: Claude Code auto-start not yet implemented\n")
+			// Start Claude Code if requested
+			if worktreeCreateFlags.startClaude {
+				if spinner != nil {
+					spinner.SetMessage("Starting Claude Code...")
+				}
+
+				if err := sessionManager.StartClaude(session.ID, worktreeCreateFlags.prompt); err != nil {
+					return err
+				}
 			}
-		}
+			return nil
+		})
 	}
 
-	// Get actual path for display
-	actualPath := worktreeDir
-	if useAutoName && worktreeInfo != nil {
-		actualPath = worktreeInfo.Path
+	if err := completeWorktreeCreation(creation, worktreeCreateFlags.keepOnFailure, steps...); err != nil {
+		return handleCLIError(newWorktreeCreationError(err, actualPath, worktreeCreateFlags.keepOnFailure))
 	}
 
 	if spinner != nil {
@@ -438,9 +750,12 @@ func runWorktreeCreateCommand(cmd *cobra.Command, args []string) error {
 	if !isQuiet() {
 		fmt.Printf("\nWorktree created:\n")
 		fmt.Printf("  Branch: %s\n", branchName)
+		if worktreeCreateFlags.track != "" {
+			fmt.Printf("  Tracking: %s\n", worktreeCreateFlags.track)
+		}
 		fmt.Printf("  Path: %s\n", actualPath)
-		if worktreeCreateFlags.startSession {
-			fmt.Printf("  Session: Started\n")
+		if startSession {
+			fmt.Printf("  Session: %s\n", sessionName)
 		}
 		if worktreeCreateFlags.startClaude {
 			fmt.Printf("  Claude Code: Started\n")
@@ -450,6 +765,23 @@ func runWorktreeCreateCommand(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// validateTrackArg checks that a --track value names a remote branch as
+// <remote>/<branch>
+func validateTrackArg(track string) error {
+	if track == "" {
+		return nil
+	}
+
+	remote, branch, ok := strings.Cut(track, "/")
+	if !ok || remote == "" || branch == "" {
+		return cli.NewErrorWithSuggestion(
+			fmt.Sprintf("invalid --track value '%s': expected <remote>/<branch>", track),
+			"For example: --track origin/feature/login",
+		)
+	}
+	return nil
+}
+
 func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 	worktreeName := args[0]
 
@@ -462,12 +794,9 @@ func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 		return handleCLIError(err)
 	}
 
-	// Initialize managers
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
-		return handleCLIError(cli.NewErrorWithCause("failed to detect git repository", err))
+		return handleCLIError(err)
 	}
 
 	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
@@ -478,13 +807,7 @@ func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
 	}
 
-	var targetWorktree *git.WorktreeInfo
-	for _, wt := range worktrees {
-		if filepath.Base(wt.Path) == worktreeName || wt.Branch == worktreeName || wt.Path == worktreeName {
-			targetWorktree = &wt
-			break
-		}
-	}
+	targetWorktree := findWorktree(worktrees, worktreeName)
 
 	if targetWorktree == nil {
 		return handleCLIError(cli.NewErrorWithSuggestion(
@@ -493,12 +816,30 @@ func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 		))
 	}
 
+	// Protected branches are only deleted with --force and git.allow_force_delete
+	if err := worktreeManager.CheckProtectedBranch(targetWorktree.Branch, worktreeDeleteFlags.force); err != nil {
+		return handleCLIError(newProtectedBranchError(err, "delete"))
+	}
+	if worktreeManager.IsProtectedBranch(targetWorktree.Branch) && cfg.Git.ConfirmDestructive && !isDryRun() {
+		confirmed, err := confirmProtectedBranch(os.Stdout, targetWorktree.Branch, "delete")
+		if err != nil {
+			return handleCLIError(err)
+		}
+		if !confirmed {
+			fmt.Println("Deletion cancelled")
+			return nil
+		}
+	}
+
 	// Safety check - confirm deletion
 	if !worktreeDeleteFlags.force && !isDryRun() {
+		if err := checkConfirmable("deleting the worktree", forceConfirmSuggestion); err != nil {
+			return handleCLIError(err)
+		}
 		fmt.Printf("This will delete worktree:\n")
 		fmt.Printf("  Name: %s\n", filepath.Base(targetWorktree.Path))
 		fmt.Printf("  Path: %s\n", targetWorktree.Path)
-		fmt.Printf("  Branch: %s\n", targetWorktree.Branch)
+		fmt.Printf("  Branch: %s\n", worktreeBranchLabel(targetWorktree))
 
 		if !targetWorktree.IsClean {
 			fmt.Printf("  WARNING: Worktree has uncommitted changes!\n")
@@ -520,41 +861,38 @@ func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 		defer spinner.Stop()
 	}
 
-	// Clean up sessions if requested
-	if worktreeDeleteFlags.cleanupSessions {
+	if isDryRun() {
 		if spinner != nil {
-			spinner.SetMessage("Cleaning up tmux sessions...")
-		}
-
-		sessionManager := tmux.NewSessionManager(cfg)
-		sessions, err := sessionManager.ListSessions()
-		if err == nil {
-			for _, sess := range sessions {
-				if sess.Worktree == worktreeName || strings.Contains(sess.Directory, targetWorktree.Path) {
-					sessionManager.KillSession(sess.ID)
-				}
-			}
+			spinner.StopWithMessage("Dry run: Would delete worktree")
 		}
+		fmt.Printf("Dry run: Would delete worktree '%s' at %s\n", worktreeName, targetWorktree.Path)
+		return nil
 	}
 
-	// Clean up processes if requested
+	// Stop Claude Code before its sessions are killed
 	if worktreeDeleteFlags.cleanupProcesses {
 		if spinner != nil {
-			spinner.SetMessage("Cleaning up Claude Code processes...")
+			spinner.SetMessage("Stopping Claude Code processes...")
 		}
 
-		// Process cleanup not yet implemented
-		if isVerbose() {
-			fmt.Printf("Warning: Process cleanup not yet implemented\n")
+		if err := stopWorktreeProcesses(cfg, targetWorktree.Path); err != nil && !isQuiet() {
+			fmt.Printf("Warning: %v\n", err)
 		}
 	}
 
-	if isDryRun() {
+	// Clean up sessions if requested
+	if worktreeDeleteFlags.cleanupSessions {
 		if spinner != nil {
-			spinner.StopWithMessage("Dry run: Would delete worktree")
+			spinner.SetMessage("Cleaning up tmux sessions...")
+		}
+
+		sessionManager := tmux.NewSessionManager(cfg)
+		sessions, err := sessionManager.ListSessions()
+		if err == nil {
+			for _, sess := range tmux.SessionsInPath(sessions, targetWorktree.Path) {
+				sessionManager.KillSession(sess.ID)
+			}
 		}
-		fmt.Printf("Dry run: Would delete worktree '%s' at %s\n", worktreeName, targetWorktree.Path)
-		return nil
 	}
 
 	// Delete the worktree
@@ -562,8 +900,12 @@ func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 		spinner.SetMessage("Removing worktree directory...")
 	}
 
-	err = worktreeManager.DeleteWorktree(targetWorktree.Path, !worktreeDeleteFlags.keepBranch)
+	backupPath, err := worktreeManager.DeleteWorktreeWithBackup(targetWorktree.Path, worktreeDeleteFlags.force)
 	if err != nil {
+		if errors.Is(err, git.ErrUncommittedChanges) {
+			return handleCLIError(cli.NewErrorWithCause("failed to delete worktree", err).
+				WithSuggestion("Commit or stash your changes, or use --force to delete anyway"))
+		}
 		return handleCLIError(cli.NewErrorWithCause("failed to delete worktree", err))
 	}
 
@@ -573,210 +915,1181 @@ func runWorktreeDeleteCommand(cmd *cobra.Command, args []string) error {
 
 	if !isQuiet() {
 		fmt.Printf("Worktree '%s' deleted successfully\n", worktreeName)
+		if backupPath != "" {
+			fmt.Printf("Uncommitted changes backed up to %s\n", backupPath)
+			fmt.Printf("Restore with: ccmgr-ultra worktree restore %s\n", strings.TrimSuffix(filepath.Base(backupPath), ".tar.gz"))
+		}
 	}
 
 	return nil
 }
 
-func runWorktreeMergeCommand(cmd *cobra.Command, args []string) error {
-	// Placeholder implementation - this would be quite complex
-	worktreeName := args[0]
-
-	if err := validateWorktreeArg(worktreeName); err != nil {
-		return handleCLIError(err)
+// stopWorktreeProcesses interrupts the Claude Code processes running in the
+// worktree at path and waits for them to exit
+func stopWorktreeProcesses(cfg *config.Config, path string) error {
+	controller, err := newClaudeProcessController(cfg)
+	if err != nil {
+		return fmt.Errorf("cannot find Claude Code processes: %w", err)
+	}
+	processes, err := controller.ListProcesses()
+	if err != nil {
+		return fmt.Errorf("cannot find Claude Code processes: %w", err)
 	}
 
-	return handleCLIError(cli.NewError("worktree merge command not yet implemented"))
+	var matched []*claude.ProcessInfo
+	for _, process := range processes {
+		if process.WorkingDir != "" && tmux.IsPathWithin(process.WorkingDir, path) {
+			matched = append(matched, process)
+		}
+	}
+	return interruptProcesses(controller, matched, claude.DefaultStopTimeout)
 }
 
-func runWorktreePushCommand(cmd *cobra.Command, args []string) error {
+func runWorktreeMergeCommand(cmd *cobra.Command, args []string) error {
 	worktreeName := args[0]
 
 	if err := validateWorktreeArg(worktreeName); err != nil {
 		return handleCLIError(err)
 	}
+	if worktreeMergeFlags.into != "" {
+		if err := validateWorktreeArg(worktreeMergeFlags.into); err != nil {
+			return handleCLIError(err)
+		}
+		if cmd.Flags().Changed("target") {
+			return handleCLIError(cli.NewError("--into and --target cannot be used together"))
+		}
+	}
 
-	cfg, err := loadConfigWithOverrides()
+	strategy, err := git.ParseMergeStrategy(worktreeMergeFlags.strategy)
 	if err != nil {
-		return handleCLIError(err)
+		return handleCLIError(cli.NewErrorWithSuggestion(err.Error(), "Use --strategy merge, squash, or rebase"))
 	}
 
-	var spinner *cli.Spinner
-	if shouldShowProgress() {
-		spinner = cli.NewSpinner(fmt.Sprintf("Pushing worktree '%s'...", worktreeName))
-		spinner.Start()
-		defer spinner.Stop()
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
 	}
 
-	// Initialize git repository manager
-	gitCmd := git.NewGitCmd()
-	repoManager := git.NewRepositoryManager(gitCmd)
-	repo, err := repoManager.DetectRepository(".")
+	repo, gitCmd, err := loadRepository()
 	if err != nil {
-		return handleCLIError(cli.NewErrorWithCause("failed to detect git repository", err))
+		return handleCLIError(err)
 	}
 
-	// Find the target worktree
 	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
 	worktrees, err := worktreeManager.ListWorktrees()
 	if err != nil {
 		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
 	}
 
-	var targetWorktree *git.WorktreeInfo
-	for _, wt := range worktrees {
-		if filepath.Base(wt.Path) == worktreeName || wt.Branch == worktreeName || wt.Path == worktreeName {
-			targetWorktree = &wt
-			break
-		}
+	sourceWorktree := findWorktree(worktrees, worktreeName)
+	if sourceWorktree == nil {
+		return handleCLIError(cli.ErrorInvalidWorktree(worktreeName))
+	}
+	if sourceWorktree.Detached {
+		return handleCLIError(newDetachedHeadError(sourceWorktree, "merge"))
 	}
 
-	if targetWorktree == nil {
-		return handleCLIError(cli.NewErrorWithSuggestion(
-			fmt.Sprintf("worktree not found: %s", worktreeName),
-			"Use 'ccmgr-ultra worktree list' to see available worktrees",
-		))
+	targetBranch, targetPath, err := resolveMergeTarget(worktrees, repo, worktreeMergeFlags.target, worktreeMergeFlags.into)
+	if err != nil {
+		return handleCLIError(err)
 	}
 
-	if spinner != nil {
-		spinner.SetMessage(fmt.Sprintf("Found worktree '%s' on branch '%s'", worktreeName, targetWorktree.Branch))
+	if sourceWorktree.Branch == targetBranch || sourceWorktree.Path == targetPath {
+		return handleCLIError(cli.NewError("source and target worktrees must be different"))
 	}
 
-	// Initialize remote manager
-	remoteManager := git.NewRemoteManager(repo, &cfg.Git, gitCmd)
+	// Rebasing rewrites the source branch and --delete-after removes its
+	// worktree, so both need --force on a protected branch
+	if strategy == git.MergeStrategyRebase || worktreeMergeFlags.deleteAfter {
+		action := "rebase"
+		if strategy != git.MergeStrategyRebase {
+			action = "delete"
+		}
+		if err := worktreeManager.CheckProtectedBranch(sourceWorktree.Branch, worktreeMergeFlags.force); err != nil {
+			return handleCLIError(newProtectedBranchError(err, action))
+		}
+		if worktreeManager.IsProtectedBranch(sourceWorktree.Branch) && cfg.Git.ConfirmDestructive && !isDryRun() {
+			confirmed, err := confirmProtectedBranch(os.Stdout, sourceWorktree.Branch, action)
+			if err != nil {
+				return handleCLIError(err)
+			}
+			if !confirmed {
+				fmt.Println("Merge cancelled")
+				return nil
+			}
+		}
+	}
 
-	// Detect hosting service
-	service, err := remoteManager.DetectHostingService(repo.Origin)
-	if err != nil {
-		return handleCLIError(cli.NewErrorWithCause("failed to detect hosting service", err))
+	if isDryRun() {
+		fmt.Printf("Dry run: Would merge branch '%s' into '%s' in %s\n", sourceWorktree.Branch, targetBranch, targetPath)
+		fmt.Printf("  Strategy: %s\n", strategy)
+		if worktreeMergeFlags.message != "" {
+			fmt.Printf("  Message: %s\n", worktreeMergeFlags.message)
+		}
+		if worktreeMergeFlags.pushFirst {
+			fmt.Printf("  Would push '%s' to %s first\n", sourceWorktree.Branch, cfg.Git.DefaultRemote)
+		}
+		if worktreeMergeFlags.deleteAfter {
+			fmt.Printf("  Would delete worktree %s afterward\n", sourceWorktree.Path)
+		}
+		return nil
 	}
 
-	if service != "github" {
-		return handleCLIError(cli.NewErrorWithSuggestion(
-			fmt.Sprintf("hosting service '%s' not supported", service),
-			"Currently only GitHub repositories are supported for push operations",
-		))
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner(fmt.Sprintf("Merging '%s' into '%s'...", sourceWorktree.Branch, targetBranch))
+		spinner.Start()
+		defer spinner.Stop()
 	}
 
-	// Validate GitHub authentication if creating PR
-	if worktreePushFlags.createPR {
+	if worktreeMergeFlags.pushFirst {
 		if spinner != nil {
-			spinner.SetMessage("Validating GitHub authentication...")
+			spinner.SetMessage(fmt.Sprintf("Pushing branch '%s' to remote...", sourceWorktree.Branch))
 		}
 
-		if err := remoteManager.ValidateAuthentication("github"); err != nil {
-			return handleCLIError(cli.NewErrorWithSuggestion(
-				fmt.Sprintf("GitHub authentication failed: %v", err),
-				"Set GITHUB_TOKEN environment variable or configure github_token in config",
-			))
+		remoteManager := git.NewRemoteManager(repo, &cfg.Git, gitCmd)
+		if err := remoteManager.PushBranch(sourceWorktree.Branch); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to push branch before merging", err).
+				WithSuggestion("Check your remote access, or merge without --push-first"))
 		}
 	}
 
-	// Push the branch first
 	if spinner != nil {
-		spinner.SetMessage(fmt.Sprintf("Pushing branch '%s' to remote...", targetWorktree.Branch))
+		spinner.SetMessage(fmt.Sprintf("Merging '%s' into '%s' (%s)...", sourceWorktree.Branch, targetBranch, strategy))
 	}
 
-	if worktreePushFlags.createPR {
-		// Determine target branch
-		targetBranch := repo.DefaultBranch
-		if cfg.Git.DefaultPRTargetBranch != "" {
-			targetBranch = cfg.Git.DefaultPRTargetBranch
+	ops := git.NewGitOperationsInDir(repo, gitCmd, targetPath)
+	result, err := ops.MergeBranchWithOptions(sourceWorktree.Branch, targetBranch, git.MergeOptions{
+		Strategy:  strategy,
+		Message:   worktreeMergeFlags.message,
+		SourceDir: sourceWorktree.Path,
+	})
+	if err != nil {
+		if result != nil && len(result.Conflicts) > 0 {
+			return handleCLIError(newMergeConflictError(strategy, result.Conflicts, sourceWorktree.Path, targetPath))
 		}
+		return handleCLIError(cli.NewErrorWithCause("failed to merge worktree", err))
+	}
 
-		// Prepare pull request options
-		prOptions := git.PullRequestRequest{
-			Title:        worktreePushFlags.prTitle,
-			Description:  worktreePushFlags.prBody,
-			SourceBranch: targetWorktree.Branch,
-			TargetBranch: targetBranch,
-			Draft:        worktreePushFlags.draft,
-		}
+	if spinner != nil {
+		spinner.StopWithMessage(fmt.Sprintf("Merged '%s' into '%s'", sourceWorktree.Branch, targetBranch))
+	}
 
-		// Set default PR title if not provided
-		if prOptions.Title == "" {
-			prOptions.Title = fmt.Sprintf("Feature: %s", targetWorktree.Branch)
+	if !isQuiet() {
+		fmt.Printf("\nMerge completed:\n")
+		fmt.Printf("  Source: %s (%s)\n", sourceWorktree.Branch, sourceWorktree.Path)
+		fmt.Printf("  Target: %s (%s)\n", targetBranch, targetPath)
+		fmt.Printf("  Strategy: %s\n", strategy)
+		fmt.Printf("  Files changed: %d\n", result.FilesChanged)
+		if result.CommitHash != "" {
+			fmt.Printf("  Commit: %s\n", result.CommitHash)
 		}
+	}
+
+	if worktreeMergeFlags.deleteAfter {
+		// --force only overrides the branch protection here, not uncommitted changes
+		opts := git.DeleteOptions{AllowProtected: worktreeMergeFlags.force}
+		if _, err := worktreeManager.DeleteWorktreeWithOptions(sourceWorktree.Path, opts); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("merge succeeded but failed to delete worktree", err).
+				WithSuggestion(fmt.Sprintf("Remove it with 'ccmgr-ultra worktree delete %s'", worktreeName)))
+		}
+		if !isQuiet() {
+			fmt.Printf("Worktree '%s' deleted\n", worktreeName)
+		}
+	}
+
+	return nil
+}
+
+// newProtectedBranchError reports a refused action on the worktree of a
+// protected branch along with how to override the protection
+func newProtectedBranchError(err error, action string) *cli.CLIError {
+	var protectedErr *git.ProtectedBranchError
+	if !errors.As(err, &protectedErr) {
+		return cli.NewErrorWithCause(fmt.Sprintf("failed to %s worktree", action), err)
+	}
+
+	suggestion := fmt.Sprintf("Set git.allow_force_delete to true and use --force to %s it anyway", action)
+	if protectedErr.ForceAllowed {
+		suggestion = fmt.Sprintf("Use --force to %s it anyway", action)
+	}
+	return cli.NewErrorWithSuggestion(
+		fmt.Sprintf("refusing to %s worktree on protected branch '%s'", action, protectedErr.Branch),
+		suggestion,
+	)
+}
+
+// newDetachedHeadError reports that action needs the worktree to be on a
+// branch
+func newDetachedHeadError(wt *git.WorktreeInfo, action string) *cli.CLIError {
+	return cli.NewErrorWithSuggestion(
+		fmt.Sprintf("cannot %s a detached HEAD (worktree %s)", action, filepath.Base(wt.Path)),
+		fmt.Sprintf("Create a branch at the current commit first: git -C %s switch -c <branch>", wt.Path),
+	)
+}
+
+// confirmProtectedBranch asks on w whether action may go ahead on the
+// worktree of a protected branch, failing when no answer can be read
+func confirmProtectedBranch(w io.Writer, branch, action string) (bool, error) {
+	if err := checkConfirmable(fmt.Sprintf("the %s of protected branch '%s'", action, branch),
+		"Set git.confirm_destructive to false to skip confirming protected branch changes"); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(w, "Branch '%s' is protected. %s its worktree anyway? [y/N]: ", branch, strings.ToUpper(action[:1])+action[1:])
+
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(response)
+	return response == "y" || response == "yes", nil
+}
+
+// resolveMergeTarget determines the branch to merge into and the directory
+// the merge runs in. With into, the target is that worktree's branch;
+// otherwise it is targetBranch, merged in the worktree that has it checked
+// out, or in the main repository when no worktree does.
+func resolveMergeTarget(worktrees []git.WorktreeInfo, repo *git.Repository, targetBranch, into string) (string, string, error) {
+	if into != "" {
+		targetWorktree := findWorktree(worktrees, into)
+		if targetWorktree == nil {
+			return "", "", cli.ErrorInvalidWorktree(into)
+		}
+		if targetWorktree.Detached {
+			return "", "", newDetachedHeadError(targetWorktree, "merge into")
+		}
+		return targetWorktree.Branch, targetWorktree.Path, nil
+	}
+
+	if targetBranch == "" {
+		return "", "", cli.NewError("target branch cannot be empty")
+	}
+
+	for i := range worktrees {
+		if worktrees[i].Branch == targetBranch {
+			return targetBranch, worktrees[i].Path, nil
+		}
+	}
+
+	return targetBranch, repo.RootPath, nil
+}
+
+// newMergeConflictError reports the conflicted files of a failed merge along
+// with how to resolve or abort it for the given strategy
+func newMergeConflictError(strategy git.MergeStrategy, conflicts []string, sourcePath, targetPath string) *cli.CLIError {
+	if strategy == git.MergeStrategyRebase {
+		return cli.NewErrorWithSuggestion(
+			fmt.Sprintf("rebase conflicts in %s: %s", sourcePath, strings.Join(conflicts, ", ")),
+			fmt.Sprintf("Resolve the conflicts in %s and run 'git rebase --continue', or run 'git rebase --abort' there", sourcePath),
+		)
+	}
+
+	return cli.NewErrorWithSuggestion(
+		fmt.Sprintf("merge conflicts in %s: %s", targetPath, strings.Join(conflicts, ", ")),
+		fmt.Sprintf("Resolve the conflicts in %s and commit, or run 'git merge --abort' there", targetPath),
+	)
+}
+
+// worktreePruneSkip records a stale worktree that prune left in place
+type worktreePruneSkip struct {
+	Worktree git.WorktreeInfo
+	Reason   string
+}
+
+// planWorktreePrune selects the worktrees last accessed before cutoff. The main
+// worktree and protected branches are never pruned; worktrees with uncommitted
+// changes are only pruned with force.
+func planWorktreePrune(worktreeManager *git.WorktreeManager, worktrees []git.WorktreeInfo, mainPath string, cutoff time.Time, force bool) ([]git.WorktreeInfo, []worktreePruneSkip) {
+	var prune []git.WorktreeInfo
+	var skipped []worktreePruneSkip
+
+	for _, wt := range worktrees {
+		if wt.Path == mainPath || !wt.LastAccessed.Before(cutoff) {
+			continue
+		}
+
+		switch {
+		case worktreeManager.IsProtectedBranch(wt.Branch):
+			skipped = append(skipped, worktreePruneSkip{Worktree: wt, Reason: "protected branch"})
+		case !wt.IsClean && !force:
+			skipped = append(skipped, worktreePruneSkip{Worktree: wt, Reason: "uncommitted changes"})
+		default:
+			prune = append(prune, wt)
+		}
+	}
+
+	return prune, skipped
+}
+
+func runWorktreePruneCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	olderThan := cfg.Git.CleanupAge
+	if cmd.Flags().Changed("older-than") {
+		olderThan = worktreePruneFlags.olderThan
+	}
+	if olderThan <= 0 {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			"prune age must be positive",
+			"Set git.cleanup_age in config or pass --older-than, e.g. --older-than 168h",
+		))
+	}
+
+	formatter, err := setupCleanupOutputFormatter(worktreePruneFlags.format)
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
+	worktrees, err := worktreeManager.ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	prune, skipped := planWorktreePrune(worktreeManager, worktrees, repo.RootPath, cutoff, worktreePruneFlags.force)
+
+	if formatter != nil {
+		if len(prune) > 0 && !isDryRun() && !worktreePruneFlags.force {
+			confirmed, err := confirmWorktreePrune(os.Stderr, len(prune))
+			if err != nil {
+				return handleCLIError(err)
+			}
+			if !confirmed {
+				fmt.Fprintln(os.Stderr, "Prune cancelled")
+				return nil
+			}
+		}
+		result := pruneWorktrees(prune, skipped, isDryRun(), func(path string) error {
+			return worktreeManager.DeleteWorktree(path, worktreePruneFlags.force)
+		})
+		return formatter.Format(result)
+	}
+
+	if len(prune) == 0 && len(skipped) == 0 {
+		if !isQuiet() {
+			fmt.Printf("No worktrees older than %s\n", olderThan)
+		}
+		return nil
+	}
+
+	if !isQuiet() || isDryRun() {
+		if len(prune) > 0 {
+			fmt.Printf("Worktrees not accessed in %s:\n", olderThan)
+			for _, wt := range prune {
+				fmt.Printf("  %s (%s, last accessed %s)\n", wt.Path, wt.Branch, wt.LastAccessed.Format("2006-01-02 15:04"))
+			}
+		}
+		for _, skip := range skipped {
+			fmt.Printf("  Skipping %s (%s): %s\n", skip.Worktree.Path, skip.Worktree.Branch, skip.Reason)
+		}
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would prune %d worktrees, skip %d\n", len(prune), len(skipped))
+		return nil
+	}
+
+	if len(prune) > 0 && !worktreePruneFlags.force {
+		confirmed, err := confirmWorktreePrune(os.Stdout, len(prune))
+		if err != nil {
+			return handleCLIError(err)
+		}
+		if !confirmed {
+			fmt.Println("Prune cancelled")
+			return nil
+		}
+	}
+
+	result := pruneWorktrees(prune, skipped, false, func(path string) error {
+		return worktreeManager.DeleteWorktree(path, worktreePruneFlags.force)
+	})
+	if !isQuiet() {
+		for _, item := range result.Skipped[len(skipped):] {
+			fmt.Printf("Failed to delete %s: %s\n", item.Path, item.Reason)
+		}
+		fmt.Printf("\nPruned %d worktrees, skipped %d\n", len(result.Removed), len(result.Skipped))
+	}
+
+	return nil
+}
+
+// confirmWorktreePrune asks on w whether count worktrees may be deleted,
+// failing when no answer can be read
+func confirmWorktreePrune(w io.Writer, count int) (bool, error) {
+	if err := checkConfirmable("the prune", forceConfirmSuggestion); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(w, "\nDelete %d worktrees? [y/N]: ", count)
+
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(response)
+	return response == "y" || response == "yes", nil
+}
+
+// pruneWorktrees deletes the worktrees in prune, unless dryRun is set, and
+// reports the outcome. Worktrees skipped by planWorktreePrune come first in
+// Skipped, followed by worktrees that failed to be deleted.
+func pruneWorktrees(prune []git.WorktreeInfo, skipped []worktreePruneSkip, dryRun bool, remove func(path string) error) CleanupResult {
+	result := CleanupResult{
+		Removed: []CleanupItem{},
+		Skipped: []CleanupItem{},
+		DryRun:  dryRun,
+	}
+
+	for _, skip := range skipped {
+		result.Skipped = append(result.Skipped, worktreeCleanupItem(skip.Worktree, skip.Reason))
+	}
+
+	for _, wt := range prune {
+		if !dryRun {
+			if err := remove(wt.Path); err != nil {
+				result.Skipped = append(result.Skipped, worktreeCleanupItem(wt, err.Error()))
+				continue
+			}
+		}
+		result.Removed = append(result.Removed, worktreeCleanupItem(wt, ""))
+	}
+
+	return result
+}
+
+func worktreeCleanupItem(wt git.WorktreeInfo, reason string) CleanupItem {
+	return CleanupItem{Name: filepath.Base(wt.Path), Path: wt.Path, Branch: wt.Branch, Reason: reason}
+}
+
+func runWorktreePRListCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner("Fetching pull requests...")
+		spinner.Start()
+		defer spinner.Stop()
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	branches, err := git.NewGitOperations(repo, gitCmd).LocalBranchNames()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list local branches", err))
+	}
+
+	worktrees, err := git.NewWorktreeManager(repo, cfg, gitCmd).ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	remoteManager := git.NewRemoteManager(repo, &cfg.Git, gitCmd)
+	prs, err := remoteManager.ListPullRequests()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list pull requests", err).
+			WithSuggestion("Set GITHUB_TOKEN (or GITLAB_TOKEN) environment variable or configure github_token in config"))
+	}
+
+	listData := buildPullRequestListData(prs, branches, worktrees)
+
+	if spinner != nil {
+		spinner.StopWithMessage(fmt.Sprintf("Found %d open pull requests", listData.Total))
+	}
+
+	outputFormat, err := cli.ValidateFormat(worktreePRListFlags.format)
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	return cli.NewPullRequestFormatter(outputFormat, nil).Format(listData)
+}
+
+// buildPullRequestListData keeps the open pull requests whose source branch
+// exists locally and records the worktree each branch is checked out in
+func buildPullRequestListData(prs []git.PullRequest, branches []string, worktrees []git.WorktreeInfo) *PullRequestListData {
+	localBranches := make(map[string]bool, len(branches))
+	for _, branch := range branches {
+		localBranches[branch] = true
+	}
+
+	worktreeByBranch := make(map[string]string, len(worktrees))
+	for _, wt := range worktrees {
+		worktreeByBranch[wt.Branch] = filepath.Base(wt.Path)
+	}
+
+	listData := &PullRequestListData{
+		PullRequests: make([]PullRequestListItem, 0),
+		Timestamp:    time.Now(),
+	}
+
+	for _, pr := range prs {
+		// GitHub reports open pull requests as "open", GitLab as "opened"
+		if (pr.State != "open" && pr.State != "opened") || !localBranches[pr.SourceBranch] {
+			continue
+		}
+
+		listData.PullRequests = append(listData.PullRequests, PullRequestListItem{
+			Number:       pr.Number,
+			Title:        pr.Title,
+			Author:       pr.Author,
+			Draft:        pr.Draft,
+			SourceBranch: pr.SourceBranch,
+			TargetBranch: pr.TargetBranch,
+			Worktree:     worktreeByBranch[pr.SourceBranch],
+			Labels:       pr.Labels,
+			URL:          pr.URL,
+		})
+	}
+
+	sort.Slice(listData.PullRequests, func(i, j int) bool {
+		return listData.PullRequests[i].Number > listData.PullRequests[j].Number
+	})
+	listData.Total = len(listData.PullRequests)
+
+	return listData
+}
+
+// remoteAPIError wraps a hosting service failure, suggesting a longer
+// git.api_timeout when the API did not answer in time
+func remoteAPIError(message string, err error) *cli.CLIError {
+	cliErr := cli.NewErrorWithCause(message, err)
+	if errors.Is(err, git.ErrAPITimeout) {
+		cliErr = cliErr.WithSuggestion("Check your network connection or raise git.api_timeout (e.g. CCMGR_GIT_API_TIMEOUT=60s)")
+	}
+	return cliErr
+}
+
+func runWorktreePushCommand(cmd *cobra.Command, args []string) error {
+	worktreeName := args[0]
+
+	if err := validateWorktreeArg(worktreeName); err != nil {
+		return handleCLIError(err)
+	}
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	var spinner *cli.Spinner
+	if shouldShowProgress() {
+		spinner = cli.NewSpinner(fmt.Sprintf("Pushing worktree '%s'...", worktreeName))
+		spinner.Start()
+		defer spinner.Stop()
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	// Find the target worktree
+	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
+	worktrees, err := worktreeManager.ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	targetWorktree := findWorktree(worktrees, worktreeName)
+
+	if targetWorktree == nil {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("worktree not found: %s", worktreeName),
+			"Use 'ccmgr-ultra worktree list' to see available worktrees",
+		))
+	}
+	if targetWorktree.Detached {
+		return handleCLIError(newDetachedHeadError(targetWorktree, "push"))
+	}
+
+	if spinner != nil {
+		spinner.SetMessage(fmt.Sprintf("Found worktree '%s' on branch '%s'", worktreeName, targetWorktree.Branch))
+	}
+
+	// Initialize remote manager
+	remoteManager := git.NewRemoteManager(repo, &cfg.Git, gitCmd)
+
+	// Detect hosting service
+	service, err := remoteManager.DetectHostingService(repo.Origin)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to detect hosting service", err))
+	}
+
+	if service != "github" {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("hosting service '%s' not supported", service),
+			"Currently only GitHub repositories are supported for push operations",
+		))
+	}
+
+	// Validate GitHub authentication if creating PR
+	if worktreePushFlags.createPR {
+		if spinner != nil {
+			spinner.SetMessage("Validating GitHub authentication...")
+		}
+
+		if err := remoteManager.ValidateAuthentication("github"); err != nil {
+			if errors.Is(err, git.ErrAPITimeout) {
+				return handleCLIError(remoteAPIError("failed to validate GitHub authentication", err))
+			}
+			return handleCLIError(cli.NewErrorWithSuggestion(
+				fmt.Sprintf("GitHub authentication failed: %v", err),
+				"Set GITHUB_TOKEN environment variable or configure github_token in config",
+			))
+		}
+
+		if err := remoteManager.ValidatePullRequestScopes("github"); err != nil {
+			if errors.Is(err, git.ErrTokenMissingScope) {
+				return handleCLIError(cli.NewErrorWithSuggestion(
+					err.Error(),
+					"Regenerate the GitHub token with the 'repo' scope (or 'public_repo' for public repositories)",
+				))
+			}
+			return handleCLIError(remoteAPIError("failed to check GitHub token scopes", err))
+		}
+	}
+
+	// Push the branch first
+	if spinner != nil {
+		spinner.SetMessage(fmt.Sprintf("Pushing branch '%s' to remote...", targetWorktree.Branch))
+	}
+
+	if worktreePushFlags.createPR {
+		// Determine target branch
+		targetBranch := repo.DefaultBranch
+		if cfg.Git.DefaultPRTargetBranch != "" {
+			targetBranch = cfg.Git.DefaultPRTargetBranch
+		}
+
+		// Prepare pull request options
+		prOptions := git.PullRequestRequest{
+			Title:        worktreePushFlags.prTitle,
+			Description:  worktreePushFlags.prBody,
+			SourceBranch: targetWorktree.Branch,
+			TargetBranch: targetBranch,
+			Draft:        worktreePushFlags.draft,
+			Labels:       worktreePushFlags.labels,
+			Assignees:    worktreePushFlags.assignees,
+			Reviewers:    splitCommaList(worktreePushFlags.reviewer),
+		}
+
+		// Set default PR title if not provided
+		if prOptions.Title == "" {
+			prOptions.Title = fmt.Sprintf("Feature: %s", targetWorktree.Branch)
+		}
+
+		if spinner != nil {
+			spinner.SetMessage("Creating GitHub pull request...")
+		}
+
+		// Push and create PR
+		pr, err := remoteManager.PushAndCreatePR(targetWorktree, prOptions)
+		if err != nil {
+			return handleCLIError(remoteAPIError("failed to push and create pull request", err))
+		}
+
+		if spinner != nil {
+			spinner.StopWithMessage(fmt.Sprintf("Successfully pushed and created PR #%d", pr.Number))
+		}
+
+		if !isQuiet() {
+			fmt.Printf("\nPull Request Created:\n")
+			fmt.Printf("  Title: %s\n", pr.Title)
+			fmt.Printf("  Number: #%d\n", pr.Number)
+			fmt.Printf("  URL: %s\n", pr.URL)
+			fmt.Printf("  Status: %s\n", pr.State)
+			if pr.Draft {
+				fmt.Printf("  Type: Draft\n")
+			}
+			if len(pr.Labels) > 0 {
+				fmt.Printf("  Labels: %s\n", strings.Join(pr.Labels, ", "))
+			}
+		}
+
+		for _, warning := range pr.Warnings {
+			fmt.Printf("Warning: %s\n", warning)
+		}
+	} else {
+		// Just push without creating PR
+		if err := remoteManager.PushBranch(targetWorktree.Branch); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to push branch", err))
+		}
+
+		if spinner != nil {
+			spinner.StopWithMessage(fmt.Sprintf("Successfully pushed branch '%s'", targetWorktree.Branch))
+		}
+
+		if !isQuiet() {
+			fmt.Printf("Branch '%s' pushed successfully\n", targetWorktree.Branch)
+		}
+	}
+
+	return nil
+}
+
+// runWorktreeOpenCommand attaches to the tmux session running in a
+// worktree, starts one with --start-session, or opens the worktree in an
+// editor with --editor
+func runWorktreeOpenCommand(cmd *cobra.Command, args []string) error {
+	worktreeName := args[0]
+
+	if err := validateWorktreeArg(worktreeName); err != nil {
+		return handleCLIError(err)
+	}
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	worktreeManager := git.NewWorktreeManager(repo, cfg, gitCmd)
+	worktrees, err := worktreeManager.ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	target := findWorktree(worktrees, worktreeName)
+	if target == nil {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("worktree not found: %s", worktreeName),
+			"Use 'ccmgr-ultra worktree list' to see available worktrees",
+		))
+	}
+
+	if worktreeOpenFlags.editor {
+		editor := worktreeEditorCommand(cfg, os.Getenv)
+		if isDryRun() {
+			fmt.Printf("Dry run: Would open %s in %s\n", target.Path, strings.Join(editor, " "))
+			return nil
+		}
+		if err := runWorktreeEditor(editor, target.Path, cfg.Commands.Environment); err != nil {
+			return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("failed to open %s in %s", target.Path, editor[0]), err).
+				WithSuggestion("Set $EDITOR, or commands.environment.EDITOR in the config, to an installed editor"))
+		}
+		return nil
+	}
+
+	sessionManager := tmux.NewSessionManager(cfg)
+	sessions, err := sessionManager.ListSessions()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list tmux sessions", err))
+	}
+
+	plan := planWorktreeOpen(*target, sessions, worktreeOpenFlags.startSession)
+	switch plan.action {
+	case worktreeOpenAttach:
+		if isDryRun() {
+			fmt.Printf("Dry run: Would attach to session '%s'\n", plan.session.Name)
+			return nil
+		}
+		if err := sessionManager.AttachSession(plan.session.ID); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to attach to session", err))
+		}
+
+	case worktreeOpenCreate:
+		sessionName, err := resolveWorktreeSessionName(cfg, "", worktreeManager, target.Path, target.Branch)
+		if err != nil {
+			return handleCLIError(err)
+		}
+		if isDryRun() {
+			fmt.Printf("Dry run: Would create and attach to session '%s' in %s\n", sessionName, target.Path)
+			return nil
+		}
+
+		session, err := sessionManager.CreateSessionWithName(sessionName, worktreeManager.ProjectName(), filepath.Base(target.Path), target.Branch, target.Path)
+		if err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to create tmux session", err))
+		}
+		if err := sessionManager.AttachSession(session.ID); err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to attach to session", err))
+		}
+
+	default:
+		if !isQuiet() {
+			fmt.Printf("No tmux session is running in %s\n", target.Path)
+			fmt.Printf("\nTo change into the worktree, run:\n")
+			fmt.Printf("  cd %s\n", target.Path)
+			fmt.Printf("\nOr start a session with:\n")
+			fmt.Printf("  ccmgr-ultra worktree open %s --start-session\n", worktreeName)
+		}
+	}
+
+	return nil
+}
+
+// worktreeOpenAction is what worktree open does for a worktree
+type worktreeOpenAction int
+
+const (
+	worktreeOpenHint worktreeOpenAction = iota
+	worktreeOpenAttach
+	worktreeOpenCreate
+)
+
+// worktreeOpenPlan is the resolved action of worktree open, with the
+// session to attach to for worktreeOpenAttach
+type worktreeOpenPlan struct {
+	action  worktreeOpenAction
+	session *tmux.Session
+}
+
+// planWorktreeOpen decides how to open wt. A running session in the
+// worktree is attached to, preferring one started in its root directory;
+// otherwise a session is created when startSession is set.
+func planWorktreeOpen(wt git.WorktreeInfo, sessions []*tmux.Session, startSession bool) worktreeOpenPlan {
+	match := tmux.ActiveSessionInPath(sessions, wt.Path)
+
+	switch {
+	case match != nil:
+		return worktreeOpenPlan{action: worktreeOpenAttach, session: match}
+	case startSession:
+		return worktreeOpenPlan{action: worktreeOpenCreate}
+	default:
+		return worktreeOpenPlan{action: worktreeOpenHint}
+	}
+}
+
+// worktreeEditorCommand returns the editor command line, taken from
+// commands.environment.EDITOR, then $VISUAL, then $EDITOR, falling back to vi
+func worktreeEditorCommand(cfg *config.Config, getenv func(string) string) []string {
+	candidates := []string{cfg.Commands.Environment["EDITOR"], getenv("VISUAL"), getenv("EDITOR")}
+	for _, candidate := range candidates {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// runWorktreeEditor runs editor on path from inside path, connected to the
+// terminal, and waits for it to exit
+func runWorktreeEditor(editor []string, path string, env map[string]string) error {
+	editorCmd := exec.Command(editor[0], append(editor[1:], path)...)
+	editorCmd.Dir = path
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	editorCmd.Env = os.Environ()
+	for key, value := range env {
+		editorCmd.Env = append(editorCmd.Env, key+"="+value)
+	}
+	return editorCmd.Run()
+}
+
+// Helper functions
+
+// splitCommaList splits a comma-separated flag value, dropping empty entries
+func splitCommaList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// newWorktreeListItem converts wt for list output, listing the names of the
+// sessions within it when withSessions is set
+func newWorktreeListItem(wt git.WorktreeInfo, sessions []*tmux.Session, withSessions bool) WorktreeListItem {
+	item := WorktreeListItem{
+		Name:         filepath.Base(wt.Path),
+		Path:         wt.Path,
+		Branch:       wt.Branch,
+		Head:         wt.Head,
+		Detached:     wt.Detached,
+		IsClean:      wt.IsClean,
+		TmuxSession:  wt.TmuxSession,
+		LastAccessed: wt.LastAccessed,
+		Created:      wt.Created,
+	}
+
+	// Determine status
+	if wt.IsClean {
+		item.Status = "clean"
+	} else {
+		item.Status = "dirty"
+	}
+
+	// Check if worktree has active sessions
+	worktreeSessions := tmux.SessionsInPath(sessions, wt.Path)
+	if len(worktreeSessions) > 0 {
+		item.Status = "active"
+	}
+
+	// Merging or pushing needs a branch, so a detached HEAD always shows
+	if wt.Detached {
+		item.Status = "detached"
+	}
+
+	if withSessions {
+		item.Sessions = sessionNames(worktreeSessions)
+	}
+
+	return item
+}
+
+// collectWorktreeGitStatus fills in the ahead/behind counts for a worktree
+// and, when withStatus is set, its staged/modified/untracked/conflicted file
+// counts. The file counts need an extra git status call per worktree, so they
+// are skipped otherwise. Failures are ignored so a single unreadable worktree
+// does not prevent listing the rest.
+func collectWorktreeGitStatus(item *WorktreeListItem, ops *git.GitOperations, withStatus bool) {
+	if item.Branch != "" {
+		if info, err := ops.GetBranchInfo(item.Branch); err == nil {
+			item.Ahead = info.Ahead
+			item.Behind = info.Behind
+		}
+	}
+
+	if !withStatus {
+		return
+	}
+
+	status, err := ops.GetStatus()
+	if err != nil {
+		return
+	}
+
+	counts := countFileStatus(status)
+	item.Staged = counts.staged
+	item.Modified = counts.modified
+	item.Untracked = counts.untracked
+	item.Conflicted = counts.conflicted
+}
+
+// fileStatusCounts tallies the porcelain status codes of a worktree
+type fileStatusCounts struct {
+	staged, modified, untracked, conflicted int
+}
 
-		// Set default PR body if not provided and template exists
-		if prOptions.Description == "" {
-			// Use GitHub-specific template if available
-			if cfg.Git.GitHubPRTemplate != "" {
-				prOptions.Description = cfg.Git.GitHubPRTemplate
-			} else if cfg.Git.PRTemplate != "" {
-				prOptions.Description = cfg.Git.PRTemplate
+// countFileStatus counts the staged, modified, untracked and conflicted files
+// in a GetStatus result. A file staged and then modified again counts as both.
+func countFileStatus(status map[string]string) fileStatusCounts {
+	var counts fileStatusCounts
+	for _, code := range status {
+		switch {
+		case code == "??":
+			counts.untracked++
+		case isConflictStatus(code):
+			counts.conflicted++
+		default:
+			if code[0] != ' ' {
+				counts.staged++
+			}
+			if code[1] != ' ' {
+				counts.modified++
 			}
 		}
+	}
+	return counts
+}
 
-		if spinner != nil {
-			spinner.SetMessage("Creating GitHub pull request...")
-		}
+// collectWorktreeDetails calls collect for every item on a pool of at most
+// workers goroutines, or GOMAXPROCS when workers is zero or less. Each call
+// fills in only its own item, so items keep their order. progress, when set,
+// is called after each item with the number completed so far and may be
+// called concurrently.
+func collectWorktreeDetails(items []WorktreeListItem, workers int, collect func(item *WorktreeListItem), progress func(done int)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
 
-		// Push and create PR
-		pr, err := remoteManager.PushAndCreatePR(targetWorktree, prOptions)
-		if err != nil {
-			return handleCLIError(cli.NewErrorWithCause("failed to push and create pull request", err))
-		}
+	indexes := make(chan int)
+	var completed atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				collect(&items[i])
+				done := completed.Add(1)
+				if progress != nil {
+					progress(int(done))
+				}
+			}
+		}()
+	}
 
-		if spinner != nil {
-			spinner.StopWithMessage(fmt.Sprintf("Successfully pushed and created PR #%d", pr.Number))
-		}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
 
-		if !isQuiet() {
-			fmt.Printf("\nPull Request Created:\n")
-			fmt.Printf("  Title: %s\n", pr.Title)
-			fmt.Printf("  Number: #%d\n", pr.Number)
-			fmt.Printf("  URL: %s\n", pr.URL)
-			fmt.Printf("  Status: %s\n", pr.State)
-			if pr.Draft {
-				fmt.Printf("  Type: Draft\n")
+// isConflictStatus reports whether a porcelain status code marks an unmerged
+// path
+func isConflictStatus(code string) bool {
+	switch code {
+	case "DD", "AU", "UD", "UA", "DU", "AA", "UU":
+		return true
+	}
+	return false
+}
+
+// worktreeTransaction finishes a pending worktree creation
+type worktreeTransaction interface {
+	Commit() error
+	Rollback() error
+}
+
+// completeWorktreeCreation runs the creation steps that follow `git worktree
+// add`, committing the worktree once they all succeed. When a step fails the
+// worktree is rolled back, unless keepOnFailure is set, in which case it is
+// left pending so re-running the command resumes it.
+func completeWorktreeCreation(tx worktreeTransaction, keepOnFailure bool, steps ...func() error) error {
+	for _, step := range steps {
+		if err := step(); err != nil {
+			if keepOnFailure {
+				return err
 			}
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+			}
+			return err
 		}
-	} else {
-		// Just push without creating PR
-		if err := remoteManager.PushBranch(targetWorktree.Branch); err != nil {
-			return handleCLIError(cli.NewErrorWithCause("failed to push branch", err))
-		}
+	}
 
-		if spinner != nil {
-			spinner.StopWithMessage(fmt.Sprintf("Successfully pushed branch '%s'", targetWorktree.Branch))
-		}
+	return tx.Commit()
+}
 
-		if !isQuiet() {
-			fmt.Printf("Branch '%s' pushed successfully\n", targetWorktree.Branch)
-		}
+// newWorktreeCreationError reports a failed creation step, explaining whether
+// the partially created worktree was kept for resuming or removed
+func newWorktreeCreationError(err error, path string, kept bool) *cli.CLIError {
+	if kept {
+		return cli.NewErrorWithCause("failed to complete worktree creation", err).
+			WithSuggestion(fmt.Sprintf("The worktree was kept at %s; re-run the same command to resume", path))
+	}
+	return cli.NewErrorWithCause("failed to complete worktree creation", err).
+		WithSuggestion("The partially created worktree was removed; use --keep-on-failure to keep it for resuming")
+}
+
+// sessionNames returns the names of the given sessions
+func sessionNames(sessions []*tmux.Session) []string {
+	names := make([]string, 0, len(sessions))
+	for _, sess := range sessions {
+		names = append(names, sess.Name)
+	}
+	return names
+}
+
+// detachedBranchLabel stands in for the branch of a detached worktree
+const detachedBranchLabel = "(detached HEAD)"
+
+// worktreeBranchLabel returns the branch of wt for display
+func worktreeBranchLabel(wt *git.WorktreeInfo) string {
+	if wt.Detached {
+		return detachedBranchLabel
 	}
+	return wt.Branch
+}
 
+// findWorktree looks up a worktree by directory name, branch, or full path
+func findWorktree(worktrees []git.WorktreeInfo, name string) *git.WorktreeInfo {
+	for i := range worktrees {
+		wt := &worktrees[i]
+		if filepath.Base(wt.Path) == name || wt.Branch == name || wt.Path == name {
+			return wt
+		}
+	}
 	return nil
 }
 
-// Helper functions
+// applyBaseDirectoryOverride replaces worktree.base_directory with the
+// --base-directory value for this invocation, rejecting a value that would
+// place worktrees inside the repository
+func applyBaseDirectoryOverride(cfg *config.Config, baseDir, repoRoot string) error {
+	if baseDir == "" {
+		return nil
+	}
+
+	override := cfg.Worktree
+	override.BaseDirectory = baseDir
+	if err := override.Validate(); err != nil {
+		return cli.NewErrorWithCause(fmt.Sprintf("invalid --base-directory %q", baseDir), err).
+			WithSuggestion("Use a path or template such as ../elsewhere/{{.Project}}")
+	}
+
+	if err := git.NewPatternManager(&override).ValidateBaseDirectory(baseDir, repoRoot); err != nil {
+		return cli.NewErrorWithCause(fmt.Sprintf("invalid --base-directory %q", baseDir), err).
+			WithSuggestion("Choose a directory outside the repository, for example --base-directory '../.worktrees/{{.Project}}'")
+	}
+
+	cfg.Worktree.BaseDirectory = baseDir
+	return nil
+}
 
 func handlePatternError(err error) error {
-	if strings.Contains(err.Error(), "template") ||
-		strings.Contains(err.Error(), "pattern") ||
-		strings.Contains(err.Error(), "variable") {
-		return cli.NewErrorWithSuggestion(
-			fmt.Sprintf("Template pattern error: %v", err),
-			"Check your directory_pattern in config. Use Go template syntax like {{.Project}}-{{.Branch}}",
-		)
+	var insideErr *git.InsideRepositoryError
+	if cliErr, ok := err.(*cli.CLIError); ok && errors.As(cliErr.Cause, &insideErr) {
+		return handleCLIError(cliErr.WithSuggestion(git.PatternErrorSuggestion(insideErr)))
+	}
+
+	if suggestion := git.PatternErrorSuggestion(err); suggestion != "" {
+		return cli.NewErrorWithSuggestion(fmt.Sprintf("Template pattern error: %v", err), suggestion)
 	}
 	return handleCLIError(err)
 }
 
-func generateSessionName(cfg *config.Config, branchName string) string {
-	// Use configured pattern or default
-	pattern := "ccmgr-%s"
-	if cfg.Tmux.NamingPattern != "" {
-		pattern = cfg.Tmux.NamingPattern
+// resolveWorktreeSessionName returns the tmux session name for the worktree
+// at worktreePath: the explicit override if given, validated with
+// validateSessionNameOverride, or the name worktreeManager generates from
+// tmux.naming_pattern otherwise
+func resolveWorktreeSessionName(cfg *config.Config, override string, worktreeManager *git.WorktreeManager, worktreePath, branch string) (string, error) {
+	if override != "" {
+		if err := validateSessionNameOverride(cfg, override); err != nil {
+			return "", err
+		}
+		return override, nil
+	}
+
+	name, err := worktreeManager.SessionName(worktreePath, branch)
+	if err != nil {
+		return "", cli.NewErrorWithSuggestion(
+			fmt.Sprintf("failed to generate session name: %v", err),
+			"Check tmux.naming_pattern in the config, e.g. {{.Prefix}}-{{.Project}}-{{.Worktree}}-{{.Branch}}",
+		)
+	}
+	return name, nil
+}
+
+// validateSessionNameOverride checks a --session-name value against tmux
+// naming rules and the configured maximum length
+func validateSessionNameOverride(cfg *config.Config, override string) error {
+	if err := validateSessionArg(override); err != nil {
+		return err
 	}
 
-	// Replace placeholders
-	name := strings.ReplaceAll(pattern, "%s", branchName)
-	name = strings.ReplaceAll(name, "{branch}", branchName)
-	name = strings.ReplaceAll(name, "{project}", filepath.Base(getCurrentProjectName()))
+	if cfg.Tmux.MaxSessionName > 0 && len(override) > cfg.Tmux.MaxSessionName {
+		return cli.NewErrorWithSuggestion(
+			fmt.Sprintf("session name '%s' is %d characters, exceeding the maximum of %d", override, len(override), cfg.Tmux.MaxSessionName),
+			"Use a shorter --session-name or raise tmux.max_session_name in the config",
+		)
+	}
 
-	return name
+	return nil
 }
 
 func getCurrentProjectName() string {
@@ -787,8 +2100,431 @@ func getCurrentProjectName() string {
 	return filepath.Base(cwd)
 }
 
-func sortWorktreeList(worktrees []WorktreeListItem, sortBy string) {
-	// Simple sorting implementation - could be enhanced
-	// For now, just ensure deterministic output
-	// Real implementation would sort based on the sortBy parameter
+// worktreeStatusOrder ranks statuses for --sort status, most attention-worthy first
+var worktreeStatusOrder = map[string]int{
+	"detached": 0,
+	"active":   1,
+	"dirty":    2,
+	"clean":    3,
+}
+
+// sortWorktreeList sorts worktrees in place by name, last-accessed (most
+// recent first), created (newest first) or status (active, dirty, clean).
+// Ties are broken by name.
+func sortWorktreeList(worktrees []WorktreeListItem, sortBy string) error {
+	var less func(a, b WorktreeListItem) bool
+
+	switch sortBy {
+	case "", "name":
+		less = func(a, b WorktreeListItem) bool { return false }
+	case "last-accessed":
+		less = func(a, b WorktreeListItem) bool { return a.LastAccessed.After(b.LastAccessed) }
+	case "created":
+		less = func(a, b WorktreeListItem) bool { return a.Created.After(b.Created) }
+	case "status":
+		less = func(a, b WorktreeListItem) bool { return statusRank(a.Status) < statusRank(b.Status) }
+	default:
+		return fmt.Errorf("invalid sort value '%s' (must be name, last-accessed, created, or status)", sortBy)
+	}
+
+	sort.SliceStable(worktrees, func(i, j int) bool {
+		a, b := worktrees[i], worktrees[j]
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.Name < b.Name
+	})
+
+	return nil
+}
+
+// statusRank returns the sort rank of a worktree status; unknown statuses sort last
+func statusRank(status string) int {
+	if rank, ok := worktreeStatusOrder[status]; ok {
+		return rank
+	}
+	return len(worktreeStatusOrder)
+}
+
+func runWorktreeRestoreCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	if len(args) == 0 {
+		backups, err := git.ListWorktreeBackups()
+		if err != nil {
+			return handleCLIError(cli.NewErrorWithCause("failed to list worktree backups", err))
+		}
+		printWorktreeBackups(os.Stdout, backupsForRepository(backups, repo.RootPath))
+		return nil
+	}
+
+	backup, err := git.FindWorktreeBackup(args[0])
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to load worktree backup", err).
+			WithSuggestion("Use 'ccmgr-ultra worktree restore' to see available backups"))
+	}
+	if backup.RepoPath != repo.RootPath {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("backup %s belongs to another repository: %s", backup.ID, backup.RepoPath),
+			"Run the restore from within that repository",
+		))
+	}
+
+	path := backup.WorktreePath
+	if worktreeRestoreFlags.directory != "" {
+		path = config.ExpandPath(worktreeRestoreFlags.directory)
+	}
+
+	if isDryRun() {
+		fmt.Printf("Dry run: Would restore branch '%s' with its uncommitted changes to %s\n", backup.Branch, path)
+		return nil
+	}
+
+	restored, err := git.NewWorktreeManager(repo, cfg, gitCmd).RestoreWorktree(backup, path)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to restore worktree", err))
+	}
+
+	if !isQuiet() {
+		fmt.Printf("Worktree restored to %s on branch '%s'\n", restored, backup.Branch)
+	}
+	return nil
+}
+
+// backupsForRepository keeps the backups taken from the repository at root
+func backupsForRepository(backups []git.WorktreeBackup, root string) []git.WorktreeBackup {
+	var matching []git.WorktreeBackup
+	for _, backup := range backups {
+		if backup.RepoPath == root {
+			matching = append(matching, backup)
+		}
+	}
+	return matching
+}
+
+// printWorktreeBackups writes one line per backup to w
+func printWorktreeBackups(w io.Writer, backups []git.WorktreeBackup) {
+	if len(backups) == 0 {
+		fmt.Fprintln(w, "No worktree backups found")
+		return
+	}
+
+	fmt.Fprintf(w, "%-40s  %-30s  %-16s  %s\n", "ID", "BRANCH", "CREATED", "PATH")
+	for _, backup := range backups {
+		fmt.Fprintf(w, "%-40s  %-30s  %-16s  %s\n",
+			backup.ID, backup.Branch, backup.CreatedAt.Format("2006-01-02 15:04"), backup.WorktreePath)
+	}
+}
+
+func runWorktreeStatusCommand(cmd *cobra.Command, args []string) error {
+	worktreeName := args[0]
+
+	if err := validateWorktreeArg(worktreeName); err != nil {
+		return handleCLIError(err)
+	}
+
+	outputFormat, err := cli.ValidateFormat(worktreeStatusFlags.format)
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	repo, gitCmd, err := loadRepository()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	worktrees, err := git.NewWorktreeManager(repo, cfg, gitCmd).ListWorktrees()
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause("failed to list worktrees", err))
+	}
+
+	target := findWorktree(worktrees, worktreeName)
+	if target == nil {
+		return handleCLIError(cli.NewErrorWithSuggestion(
+			fmt.Sprintf("worktree not found: %s", worktreeName),
+			"Use 'ccmgr-ultra worktree list' to see available worktrees",
+		))
+	}
+
+	ops := git.NewGitOperationsInDir(repo, gitCmd, target.Path)
+	sources := worktreeStatusSources{
+		branchInfo:    ops.GetBranchInfo,
+		fileStatus:    ops.GetStatus,
+		commitHistory: ops.GetCommitHistory,
+		sessions:      tmux.NewSessionManager(cfg).ListSessions,
+		processes: func() ([]*claude.ProcessInfo, error) {
+			controller, err := newClaudeProcessController(cfg)
+			if err != nil {
+				return nil, err
+			}
+			return controller.ListProcesses()
+		},
+	}
+
+	statusData, err := buildWorktreeStatus(*target, sources, worktreeStatusFlags.commits)
+	if err != nil {
+		return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("failed to read status of %s", target.Path), err))
+	}
+
+	if outputFormat == cli.FormatTable {
+		printWorktreeStatus(os.Stdout, statusData)
+		return nil
+	}
+	return cli.NewFormatter(outputFormat, os.Stdout).Format(statusData)
+}
+
+// worktreeStatusSources supplies the details reported by worktree status
+type worktreeStatusSources struct {
+	branchInfo    func(branch string) (*git.BranchInfo, error)
+	fileStatus    func() (map[string]string, error)
+	commitHistory func(branch string, limit int) ([]git.CommitInfo, error)
+	sessions      func() ([]*tmux.Session, error)
+	processes     func() ([]*claude.ProcessInfo, error)
+}
+
+// buildWorktreeStatus collects the status of wt with its commitLimit most
+// recent commits. Git failures are returned, but tmux and Claude Code are
+// optional, so when sessions or processes cannot be listed those sections are
+// left empty.
+func buildWorktreeStatus(wt git.WorktreeInfo, sources worktreeStatusSources, commitLimit int) (*WorktreeStatusData, error) {
+	statusData := &WorktreeStatusData{
+		Name:          filepath.Base(wt.Path),
+		Path:          wt.Path,
+		Branch:        wt.Branch,
+		Head:          wt.Head,
+		Detached:      wt.Detached,
+		IsClean:       wt.IsClean,
+		Files:         []WorktreeFileStatus{},
+		Sessions:      []string{},
+		Processes:     []ClaudeProcessItem{},
+		RecentCommits: []WorktreeCommitItem{},
+		LastAccessed:  wt.LastAccessed,
+		Created:       wt.Created,
+		Timestamp:     time.Now(),
+	}
+
+	// A detached HEAD has no branch to compare with an upstream
+	if !wt.Detached && wt.Branch != "" {
+		if info, err := sources.branchInfo(wt.Branch); err == nil {
+			statusData.Upstream = info.Upstream
+			statusData.Ahead = info.Ahead
+			statusData.Behind = info.Behind
+		}
+	}
+
+	status, err := sources.fileStatus()
+	if err != nil {
+		return nil, err
+	}
+	counts := countFileStatus(status)
+	statusData.Staged = counts.staged
+	statusData.Modified = counts.modified
+	statusData.Untracked = counts.untracked
+	statusData.Conflicted = counts.conflicted
+	statusData.IsClean = len(status) == 0
+	for path, code := range status {
+		statusData.Files = append(statusData.Files, WorktreeFileStatus{Path: path, Status: code})
+	}
+	sort.Slice(statusData.Files, func(i, j int) bool {
+		return statusData.Files[i].Path < statusData.Files[j].Path
+	})
+
+	commits, err := sources.commitHistory("HEAD", commitLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, commit := range commits {
+		statusData.RecentCommits = append(statusData.RecentCommits, WorktreeCommitItem{
+			Hash:    commit.Hash,
+			Author:  commit.Author,
+			Date:    commit.Date,
+			Message: commit.Message,
+		})
+	}
+
+	if sessions, err := sources.sessions(); err == nil {
+		statusData.Sessions = sessionNames(tmux.SessionsInPath(sessions, wt.Path))
+	}
+
+	if processes, err := sources.processes(); err == nil {
+		for _, process := range processes {
+			if process.WorktreeID == statusData.Name || tmux.IsPathWithin(process.WorkingDir, wt.Path) {
+				statusData.Processes = append(statusData.Processes, newClaudeProcessItem(process))
+			}
+		}
+		sort.Slice(statusData.Processes, func(i, j int) bool {
+			return statusData.Processes[i].StartTime.Before(statusData.Processes[j].StartTime)
+		})
+	}
+
+	return statusData, nil
+}
+
+// printWorktreeStatus writes statusData to w as one section per detail
+func printWorktreeStatus(w io.Writer, statusData *WorktreeStatusData) {
+	branch := statusData.Branch
+	if statusData.Detached {
+		branch = detachedBranchLabel
+	}
+	state := "clean"
+	if !statusData.IsClean {
+		state = "dirty"
+	}
+
+	fmt.Fprintf(w, "Worktree: %s\n", statusData.Name)
+	fmt.Fprintf(w, "  Path:     %s\n", statusData.Path)
+	fmt.Fprintf(w, "  Branch:   %s\n", branch)
+	fmt.Fprintf(w, "  HEAD:     %s\n", shortHash(statusData.Head))
+	if statusData.Upstream != "" {
+		fmt.Fprintf(w, "  Upstream: %s (%d ahead, %d behind)\n", statusData.Upstream, statusData.Ahead, statusData.Behind)
+	} else {
+		fmt.Fprintf(w, "  Upstream: none\n")
+	}
+	fmt.Fprintf(w, "  Status:   %s (%d staged, %d modified, %d untracked, %d conflicted)\n",
+		state, statusData.Staged, statusData.Modified, statusData.Untracked, statusData.Conflicted)
+
+	if len(statusData.Files) > 0 {
+		fmt.Fprintf(w, "\nChanged files:\n")
+		for _, file := range statusData.Files {
+			fmt.Fprintf(w, "  %s %s\n", file.Status, file.Path)
+		}
+	}
+
+	fmt.Fprintf(w, "\nSessions:\n")
+	if len(statusData.Sessions) == 0 {
+		fmt.Fprintf(w, "  none\n")
+	}
+	for _, name := range statusData.Sessions {
+		fmt.Fprintf(w, "  %s\n", name)
+	}
+
+	fmt.Fprintf(w, "\nClaude Code:\n")
+	if len(statusData.Processes) == 0 {
+		fmt.Fprintf(w, "  none\n")
+	}
+	for _, process := range statusData.Processes {
+		fmt.Fprintf(w, "  PID %d  %-8s  started %s\n", process.PID, process.State, process.StartTime.Format("2006-01-02 15:04"))
+	}
+
+	fmt.Fprintf(w, "\nRecent commits:\n")
+	if len(statusData.RecentCommits) == 0 {
+		fmt.Fprintf(w, "  none\n")
+	}
+	for _, commit := range statusData.RecentCommits {
+		fmt.Fprintf(w, "  %s  %s  %-20s  %s\n",
+			shortHash(commit.Hash), commit.Date.Format("2006-01-02 15:04"), commit.Author, commit.Message)
+	}
+}
+
+// shortHash abbreviates a commit hash as the list formatters do
+func shortHash(hash string) string {
+	if len(hash) > 8 {
+		return hash[:8]
+	}
+	return hash
+}
+
+func runWorktreePatternPreviewCommand(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfigWithOverrides()
+	if err != nil {
+		return handleCLIError(err)
+	}
+
+	// git.directory_pattern takes precedence, as in worktree creation
+	worktreeConfig := cfg.Worktree
+	if cfg.Git.DirectoryPattern != "" {
+		worktreeConfig.DirectoryPattern = cfg.Git.DirectoryPattern
+	}
+
+	pattern := worktreePatternPreviewFlags.pattern
+	if pattern == "" {
+		pattern = worktreeConfig.DirectoryPattern
+	}
+
+	pm := git.NewPatternManager(&worktreeConfig)
+	if err := printPatternPreview(os.Stdout, pm, pattern); err != nil {
+		return handleCLIError(cli.NewErrorWithCause(fmt.Sprintf("invalid pattern '%s'", pattern), err).
+			WithSuggestion(patternVariablesSuggestion(pm)))
+	}
+	return nil
+}
+
+// patternVariablesSuggestion lists the template variables a pattern can use
+func patternVariablesSuggestion(pm *git.PatternManager) string {
+	variables := make([]string, 0, len(pm.GetPatternVariables()))
+	for name := range pm.GetPatternVariables() {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return "Available variables: " + strings.Join(variables, ", ")
+}
+
+// printPatternPreview validates pattern and writes the directory names it
+// produces for example branches, followed by the available template
+// variables and functions
+func printPatternPreview(w io.Writer, pm *git.PatternManager, pattern string) error {
+	if err := pm.ValidatePattern(pattern); err != nil {
+		return err
+	}
+	examples, err := pm.GenerateExamplePaths(pattern)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Pattern: %s\n\n", pattern)
+	fmt.Fprintln(w, "Examples:")
+	for _, example := range examples {
+		fmt.Fprintf(w, "  %s\n", example)
+	}
+
+	fmt.Fprintln(w, "\nVariables:")
+	printSortedDescriptions(w, pm.GetPatternVariables())
+	fmt.Fprintln(w, "\nFunctions:")
+	printSortedDescriptions(w, pm.GetPatternFunctions())
+	return nil
+}
+
+// printSortedDescriptions writes name/description pairs ordered by name
+func printSortedDescriptions(w io.Writer, descriptions map[string]string) {
+	names := make([]string, 0, len(descriptions))
+	for name := range descriptions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-16s  %s\n", name, descriptions[name])
+	}
+}
+
+// relativeWorktreePath renders path relative to baseDir when it lies within
+// it, otherwise relative to repoRoot when it lies within that, and otherwise
+// as the absolute path
+func relativeWorktreePath(path, baseDir, repoRoot string) string {
+	for _, root := range []string{baseDir, repoRoot} {
+		if root == "" {
+			continue
+		}
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return rel
+		}
+	}
+	return path
 }
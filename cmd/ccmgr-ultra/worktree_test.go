@@ -1,10 +1,25 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
+	"github.com/unbracketed/ccmgr-ultra/internal/cli"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
 	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
 )
 
 func TestWorktreeOptions_AutoName(t *testing.T) {
@@ -89,6 +104,17 @@ func TestHandlePatternError(t *testing.T) {
 	}
 }
 
+func TestHandlePatternError_InsideRepository(t *testing.T) {
+	inside := &git.InsideRepositoryError{Subject: "worktree path", Path: "/repo/.worktrees/x", RepoRoot: "/repo"}
+	err := handlePatternError(cli.NewErrorWithCause("failed to create worktree", fmt.Errorf("invalid worktree path: %w", inside)))
+
+	var cliErr *cli.CLIError
+	require.True(t, errors.As(err, &cliErr))
+	assert.Contains(t, cliErr.Error(), "repository root: /repo")
+	assert.Contains(t, cliErr.Suggestion, "worktree.base_directory")
+	assert.NotContains(t, cliErr.Error(), "Template pattern error")
+}
+
 // mockError is a simple error implementation for testing
 type mockError struct {
 	msg string
@@ -97,3 +123,1117 @@ type mockError struct {
 func (e *mockError) Error() string {
 	return e.msg
 }
+
+func TestValidateTrackArg(t *testing.T) {
+	assert.NoError(t, validateTrackArg(""))
+	assert.NoError(t, validateTrackArg("origin/feature/login"))
+
+	for _, track := range []string{"origin", "origin/", "/feature"} {
+		err := validateTrackArg(track)
+		require.Error(t, err, track)
+
+		var cliErr *cli.CLIError
+		require.True(t, errors.As(err, &cliErr))
+		assert.Contains(t, cliErr.Message, "expected <remote>/<branch>")
+		assert.Contains(t, cliErr.Suggestion, "--track origin/feature/login")
+	}
+}
+
+func TestResolveWorktreeSessionName(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Tmux.MaxSessionName = 20
+	worktreeManager := git.NewWorktreeManager(&git.Repository{RootPath: "/work/proj"}, cfg, nil)
+
+	tests := []struct {
+		name     string
+		override string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no override uses naming pattern truncated to max",
+			override: "",
+			expected: "ccmgr-proj-feature-f",
+		},
+		{
+			name:     "override is used as-is",
+			override: "my-session",
+			expected: "my-session",
+		},
+		{
+			name:     "override with colon is rejected",
+			override: "bad:name",
+			wantErr:  true,
+		},
+		{
+			name:     "override longer than max is rejected",
+			override: "a-very-long-session-name",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := resolveWorktreeSessionName(cfg, tt.override, worktreeManager, "/work/proj-worktrees/feature", "feature")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestResolveWorktreeSessionName_UsesRepositoryAndWorktreeDirectory(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	cfg := &config.Config{}
+	worktreeManager := git.NewWorktreeManager(&git.Repository{RootPath: "/work/proj"}, cfg, nil)
+
+	result, err := resolveWorktreeSessionName(cfg, "", worktreeManager, "/work/proj-worktrees/login", "feature/login")
+	require.NoError(t, err)
+	assert.Equal(t, "ccmgr-proj-login-feature_login", result)
+}
+
+type mockWorktreeTransaction struct {
+	committed   bool
+	rolledBack  bool
+	rollbackErr error
+}
+
+func (m *mockWorktreeTransaction) Commit() error {
+	m.committed = true
+	return nil
+}
+
+func (m *mockWorktreeTransaction) Rollback() error {
+	m.rolledBack = true
+	return m.rollbackErr
+}
+
+func TestCompleteWorktreeCreation(t *testing.T) {
+	sessionFailure := errors.New("failed to create tmux session: tmux not available")
+	succeed := func() error { return nil }
+	failSession := func() error { return sessionFailure }
+
+	tests := []struct {
+		name           string
+		steps          []func() error
+		keepOnFailure  bool
+		rollbackErr    error
+		wantErr        bool
+		wantCommitted  bool
+		wantRolledBack bool
+	}{
+		{
+			name:          "all steps succeed",
+			steps:         []func() error{succeed, succeed},
+			wantCommitted: true,
+		},
+		{
+			name:           "failure after worktree add rolls back",
+			steps:          []func() error{failSession},
+			wantErr:        true,
+			wantRolledBack: true,
+		},
+		{
+			name:          "failure with keep-on-failure leaves worktree resumable",
+			steps:         []func() error{failSession},
+			keepOnFailure: true,
+			wantErr:       true,
+		},
+		{
+			name:           "rollback failure is reported",
+			steps:          []func() error{failSession},
+			rollbackErr:    errors.New("worktree is locked"),
+			wantErr:        true,
+			wantRolledBack: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &mockWorktreeTransaction{rollbackErr: tt.rollbackErr}
+
+			err := completeWorktreeCreation(tx, tt.keepOnFailure, tt.steps...)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, sessionFailure)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.rollbackErr != nil {
+				assert.Contains(t, err.Error(), "rollback failed: worktree is locked")
+			}
+			assert.Equal(t, tt.wantCommitted, tx.committed)
+			assert.Equal(t, tt.wantRolledBack, tx.rolledBack)
+		})
+	}
+}
+
+func TestNewWorktreeCreationError(t *testing.T) {
+	cause := errors.New("failed to create tmux session")
+
+	kept := newWorktreeCreationError(cause, "/work/feature", true)
+	assert.Contains(t, kept.Suggestion, "/work/feature")
+	assert.Contains(t, kept.Suggestion, "resume")
+
+	removed := newWorktreeCreationError(cause, "/work/feature", false)
+	assert.Contains(t, removed.Suggestion, "--keep-on-failure")
+}
+
+func TestResolveMergeTarget(t *testing.T) {
+	repo := &git.Repository{RootPath: "/work/app"}
+	worktrees := []git.WorktreeInfo{
+		{Path: "/work/app", Branch: "develop"},
+		{Path: "/work/app-main", Branch: "main"},
+		{Path: "/work/app-feature", Branch: "feature/auth"},
+		{Path: "/work/app-bisect", Head: "def456ghi", Detached: true},
+	}
+
+	tests := []struct {
+		name           string
+		target         string
+		into           string
+		expectedBranch string
+		expectedPath   string
+		expectError    bool
+	}{
+		{"target checked out in a worktree", "main", "", "main", "/work/app-main", false},
+		{"target not checked out falls back to repository", "release", "", "release", "/work/app", false},
+		{"into another worktree", "main", "app-feature", "feature/auth", "/work/app-feature", false},
+		{"unknown into worktree", "main", "missing", "", "", true},
+		{"into a detached worktree", "main", "app-bisect", "", "", true},
+		{"empty target", "", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			branch, path, err := resolveMergeTarget(worktrees, repo, tt.target, tt.into)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBranch, branch)
+			assert.Equal(t, tt.expectedPath, path)
+		})
+	}
+}
+
+func TestNewMergeConflictError(t *testing.T) {
+	conflicts := []string{"auth.go", "README.md"}
+
+	err := newMergeConflictError(git.MergeStrategySquash, conflicts, "/work/app-feature", "/work/app-main")
+	assert.Contains(t, err.Error(), "merge conflicts in /work/app-main: auth.go, README.md")
+	assert.Contains(t, err.Suggestion, "git merge --abort")
+
+	err = newMergeConflictError(git.MergeStrategyRebase, conflicts, "/work/app-feature", "/work/app-main")
+	assert.Contains(t, err.Error(), "rebase conflicts in /work/app-feature: auth.go, README.md")
+	assert.Contains(t, err.Suggestion, "git rebase --continue")
+}
+
+// fakeWorktreeGit answers git commands from canned output keyed by the joined
+// arguments, failing any command it does not know, and counts calls. It is
+// safe for concurrent use.
+type fakeWorktreeGit struct {
+	mu        sync.Mutex
+	responses map[string]string
+	calls     int
+	delay     time.Duration
+}
+
+func (f *fakeWorktreeGit) Execute(dir string, args ...string) (string, error) {
+	time.Sleep(f.delay)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if output, ok := f.responses[strings.Join(args, " ")]; ok {
+		return output, nil
+	}
+	return "", fmt.Errorf("unexpected git command: %s", strings.Join(args, " "))
+}
+
+func (f *fakeWorktreeGit) ExecuteWithInput(dir, input string, args ...string) (string, error) {
+	return f.Execute(dir, args...)
+}
+
+// newFakeWorktreeGit returns a fake for a worktree on branch that is two
+// commits ahead of and one behind its upstream, with the given porcelain status
+func newFakeWorktreeGit(branch, status string) *fakeWorktreeGit {
+	return &fakeWorktreeGit{responses: map[string]string{
+		"rev-parse --verify " + branch:                                    "abc123",
+		"rev-parse " + branch:                                             "abc123",
+		"rev-parse --abbrev-ref " + branch + "@{upstream}":                "origin/" + branch,
+		"rev-list --left-right --count " + branch + "...origin/" + branch: "2\t1",
+		"status --porcelain":                                              status,
+	}}
+}
+
+func TestCollectWorktreeGitStatus(t *testing.T) {
+	repo := &git.Repository{RootPath: "/repo", CurrentBranch: "main"}
+	gitCmd := newFakeWorktreeGit("feature", "M  staged.go\n M modified.go\nMM both.go\n?? new.go\nUU conflict.go")
+
+	item := WorktreeListItem{Name: "feature", Path: "/repo-feature", Branch: "feature"}
+	collectWorktreeGitStatus(&item, git.NewGitOperationsInDir(repo, gitCmd, item.Path), true)
+
+	assert.Equal(t, 2, item.Ahead)
+	assert.Equal(t, 1, item.Behind)
+	assert.Equal(t, 2, item.Staged)
+	assert.Equal(t, 2, item.Modified)
+	assert.Equal(t, 1, item.Untracked)
+	assert.Equal(t, 1, item.Conflicted)
+
+	// A worktree without an upstream still reports its local changes
+	gitCmd = &fakeWorktreeGit{responses: map[string]string{
+		"rev-parse --verify local": "abc123",
+		"rev-parse local":          "abc123",
+		"status --porcelain":       "?? new.go",
+	}}
+	item = WorktreeListItem{Name: "local", Path: "/repo-local", Branch: "local"}
+	collectWorktreeGitStatus(&item, git.NewGitOperationsInDir(repo, gitCmd, item.Path), true)
+
+	assert.Zero(t, item.Ahead)
+	assert.Zero(t, item.Behind)
+	assert.Equal(t, 1, item.Untracked)
+}
+
+func TestCollectWorktreeGitStatus_WithoutStatusSkipsGitStatus(t *testing.T) {
+	repo := &git.Repository{RootPath: "/repo", CurrentBranch: "main"}
+
+	withStatus := newFakeWorktreeGit("feature", " M modified.go")
+	item := WorktreeListItem{Branch: "feature"}
+	collectWorktreeGitStatus(&item, git.NewGitOperationsInDir(repo, withStatus, "/repo-feature"), true)
+	assert.Equal(t, 1, item.Modified)
+
+	withoutStatus := newFakeWorktreeGit("feature", " M modified.go")
+	delete(withoutStatus.responses, "status --porcelain")
+	item = WorktreeListItem{Branch: "feature"}
+	collectWorktreeGitStatus(&item, git.NewGitOperationsInDir(repo, withoutStatus, "/repo-feature"), false)
+
+	assert.Equal(t, 2, item.Ahead, "ahead/behind is always collected")
+	assert.Zero(t, item.Modified)
+	assert.Equal(t, withStatus.calls-1, withoutStatus.calls, "only the git status call is skipped")
+}
+
+// newWorktreeListFixture returns n worktree items, each on its own branch,
+// and a fake git answering for all of them with different ahead/behind counts
+// per worktree
+func newWorktreeListFixture(n int) ([]WorktreeListItem, *fakeWorktreeGit) {
+	items := make([]WorktreeListItem, n)
+	gitCmd := &fakeWorktreeGit{responses: map[string]string{}}
+	for i := range items {
+		branch := fmt.Sprintf("feature-%d", i)
+		items[i] = WorktreeListItem{Name: branch, Path: "/repo-" + branch, Branch: branch}
+		for args, output := range newFakeWorktreeGit(branch, "").responses {
+			gitCmd.responses[args] = output
+		}
+		gitCmd.responses["rev-list --left-right --count "+branch+"...origin/"+branch] = fmt.Sprintf("%d\t%d", i, i%3)
+	}
+	return items, gitCmd
+}
+
+// collectWithFakeGit collects the git status of items through gitCmd
+func collectWithFakeGit(items []WorktreeListItem, gitCmd *fakeWorktreeGit, workers int) {
+	repo := &git.Repository{RootPath: "/repo", CurrentBranch: "main"}
+	collectWorktreeDetails(items, workers, func(item *WorktreeListItem) {
+		collectWorktreeGitStatus(item, git.NewGitOperationsInDir(repo, gitCmd, item.Path), false)
+	}, nil)
+}
+
+func TestCollectWorktreeDetails_MatchesSerial(t *testing.T) {
+	serial, gitCmd := newWorktreeListFixture(25)
+	collectWithFakeGit(serial, gitCmd, 1)
+	assert.Equal(t, 24, serial[24].Ahead)
+	assert.Equal(t, 0, serial[24].Behind)
+
+	for _, workers := range []int{0, 2, 8, 100} {
+		items, gitCmd := newWorktreeListFixture(25)
+		collectWithFakeGit(items, gitCmd, workers)
+		assert.Equal(t, serial, items, "workers %d", workers)
+	}
+}
+
+func TestCollectWorktreeDetails_BoundsWorkersAndReportsProgress(t *testing.T) {
+	items := make([]WorktreeListItem, 20)
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	var reported []int
+	collectWorktreeDetails(items, 3, func(item *WorktreeListItem) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+		item.Name = "done"
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+	}, func(done int) {
+		mu.Lock()
+		reported = append(reported, done)
+		mu.Unlock()
+	})
+
+	assert.LessOrEqual(t, peak, 3)
+	assert.Len(t, reported, len(items))
+	assert.ElementsMatch(t, reported, func() []int {
+		expected := make([]int, len(items))
+		for i := range expected {
+			expected[i] = i + 1
+		}
+		return expected
+	}())
+	for _, item := range items {
+		assert.Equal(t, "done", item.Name)
+	}
+
+	collectWorktreeDetails(nil, 0, func(item *WorktreeListItem) {
+		t.Fatal("collect is not called without items")
+	}, nil)
+}
+
+func BenchmarkCollectWorktreeDetails(b *testing.B) {
+	for _, workers := range []int{1, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				items, gitCmd := newWorktreeListFixture(20)
+				gitCmd.delay = 100 * time.Microsecond
+				collectWithFakeGit(items, gitCmd, workers)
+			}
+		})
+	}
+}
+
+func TestWorktreeListData_SerializesGitStatus(t *testing.T) {
+	data := &WorktreeListData{
+		Worktrees: []WorktreeListItem{{Name: "feature", Branch: "feature", Status: "dirty", Ahead: 2, Behind: 1, Modified: 3}},
+		Total:     1,
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, cli.NewWorktreeFormatter(cli.FormatJSON, &out).Format(data))
+
+	var decoded struct {
+		Worktrees []map[string]interface{} `json:"worktrees"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	require.Len(t, decoded.Worktrees, 1)
+	assert.EqualValues(t, 2, decoded.Worktrees[0]["ahead"])
+	assert.EqualValues(t, 1, decoded.Worktrees[0]["behind"])
+	assert.Equal(t, false, decoded.Worktrees[0]["is_clean"])
+
+	out.Reset()
+	require.NoError(t, cli.NewWorktreeFormatter(cli.FormatYAML, &out).Format(data))
+	assert.Contains(t, out.String(), "ahead: 2")
+	assert.Contains(t, out.String(), "behind: 1")
+
+	out.Reset()
+	require.NoError(t, cli.NewWorktreeFormatter(cli.FormatTable, &out).Format(data))
+	assert.Contains(t, out.String(), "↑2↓1 ~3")
+	assert.Contains(t, out.String(), "⚠ Dirty")
+}
+
+func TestSortWorktreeList(t *testing.T) {
+	now := time.Now()
+	newItems := func() []WorktreeListItem {
+		return []WorktreeListItem{
+			{Name: "charlie", Status: "clean", LastAccessed: now.Add(-time.Hour), Created: now.Add(-72 * time.Hour)},
+			{Name: "alpha", Status: "dirty", LastAccessed: now.Add(-24 * time.Hour), Created: now.Add(-time.Hour)},
+			{Name: "delta", Status: "active", LastAccessed: now, Created: now.Add(-24 * time.Hour)},
+			{Name: "bravo", Status: "clean", LastAccessed: now.Add(-time.Hour), Created: now.Add(-48 * time.Hour)},
+			{Name: "echo", Status: "active", LastAccessed: now.Add(-2 * time.Hour), Created: now.Add(-24 * time.Hour)},
+		}
+	}
+
+	tests := []struct {
+		sortBy   string
+		expected []string
+	}{
+		{"name", []string{"alpha", "bravo", "charlie", "delta", "echo"}},
+		{"", []string{"alpha", "bravo", "charlie", "delta", "echo"}},
+		{"last-accessed", []string{"delta", "bravo", "charlie", "echo", "alpha"}},
+		{"created", []string{"alpha", "delta", "echo", "bravo", "charlie"}},
+		{"status", []string{"delta", "echo", "alpha", "bravo", "charlie"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("sort by "+tt.sortBy, func(t *testing.T) {
+			items := newItems()
+			assert.NoError(t, sortWorktreeList(items, tt.sortBy))
+
+			names := make([]string, 0, len(items))
+			for _, item := range items {
+				names = append(names, item.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestSortWorktreeList_InvalidSort(t *testing.T) {
+	items := []WorktreeListItem{{Name: "bravo"}, {Name: "alpha"}}
+
+	err := sortWorktreeList(items, "size")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sort value 'size'")
+	assert.Equal(t, "bravo", items[0].Name, "items are left untouched on error")
+}
+
+func TestPlanWorktreePrune(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-200 * time.Hour)
+	cutoff := now.Add(-168 * time.Hour)
+	cfg := config.DefaultConfig()
+	cfg.Git.ProtectedBranches = []string{"main", "develop"}
+	worktreeManager := git.NewWorktreeManager(&git.Repository{RootPath: "/work/app"}, cfg, nil)
+
+	worktrees := []git.WorktreeInfo{
+		{Path: "/work/app", Branch: "feature/in-root", IsClean: true, LastAccessed: old},
+		{Path: "/work/app-stale", Branch: "feature/stale", IsClean: true, LastAccessed: old},
+		{Path: "/work/app-recent", Branch: "feature/recent", IsClean: true, LastAccessed: now},
+		{Path: "/work/app-develop", Branch: "develop", IsClean: true, LastAccessed: old},
+		{Path: "/work/app-dirty", Branch: "feature/dirty", IsClean: false, LastAccessed: old},
+	}
+
+	paths := func(wts []git.WorktreeInfo) []string {
+		result := []string{}
+		for _, wt := range wts {
+			result = append(result, wt.Path)
+		}
+		return result
+	}
+	reasons := func(skips []worktreePruneSkip) map[string]string {
+		result := map[string]string{}
+		for _, skip := range skips {
+			result[skip.Worktree.Path] = skip.Reason
+		}
+		return result
+	}
+
+	prune, skipped := planWorktreePrune(worktreeManager, worktrees, "/work/app", cutoff, false)
+	assert.Equal(t, []string{"/work/app-stale"}, paths(prune))
+	assert.Equal(t, map[string]string{
+		"/work/app-develop": "protected branch",
+		"/work/app-dirty":   "uncommitted changes",
+	}, reasons(skipped))
+
+	prune, skipped = planWorktreePrune(worktreeManager, worktrees, "/work/app", cutoff, true)
+	assert.Equal(t, []string{"/work/app-stale", "/work/app-dirty"}, paths(prune))
+	assert.Equal(t, map[string]string{"/work/app-develop": "protected branch"}, reasons(skipped))
+}
+
+func TestBuildPullRequestListData(t *testing.T) {
+	prs := []git.PullRequest{
+		{Number: 10, Title: "Add auth", State: "open", SourceBranch: "feature/auth", TargetBranch: "main"},
+		{Number: 14, Title: "Docs", State: "opened", SourceBranch: "docs", TargetBranch: "main", Draft: true},
+		{Number: 12, Title: "Old work", State: "closed", SourceBranch: "feature/auth", TargetBranch: "main"},
+		{Number: 13, Title: "Someone else's branch", State: "open", SourceBranch: "feature/remote-only", TargetBranch: "main"},
+	}
+	branches := []string{"main", "feature/auth", "docs"}
+	worktrees := []git.WorktreeInfo{
+		{Path: "/work/app", Branch: "main"},
+		{Path: "/work/app-auth", Branch: "feature/auth"},
+	}
+
+	data := buildPullRequestListData(prs, branches, worktrees)
+
+	assert.Equal(t, 2, data.Total)
+	if assert.Len(t, data.PullRequests, 2) {
+		assert.Equal(t, 14, data.PullRequests[0].Number)
+		assert.Equal(t, "", data.PullRequests[0].Worktree)
+		assert.True(t, data.PullRequests[0].Draft)
+		assert.Equal(t, 10, data.PullRequests[1].Number)
+		assert.Equal(t, "app-auth", data.PullRequests[1].Worktree)
+	}
+}
+
+func TestSplitCommaList(t *testing.T) {
+	assert.Equal(t, []string{"alice", "bob", "org/team"}, splitCommaList("alice, bob,,org/team "))
+	assert.Nil(t, splitCommaList(""))
+}
+
+func TestRunWorktreeCreateCommand_DryRun(t *testing.T) {
+	repoDir := setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(repoDir) })
+
+	baseDir := filepath.Join(t.TempDir(), "worktrees")
+	writeTestConfig(t, fmt.Sprintf("version: \"2.0.0\"\nworktree:\n  base_directory: %q\n  directory_pattern: \"{{.Branch}}\"\n", baseDir))
+
+	originalCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repoDir))
+	t.Cleanup(func() { os.Chdir(originalCwd) })
+
+	originalDryRun := dryRun
+	t.Cleanup(func() { dryRun = originalDryRun })
+	dryRun = true
+
+	require.NoError(t, runWorktreeCreateCommand(worktreeCreateCmd, []string{"feature-dry"}))
+
+	_, err = os.Stat(baseDir)
+	assert.True(t, os.IsNotExist(err), "dry run must not create the worktree base directory")
+
+	branches, err := git.NewGitCmd().Execute(repoDir, "branch", "--list", "feature-dry")
+	require.NoError(t, err)
+	assert.Empty(t, branches, "dry run must not create the branch")
+}
+
+func TestRunWorktreeDeleteCommand_NonInteractiveStdin(t *testing.T) {
+	repoDir := setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(repoDir) })
+	writeTestConfig(t, "version: \"2.0.0\"\n")
+
+	worktreePath := filepath.Join(t.TempDir(), "feature-delete")
+	_, err := git.NewGitCmd().Execute(repoDir, "worktree", "add", "-b", "feature-delete", worktreePath)
+	require.NoError(t, err)
+
+	originalCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repoDir))
+	t.Cleanup(func() { os.Chdir(originalCwd) })
+
+	withStdinTerminal(t, false)
+
+	done := make(chan error, 1)
+	go func() { done <- runWorktreeDeleteCommand(worktreeDeleteCmd, []string{"feature-delete"}) }()
+
+	select {
+	case err = <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("worktree delete waited for confirmation on a non-terminal stdin")
+	}
+
+	var cliErr *cli.CLIError
+	require.ErrorAs(t, err, &cliErr)
+	assert.Contains(t, cliErr.Message, "stdin is not a terminal")
+	assert.Contains(t, cliErr.Suggestion, "--force")
+
+	_, err = os.Stat(worktreePath)
+	assert.NoError(t, err, "the worktree is kept")
+}
+
+func TestApplyBaseDirectoryOverride(t *testing.T) {
+	repoDir := setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(repoDir) })
+
+	originalCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repoDir))
+	t.Cleanup(func() { os.Chdir(originalCwd) })
+
+	cfg := config.DefaultConfig()
+	cfg.Worktree.BaseDirectory = "/config/worktrees"
+	cfg.Worktree.DirectoryPattern = "{{.Branch}}"
+	cfg.Git.DirectoryPattern = ""
+
+	require.NoError(t, applyBaseDirectoryOverride(cfg, "", repoDir))
+	assert.Equal(t, "/config/worktrees", cfg.Worktree.BaseDirectory, "no flag keeps the configured base directory")
+
+	t.Run("inside repository", func(t *testing.T) {
+		err := applyBaseDirectoryOverride(cfg, filepath.Join(repoDir, "worktrees"), repoDir)
+
+		var cliErr *cli.CLIError
+		require.True(t, errors.As(err, &cliErr))
+		assert.Contains(t, cliErr.Suggestion, "--base-directory")
+
+		var insideErr *git.InsideRepositoryError
+		assert.True(t, errors.As(cliErr.Cause, &insideErr))
+		assert.Equal(t, "/config/worktrees", cfg.Worktree.BaseDirectory)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		require.NoError(t, applyBaseDirectoryOverride(cfg, "../elsewhere/{{.Project}}", repoDir))
+		assert.Equal(t, "../elsewhere/{{.Project}}", cfg.Worktree.BaseDirectory)
+
+		repo, err := git.NewRepositoryManager(nil).DetectRepository(repoDir)
+		require.NoError(t, err)
+
+		path, err := git.NewWorktreeManager(repo, cfg, nil).ResolveWorktreePath("feature-x", git.WorktreeOptions{AutoName: true})
+		require.NoError(t, err)
+
+		resolvedRepo, err := filepath.EvalSymlinks(repoDir)
+		require.NoError(t, err)
+		project := filepath.Base(repoDir)
+		assert.Contains(t, []string{
+			filepath.Join(filepath.Dir(repoDir), "elsewhere", project, "feature-x"),
+			filepath.Join(filepath.Dir(resolvedRepo), "elsewhere", project, "feature-x"),
+		}, path)
+	})
+}
+
+func TestRunWorktreeCreateCommand_BaseDirectoryFlag(t *testing.T) {
+	repoDir := setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(repoDir) })
+
+	configBase := filepath.Join(t.TempDir(), "config-worktrees")
+	writeTestConfig(t, fmt.Sprintf("version: \"2.0.0\"\nworktree:\n  base_directory: %q\n  directory_pattern: \"{{.Branch}}\"\n", configBase))
+
+	originalCwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(repoDir))
+	t.Cleanup(func() { os.Chdir(originalCwd) })
+
+	originalFlags := worktreeCreateFlags
+	t.Cleanup(func() { worktreeCreateFlags = originalFlags })
+
+	flagBase := filepath.Join(t.TempDir(), "flag-worktrees")
+	worktreeCreateFlags.baseDirectory = flagBase
+	require.NoError(t, runWorktreeCreateCommand(worktreeCreateCmd, []string{"feature-flag"}))
+
+	created, err := filepath.Glob(filepath.Join(flagBase, "*feature-flag"))
+	require.NoError(t, err)
+	assert.Len(t, created, 1, "worktree is created under --base-directory")
+	_, err = os.Stat(configBase)
+	assert.True(t, os.IsNotExist(err), "configured base directory is not used")
+
+	worktreeCreateFlags.baseDirectory = filepath.Join(repoDir, "nested")
+	assert.Error(t, runWorktreeCreateCommand(worktreeCreateCmd, []string{"feature-inside"}))
+
+	branches, err := git.NewGitCmd().Execute(repoDir, "branch", "--list", "feature-inside")
+	require.NoError(t, err)
+	assert.Empty(t, branches, "a rejected base directory must not create the branch")
+}
+
+// formatCleanupJSON renders a cleanup result through the JSON formatter and
+// decodes it generically so tests can assert the wire shape
+func formatCleanupJSON(t *testing.T, result CleanupResult) map[string]interface{} {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, cli.NewFormatter(cli.FormatJSON, &buf).Format(result))
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	return decoded
+}
+
+func TestPruneWorktrees_JSON(t *testing.T) {
+	prune := []git.WorktreeInfo{
+		{Path: "/work/app-stale", Branch: "feature/stale"},
+		{Path: "/work/app-locked", Branch: "feature/locked"},
+	}
+	skipped := []worktreePruneSkip{
+		{Worktree: git.WorktreeInfo{Path: "/work/app-develop", Branch: "develop"}, Reason: "protected branch"},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		var deleted []string
+		result := pruneWorktrees(prune, skipped, true, func(path string) error {
+			deleted = append(deleted, path)
+			return nil
+		})
+
+		assert.Empty(t, deleted)
+		decoded := formatCleanupJSON(t, result)
+		assert.Equal(t, true, decoded["dry_run"])
+		assert.Len(t, decoded["removed"], 2)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "app-develop", "path": "/work/app-develop", "branch": "develop", "reason": "protected branch"},
+		}, decoded["skipped"])
+	})
+
+	t.Run("real run", func(t *testing.T) {
+		result := pruneWorktrees(prune, skipped, false, func(path string) error {
+			if path == "/work/app-locked" {
+				return errors.New("worktree is locked")
+			}
+			return nil
+		})
+
+		decoded := formatCleanupJSON(t, result)
+		assert.Equal(t, false, decoded["dry_run"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "app-stale", "path": "/work/app-stale", "branch": "feature/stale"},
+		}, decoded["removed"])
+		skippedItems := decoded["skipped"].([]interface{})
+		require.Len(t, skippedItems, 2)
+		assert.Equal(t, "worktree is locked", skippedItems[1].(map[string]interface{})["reason"])
+	})
+
+	t.Run("nothing to prune", func(t *testing.T) {
+		decoded := formatCleanupJSON(t, pruneWorktrees(nil, nil, false, nil))
+
+		assert.Equal(t, []interface{}{}, decoded["removed"])
+		assert.Equal(t, []interface{}{}, decoded["skipped"])
+	})
+}
+
+func TestPlanWorktreeOpen(t *testing.T) {
+	wt := git.WorktreeInfo{Path: "/repo-worktrees/feature", Branch: "feature"}
+	root := &tmux.Session{ID: "root", Name: "root", Directory: "/repo-worktrees/feature", Active: true}
+	nested := &tmux.Session{ID: "nested", Name: "nested", Directory: "/repo-worktrees/feature/src", Active: true}
+	inactive := &tmux.Session{ID: "inactive", Name: "inactive", Directory: "/repo-worktrees/feature", Active: false}
+	other := &tmux.Session{ID: "other", Name: "other", Directory: "/repo-worktrees/feature-2", Active: true}
+
+	tests := []struct {
+		name         string
+		sessions     []*tmux.Session
+		startSession bool
+		action       worktreeOpenAction
+		session      *tmux.Session
+	}{
+		{"session in worktree root", []*tmux.Session{other, nested, root}, false, worktreeOpenAttach, root},
+		{"session in subdirectory", []*tmux.Session{nested}, true, worktreeOpenAttach, nested},
+		{"no session prints hint", []*tmux.Session{other, inactive}, false, worktreeOpenHint, nil},
+		{"no session with --start-session", []*tmux.Session{other, inactive}, true, worktreeOpenCreate, nil},
+		{"no sessions at all", nil, false, worktreeOpenHint, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := planWorktreeOpen(wt, tt.sessions, tt.startSession)
+			assert.Equal(t, tt.action, plan.action)
+			assert.Equal(t, tt.session, plan.session)
+		})
+	}
+}
+
+func TestWorktreeEditorCommand(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+	cfg := config.DefaultConfig()
+
+	assert.Equal(t, []string{"vi"}, worktreeEditorCommand(cfg, getenv))
+
+	env["EDITOR"] = "nano"
+	assert.Equal(t, []string{"nano"}, worktreeEditorCommand(cfg, getenv))
+
+	env["VISUAL"] = "code --wait"
+	assert.Equal(t, []string{"code", "--wait"}, worktreeEditorCommand(cfg, getenv))
+
+	cfg.Commands.Environment = map[string]string{"EDITOR": "hx"}
+	assert.Equal(t, []string{"hx"}, worktreeEditorCommand(cfg, getenv))
+}
+
+func TestNewProtectedBranchError(t *testing.T) {
+	err := newProtectedBranchError(&git.ProtectedBranchError{Branch: "main"}, "delete")
+	assert.Equal(t, "refusing to delete worktree on protected branch 'main'", err.Message)
+	assert.Contains(t, err.Suggestion, "git.allow_force_delete")
+
+	err = newProtectedBranchError(fmt.Errorf("wrapped: %w", &git.ProtectedBranchError{Branch: "main", ForceAllowed: true}), "rebase")
+	assert.Equal(t, "refusing to rebase worktree on protected branch 'main'", err.Message)
+	assert.Equal(t, "Use --force to rebase it anyway", err.Suggestion)
+}
+
+func TestNewDetachedHeadError(t *testing.T) {
+	err := newDetachedHeadError(&git.WorktreeInfo{Path: "/work/app-bisect", Detached: true}, "push")
+	assert.Equal(t, "cannot push a detached HEAD (worktree app-bisect)", err.Message)
+	assert.Contains(t, err.Suggestion, "git -C /work/app-bisect switch -c <branch>")
+}
+
+func TestNewWorktreeListItem_Detached(t *testing.T) {
+	sessions := []*tmux.Session{{Name: "ccmgr-app-bisect", Directory: "/work/app-bisect"}}
+	worktrees := []git.WorktreeInfo{
+		{Path: "/work/app", Branch: "main", IsClean: true},
+		{Path: "/work/app-bisect", Head: "def456ghi", Detached: true, IsClean: true},
+	}
+
+	items := []WorktreeListItem{
+		newWorktreeListItem(worktrees[0], sessions, false),
+		newWorktreeListItem(worktrees[1], sessions, true),
+	}
+
+	assert.Equal(t, "clean", items[0].Status)
+	assert.False(t, items[0].Detached)
+	assert.Equal(t, "detached", items[1].Status, "detached wins over an active session")
+	assert.True(t, items[1].Detached)
+	assert.Empty(t, items[1].Branch)
+	assert.Equal(t, []string{"ccmgr-app-bisect"}, items[1].Sessions)
+
+	require.NoError(t, sortWorktreeList(items, "status"))
+	assert.Equal(t, "app-bisect", items[0].Name)
+
+	var buf bytes.Buffer
+	formatter := cli.NewWorktreeFormatter(cli.FormatTable, &buf)
+	require.NoError(t, formatter.Format(&WorktreeListData{Worktrees: items, Total: len(items)}))
+	assert.Contains(t, buf.String(), "(detached)")
+	assert.Contains(t, buf.String(), "Detached")
+
+	buf.Reset()
+	require.NoError(t, cli.NewFormatter(cli.FormatJSON, &buf).Format(&WorktreeListData{Worktrees: items, Total: len(items)}))
+	assert.Contains(t, buf.String(), `"status": "detached"`)
+	assert.Contains(t, buf.String(), `"detached": true`)
+}
+
+func TestPrintWorktreeBackups(t *testing.T) {
+	created := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	backups := []git.WorktreeBackup{
+		{ID: "repo-feature-20240301-093000", Branch: "feature", WorktreePath: "/work/repo-feature", RepoPath: "/work/repo", CreatedAt: created},
+		{ID: "other-fix-20240301-093000", Branch: "fix", WorktreePath: "/work/other-fix", RepoPath: "/work/other", CreatedAt: created},
+	}
+
+	var buf bytes.Buffer
+	printWorktreeBackups(&buf, backupsForRepository(backups, "/work/repo"))
+
+	output := buf.String()
+	assert.Contains(t, output, "repo-feature-20240301-093000")
+	assert.Contains(t, output, "2024-03-01 09:30")
+	assert.NotContains(t, output, "other-fix")
+
+	buf.Reset()
+	printWorktreeBackups(&buf, backupsForRepository(backups, "/work/none"))
+	assert.Equal(t, "No worktree backups found\n", buf.String())
+}
+
+func TestPrintPatternPreview(t *testing.T) {
+	pm := git.NewPatternManager(nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, printPatternPreview(&buf, pm, "{{.Project}}-{{.Branch | lower}}"))
+
+	output := buf.String()
+	assert.Contains(t, output, "Pattern: {{.Project}}-{{.Branch | lower}}")
+	assert.Contains(t, output, "  my-project-feature-user-auth\n")
+	assert.Contains(t, output, "  api-server-bugfix-memory-leak\n")
+	assert.Contains(t, output, "  frontend-app-main\n")
+	assert.Contains(t, output, "{{.Timestamp}}")
+	assert.Contains(t, output, "truncate")
+}
+
+func TestPrintPatternPreview_InvalidPattern(t *testing.T) {
+	pm := git.NewPatternManager(nil)
+
+	for pattern, want := range map[string]string{
+		"{{.Unknown}}":   "unknown template variable",
+		"../{{.Branch}}": "dangerous sequence",
+		"{{.Branch":      "invalid template syntax",
+	} {
+		var buf bytes.Buffer
+		err := printPatternPreview(&buf, pm, pattern)
+		require.Error(t, err, pattern)
+		assert.Contains(t, err.Error(), want)
+		assert.Empty(t, buf.String(), "nothing is printed for an invalid pattern")
+	}
+}
+
+func TestPatternVariablesSuggestion(t *testing.T) {
+	suggestion := patternVariablesSuggestion(git.NewPatternManager(nil))
+
+	assert.Equal(t, "Available variables: {{.Branch}}, {{.Prefix}}, {{.Project}}, {{.Suffix}}, {{.Timestamp}}, {{.UserName}}, {{.Worktree}}", suggestion)
+}
+
+func TestRelativeWorktreePath(t *testing.T) {
+	baseDir := "/work/.worktrees/app"
+	repoRoot := "/work/app"
+
+	tests := []struct {
+		name     string
+		path     string
+		baseDir  string
+		expected string
+	}{
+		{"under base directory", "/work/.worktrees/app/app-login", baseDir, "app-login"},
+		{"nested under base directory", "/work/.worktrees/app/team/app-api", baseDir, "team/app-api"},
+		{"repository root", "/work/app", baseDir, "."},
+		{"outside base directory", "/elsewhere/app-spike", baseDir, "/elsewhere/app-spike"},
+		{"sibling with common prefix", "/work/.worktrees/app-other/x", baseDir, "/work/.worktrees/app-other/x"},
+		{"unresolved base directory", "/work/app/..dotted", "", "..dotted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, relativeWorktreePath(tt.path, tt.baseDir, repoRoot))
+		})
+	}
+}
+
+func TestWorktreeListOutput_RelativePaths(t *testing.T) {
+	data := &WorktreeListData{
+		Worktrees: []WorktreeListItem{
+			{Name: "app-login", Path: "/work/.worktrees/app/app-login", Branch: "login", IsClean: true},
+		},
+		Total: 1,
+	}
+	displayPath := func(path string) string {
+		return relativeWorktreePath(path, "/work/.worktrees/app", "/work/app")
+	}
+
+	var buf bytes.Buffer
+	formatter, err := setupWorktreeOutputFormatter("table", &buf, displayPath)
+	require.NoError(t, err)
+	require.NoError(t, formatter.Format(data))
+	assert.Contains(t, buf.String(), "Path")
+	assert.NotContains(t, buf.String(), "/work/.worktrees/app/app-login")
+
+	for _, format := range []string{"json", "yaml"} {
+		buf.Reset()
+		formatter, err := setupWorktreeOutputFormatter(format, &buf, displayPath)
+		require.NoError(t, err)
+		require.NoError(t, formatter.Format(data))
+		assert.Contains(t, buf.String(), "/work/.worktrees/app/app-login", "%s keeps absolute paths", format)
+	}
+}
+
+func TestRemoteAPIError(t *testing.T) {
+	timeout := fmt.Errorf("%w after 30s: %w", git.ErrAPITimeout, context.DeadlineExceeded)
+	err := remoteAPIError("failed to check GitHub token scopes", timeout)
+	assert.Contains(t, err.Suggestion, "git.api_timeout")
+	assert.True(t, errors.Is(err.Cause, git.ErrAPITimeout))
+
+	err = remoteAPIError("failed to push and create pull request", errors.New("remote rejected"))
+	assert.Empty(t, err.Suggestion)
+}
+
+// fakeWorktreeStatusSources returns sources describing a dirty worktree at
+// /work/app-login with one session and one Claude Code process inside it
+func fakeWorktreeStatusSources() worktreeStatusSources {
+	commitDate := time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC)
+	return worktreeStatusSources{
+		branchInfo: func(branch string) (*git.BranchInfo, error) {
+			return &git.BranchInfo{Name: branch, Upstream: "origin/" + branch, Ahead: 2, Behind: 1}, nil
+		},
+		fileStatus: func() (map[string]string, error) {
+			return map[string]string{
+				"src/login.go": "M ",
+				"src/form.go":  "MM",
+				"README.md":    " M",
+				"notes.txt":    "??",
+				"go.sum":       "UU",
+			}, nil
+		},
+		commitHistory: func(branch string, limit int) ([]git.CommitInfo, error) {
+			commits := []git.CommitInfo{
+				{Hash: "abcdef1234567890", Author: "Ada", Date: commitDate, Message: "Add login form"},
+				{Hash: "1234567890abcdef", Author: "Grace", Date: commitDate.Add(-time.Hour), Message: "Scaffold auth"},
+			}
+			if limit < len(commits) {
+				commits = commits[:limit]
+			}
+			return commits, nil
+		},
+		sessions: func() ([]*tmux.Session, error) {
+			return []*tmux.Session{
+				{Name: "ccmgr-app-login", Directory: "/work/app-login"},
+				{Name: "ccmgr-app-main", Directory: "/work/app"},
+				{Name: "ccmgr-app-login-tests", Directory: "/work/app-login/tests"},
+			}, nil
+		},
+		processes: func() ([]*claude.ProcessInfo, error) {
+			return []*claude.ProcessInfo{
+				{SessionID: "claude-1", PID: 4242, State: claude.StateBusy, WorkingDir: "/work/app-login", WorktreeID: "app-login"},
+				{SessionID: "claude-2", PID: 4343, State: claude.StateIdle, WorkingDir: "/work/app", WorktreeID: "app"},
+			}, nil
+		},
+	}
+}
+
+func TestBuildWorktreeStatus(t *testing.T) {
+	wt := git.WorktreeInfo{Path: "/work/app-login", Branch: "feature/login", Head: "abcdef1234567890"}
+
+	statusData, err := buildWorktreeStatus(wt, fakeWorktreeStatusSources(), 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, "app-login", statusData.Name)
+	assert.Equal(t, "feature/login", statusData.Branch)
+	assert.Equal(t, "abcdef1234567890", statusData.Head)
+	assert.Equal(t, "origin/feature/login", statusData.Upstream)
+	assert.Equal(t, 2, statusData.Ahead)
+	assert.Equal(t, 1, statusData.Behind)
+
+	assert.False(t, statusData.IsClean)
+	assert.Equal(t, 2, statusData.Staged)
+	assert.Equal(t, 2, statusData.Modified)
+	assert.Equal(t, 1, statusData.Untracked)
+	assert.Equal(t, 1, statusData.Conflicted)
+	require.Len(t, statusData.Files, 5)
+	assert.Equal(t, WorktreeFileStatus{Path: "README.md", Status: " M"}, statusData.Files[0])
+
+	assert.Equal(t, []string{"ccmgr-app-login", "ccmgr-app-login-tests"}, statusData.Sessions)
+
+	require.Len(t, statusData.Processes, 1)
+	assert.Equal(t, 4242, statusData.Processes[0].PID)
+	assert.Equal(t, "busy", statusData.Processes[0].State)
+
+	require.Len(t, statusData.RecentCommits, 2)
+	assert.Equal(t, "Add login form", statusData.RecentCommits[0].Message)
+	assert.Equal(t, "Ada", statusData.RecentCommits[0].Author)
+}
+
+func TestBuildWorktreeStatus_OptionalSourcesUnavailable(t *testing.T) {
+	sources := fakeWorktreeStatusSources()
+	sources.fileStatus = func() (map[string]string, error) { return map[string]string{}, nil }
+	sources.sessions = func() ([]*tmux.Session, error) { return nil, errors.New("tmux not running") }
+	sources.processes = func() ([]*claude.ProcessInfo, error) { return nil, errors.New("no process manager") }
+
+	statusData, err := buildWorktreeStatus(git.WorktreeInfo{Path: "/work/app-login", Head: "abcdef12"}, sources, 1)
+	require.NoError(t, err)
+
+	assert.True(t, statusData.IsClean)
+	assert.Empty(t, statusData.Upstream, "a detached HEAD has no upstream")
+	assert.Empty(t, statusData.Sessions)
+	assert.Empty(t, statusData.Processes)
+	assert.Len(t, statusData.RecentCommits, 1)
+
+	sources.commitHistory = func(branch string, limit int) ([]git.CommitInfo, error) {
+		return nil, errors.New("bad revision")
+	}
+	_, err = buildWorktreeStatus(git.WorktreeInfo{Path: "/work/app-login"}, sources, 5)
+	assert.Error(t, err)
+}
+
+func TestBuildWorktreeStatus_RealRepository(t *testing.T) {
+	repoDir := setupTestRepo(t)
+	t.Cleanup(func() { os.RemoveAll(repoDir) })
+
+	gitCmd := git.NewGitCmd()
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Changed"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "staged.go"), []byte("package main\n"), 0644))
+	_, err := gitCmd.Execute(repoDir, "add", "staged.go")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "notes.txt"), []byte("todo\n"), 0644))
+
+	repo, err := git.NewRepositoryManager(gitCmd).DetectRepository(repoDir)
+	require.NoError(t, err)
+	ops := git.NewGitOperationsInDir(repo, gitCmd, repoDir)
+	sources := fakeWorktreeStatusSources()
+	sources.fileStatus = ops.GetStatus
+	sources.commitHistory = ops.GetCommitHistory
+
+	statusData, err := buildWorktreeStatus(git.WorktreeInfo{Path: repoDir, Head: "abcdef12"}, sources, 5)
+	require.NoError(t, err)
+
+	assert.False(t, statusData.IsClean)
+	assert.Equal(t, 1, statusData.Staged)
+	assert.Equal(t, 1, statusData.Modified)
+	assert.Equal(t, 1, statusData.Untracked)
+	assert.Equal(t, 0, statusData.Conflicted)
+	assert.Equal(t, []WorktreeFileStatus{
+		{Path: "README.md", Status: " M"},
+		{Path: "notes.txt", Status: "??"},
+		{Path: "staged.go", Status: "A "},
+	}, statusData.Files)
+	require.Len(t, statusData.RecentCommits, 1)
+	assert.Equal(t, "Initial commit", statusData.RecentCommits[0].Message)
+}
+
+func TestPrintWorktreeStatus(t *testing.T) {
+	wt := git.WorktreeInfo{Path: "/work/app-login", Branch: "feature/login", Head: "abcdef1234567890"}
+	statusData, err := buildWorktreeStatus(wt, fakeWorktreeStatusSources(), 5)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	printWorktreeStatus(&buf, statusData)
+	output := buf.String()
+
+	assert.Contains(t, output, "Worktree: app-login")
+	assert.Contains(t, output, "HEAD:     abcdef12\n")
+	assert.Contains(t, output, "Upstream: origin/feature/login (2 ahead, 1 behind)")
+	assert.Contains(t, output, "dirty (2 staged, 2 modified, 1 untracked, 1 conflicted)")
+	assert.Contains(t, output, "?? notes.txt")
+	assert.Contains(t, output, "Sessions:\n  ccmgr-app-login\n")
+	assert.Contains(t, output, "PID 4242  busy")
+	assert.Contains(t, output, "abcdef12  2026-03-02 10:30  Ada")
+
+	var decoded WorktreeStatusData
+	buf.Reset()
+	require.NoError(t, cli.NewFormatter(cli.FormatJSON, &buf).Format(statusData))
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, statusData.Sessions, decoded.Sessions)
+	assert.Len(t, decoded.RecentCommits, 2)
+}
@@ -133,12 +133,6 @@ func (c *Collector) Start(ctx context.Context) error {
 	c.wg.Add(1)
 	go c.collectionLoop()
 
-	// Start periodic cleanup if retention is enabled
-	if c.config.RetentionDays > 0 {
-		c.wg.Add(1)
-		go c.cleanupLoop()
-	}
-
 	return nil
 }
 
@@ -249,45 +243,6 @@ func (c *Collector) fallbackToIndividualInserts(ctx context.Context, events []*s
 	c.addFailedEvents(failureCount)
 }
 
-// cleanupLoop handles periodic cleanup of old events
-func (c *Collector) cleanupLoop() {
-	defer c.wg.Done()
-
-	// Run cleanup daily
-	ticker := time.NewTicker(24 * time.Hour)
-	defer ticker.Stop()
-
-	// Run initial cleanup after 1 minute
-	initialTimer := time.NewTimer(time.Minute)
-	defer initialTimer.Stop()
-
-	for {
-		select {
-		case <-c.ctx.Done():
-			return
-
-		case <-initialTimer.C:
-			c.performCleanup()
-
-		case <-ticker.C:
-			c.performCleanup()
-		}
-	}
-}
-
-// performCleanup removes old events based on retention policy
-func (c *Collector) performCleanup() {
-	if c.config.RetentionDays <= 0 {
-		return
-	}
-
-	cutoffTime := time.Now().AddDate(0, 0, -c.config.RetentionDays)
-
-	// This would require implementing a cleanup method in storage
-	// For now, we'll log the operation
-	fmt.Printf("Analytics cleanup: removing events older than %s\n", cutoffTime.Format(time.RFC3339))
-}
-
 // GetStats returns collector statistics
 func (c *Collector) GetStats() map[string]interface{} {
 	c.mutex.RLock()
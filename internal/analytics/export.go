@@ -0,0 +1,170 @@
+package analytics
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/storage"
+)
+
+// eventDataColumns are the event data keys exported as their own CSV columns,
+// covering the fields set by the New*EventData helpers
+var eventDataColumns = []string{
+	"project",
+	"worktree",
+	"branch",
+	"directory",
+	"action",
+	"old_state",
+	"new_state",
+	"activity_type",
+	"duration_ms",
+	"remote",
+	"target_branch",
+	"pr_number",
+	"pr_url",
+	"title",
+	"draft",
+	"success",
+	"error",
+}
+
+// EventCSVColumns returns the header written by ExportEventsCSV. Data keys
+// without a column of their own are collected as JSON in the trailing
+// "extra" column.
+func EventCSVColumns() []string {
+	columns := []string{"type", "timestamp", "session_id"}
+	columns = append(columns, eventDataColumns...)
+	return append(columns, "extra")
+}
+
+// ExportOptions configures ExportEventsCSV
+type ExportOptions struct {
+	// Since limits the export to events at or after this time when set
+	Since time.Time
+	// PageSize is the number of events read from storage at a time
+	PageSize int
+}
+
+// ExportPageSize returns how many events to hold in memory at once so an
+// export stays well within maxMemoryMB, assuming about 1KB per event
+func ExportPageSize(maxMemoryMB int64) int {
+	const minPage, maxPage = 100, 10000
+
+	size := int(maxMemoryMB * 1024 / 10)
+	if size < minPage {
+		return minPage
+	}
+	if size > maxPage {
+		return maxPage
+	}
+	return size
+}
+
+// ExportEventsCSV streams stored events to w as CSV, newest first, reading
+// them from storage one page at a time. The header is always written, so an
+// empty store, or a nil events repository, produces a header-only file. It
+// returns the number of event rows written.
+func ExportEventsCSV(ctx context.Context, events storage.SessionEventRepository, w io.Writer, opts ExportOptions) (int, error) {
+	if opts.PageSize <= 0 {
+		opts.PageSize = ExportPageSize(0)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(EventCSVColumns()); err != nil {
+		return 0, fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	if events == nil {
+		writer.Flush()
+		return 0, writer.Error()
+	}
+
+	// Fix the end of the window so events recorded during the export do not
+	// shift the pages
+	filter := storage.EventFilter{
+		Since: opts.Since,
+		Until: time.Now(),
+		Limit: opts.PageSize,
+	}
+
+	rows := 0
+	for {
+		page, err := events.GetByFilter(ctx, filter)
+		if err != nil {
+			return rows, fmt.Errorf("failed to read events: %w", err)
+		}
+
+		for _, event := range page {
+			record, err := eventCSVRecord(event)
+			if err != nil {
+				return rows, err
+			}
+			if err := writer.Write(record); err != nil {
+				return rows, fmt.Errorf("failed to write CSV row: %w", err)
+			}
+			rows++
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return rows, fmt.Errorf("failed to write CSV: %w", err)
+		}
+
+		if len(page) < filter.Limit {
+			return rows, nil
+		}
+		filter.Offset += len(page)
+	}
+}
+
+// eventCSVRecord flattens an event into a row matching EventCSVColumns
+func eventCSVRecord(event *storage.SessionEvent) ([]string, error) {
+	record := []string{
+		event.EventType,
+		event.Timestamp.UTC().Format(time.RFC3339),
+		event.SessionID,
+	}
+
+	extra := make(map[string]interface{})
+	for key, value := range event.Data {
+		extra[key] = value
+	}
+	for _, key := range eventDataColumns {
+		record = append(record, formatCSVValue(extra[key]))
+		delete(extra, key)
+	}
+
+	if len(extra) == 0 {
+		return append(record, ""), nil
+	}
+	encoded, err := json.Marshal(extra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode data of event %d: %w", event.ID, err)
+	}
+	return append(record, string(encoded)), nil
+}
+
+// formatCSVValue renders a decoded JSON value as a CSV cell
+func formatCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
@@ -0,0 +1,268 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/storage"
+)
+
+// memoryEventRepository is an in-memory SessionEventRepository that records
+// the filters it is queried with and calls onDelete after every deletion
+type memoryEventRepository struct {
+	events   []*storage.SessionEvent
+	filters  []storage.EventFilter
+	onDelete func()
+}
+
+func (r *memoryEventRepository) Create(ctx context.Context, event *storage.SessionEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memoryEventRepository) CreateBatch(ctx context.Context, events []*storage.SessionEvent) error {
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *memoryEventRepository) GetBySessionID(ctx context.Context, sessionID string, limit int) ([]*storage.SessionEvent, error) {
+	return r.GetByFilter(ctx, storage.EventFilter{SessionID: sessionID, Limit: limit})
+}
+
+func (r *memoryEventRepository) GetByFilter(ctx context.Context, filter storage.EventFilter) ([]*storage.SessionEvent, error) {
+	r.filters = append(r.filters, filter)
+
+	var matched []*storage.SessionEvent
+	for _, event := range r.events {
+		if filter.SessionID != "" && event.SessionID != filter.SessionID {
+			continue
+		}
+		if !filter.Since.IsZero() && event.Timestamp.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && event.Timestamp.After(filter.Until) {
+			continue
+		}
+		matched = append(matched, event)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].Timestamp.After(matched[j].Timestamp)
+	})
+
+	if filter.Offset >= len(matched) {
+		return nil, nil
+	}
+	matched = matched[filter.Offset:]
+	if filter.Limit > 0 && len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+	return matched, nil
+}
+
+func (r *memoryEventRepository) CountBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var count int64
+	for _, event := range r.events {
+		if event.Timestamp.Before(cutoff) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryEventRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var kept []*storage.SessionEvent
+	for _, event := range r.events {
+		if !event.Timestamp.Before(cutoff) {
+			kept = append(kept, event)
+		}
+	}
+	removed := int64(len(r.events) - len(kept))
+	r.events = kept
+	if r.onDelete != nil {
+		r.onDelete()
+	}
+	return removed, nil
+}
+
+// newTestEvents returns count events one minute apart, the newest an hour ago
+func newTestEvents(count int) []*storage.SessionEvent {
+	base := time.Now().Add(-time.Hour)
+	events := make([]*storage.SessionEvent, count)
+	for i := range events {
+		events[i] = &storage.SessionEvent{
+			ID:        int64(i + 1),
+			SessionID: fmt.Sprintf("session-%d", i),
+			EventType: EventTypeSessionStart,
+			Timestamp: base.Add(-time.Duration(i) * time.Minute),
+			Data:      map[string]interface{}{"project": "api"},
+		}
+	}
+	return events
+}
+
+func readCSV(t *testing.T, data []byte) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read exported CSV: %v", err)
+	}
+	return records
+}
+
+func TestExportEventsCSV_EmptyStore(t *testing.T) {
+	for name, repo := range map[string]storage.SessionEventRepository{
+		"empty store":   &memoryEventRepository{},
+		"no repository": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			rows, err := ExportEventsCSV(context.Background(), repo, &buf, ExportOptions{})
+			if err != nil {
+				t.Fatalf("ExportEventsCSV() error = %v", err)
+			}
+			if rows != 0 {
+				t.Errorf("rows = %d, want 0", rows)
+			}
+
+			records := readCSV(t, buf.Bytes())
+			if len(records) != 1 {
+				t.Fatalf("got %d records, want only the header", len(records))
+			}
+			if !reflect.DeepEqual(records[0], EventCSVColumns()) {
+				t.Errorf("header = %v, want %v", records[0], EventCSVColumns())
+			}
+		})
+	}
+}
+
+func TestExportEventsCSV_Pages(t *testing.T) {
+	repo := &memoryEventRepository{events: newTestEvents(25)}
+
+	var buf bytes.Buffer
+	rows, err := ExportEventsCSV(context.Background(), repo, &buf, ExportOptions{PageSize: 10})
+	if err != nil {
+		t.Fatalf("ExportEventsCSV() error = %v", err)
+	}
+	if rows != 25 {
+		t.Errorf("rows = %d, want 25", rows)
+	}
+
+	records := readCSV(t, buf.Bytes())
+	if len(records) != 26 {
+		t.Fatalf("got %d records, want header and 25 rows", len(records))
+	}
+
+	// Newest first, with no event repeated or skipped across pages
+	for i, record := range records[1:] {
+		if want := fmt.Sprintf("session-%d", i); record[2] != want {
+			t.Errorf("row %d session_id = %q, want %q", i, record[2], want)
+		}
+	}
+
+	if len(repo.filters) != 3 {
+		t.Fatalf("got %d queries, want 3 pages", len(repo.filters))
+	}
+	for i, filter := range repo.filters {
+		if filter.Limit != 10 || filter.Offset != i*10 {
+			t.Errorf("query %d limit/offset = %d/%d, want 10/%d", i, filter.Limit, filter.Offset, i*10)
+		}
+		if !filter.Until.Equal(repo.filters[0].Until) {
+			t.Errorf("query %d until = %v, want a fixed window end", i, filter.Until)
+		}
+	}
+}
+
+func TestExportEventsCSV_FlattensEventData(t *testing.T) {
+	timestamp := time.Date(2025, 6, 1, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	repo := &memoryEventRepository{events: []*storage.SessionEvent{{
+		ID:        1,
+		SessionID: "ccmgr-api-wt-main",
+		EventType: EventTypeStateChange,
+		Timestamp: timestamp,
+		Data: map[string]interface{}{
+			"project":     "api",
+			"branch":      "main",
+			"old_state":   "idle",
+			"new_state":   "busy",
+			"duration_ms": float64(1500),
+			"success":     true,
+			"model":       "sonnet",
+		},
+	}}}
+
+	var buf bytes.Buffer
+	if _, err := ExportEventsCSV(context.Background(), repo, &buf, ExportOptions{}); err != nil {
+		t.Fatalf("ExportEventsCSV() error = %v", err)
+	}
+
+	records := readCSV(t, buf.Bytes())
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want header and 1 row", len(records))
+	}
+
+	row := make(map[string]string)
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+
+	want := map[string]string{
+		"type":        EventTypeStateChange,
+		"timestamp":   "2025-06-01T10:30:00Z",
+		"session_id":  "ccmgr-api-wt-main",
+		"project":     "api",
+		"worktree":    "",
+		"branch":      "main",
+		"old_state":   "idle",
+		"new_state":   "busy",
+		"duration_ms": "1500",
+		"success":     "true",
+		"extra":       `{"model":"sonnet"}`,
+	}
+	for column, value := range want {
+		if row[column] != value {
+			t.Errorf("%s = %q, want %q", column, row[column], value)
+		}
+	}
+}
+
+func TestExportEventsCSV_Since(t *testing.T) {
+	repo := &memoryEventRepository{events: newTestEvents(10)}
+	since := repo.events[3].Timestamp
+
+	var buf bytes.Buffer
+	rows, err := ExportEventsCSV(context.Background(), repo, &buf, ExportOptions{Since: since})
+	if err != nil {
+		t.Fatalf("ExportEventsCSV() error = %v", err)
+	}
+	if rows != 4 {
+		t.Errorf("rows = %d, want 4", rows)
+	}
+	if records := readCSV(t, buf.Bytes()); len(records) != 5 {
+		t.Errorf("got %d records, want header and 4 rows", len(records))
+	}
+}
+
+func TestExportPageSize(t *testing.T) {
+	tests := []struct {
+		maxMemoryMB int64
+		want        int
+	}{
+		{0, 100},
+		{50, 5120},
+		{100, 10000},
+		{1, 102},
+		{1000, 10000},
+	}
+
+	for _, tt := range tests {
+		if got := ExportPageSize(tt.maxMemoryMB); got != tt.want {
+			t.Errorf("ExportPageSize(%d) = %d, want %d", tt.maxMemoryMB, got, tt.want)
+		}
+	}
+}
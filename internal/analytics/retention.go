@@ -0,0 +1,195 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/storage"
+)
+
+// RetentionConfig defines how long analytics data is kept
+type RetentionConfig struct {
+	SessionEventsDays  int           `yaml:"session_events_days" json:"session_events_days" default:"90"`
+	AggregatedDataDays int           `yaml:"aggregated_data_days" json:"aggregated_data_days" default:"365"`
+	CleanupInterval    time.Duration `yaml:"cleanup_interval" json:"cleanup_interval" default:"24h"`
+	EnableAutoCleanup  bool          `yaml:"enable_auto_cleanup" json:"enable_auto_cleanup" default:"true"`
+}
+
+// SetDefaults sets default values for RetentionConfig
+func (r *RetentionConfig) SetDefaults() {
+	if r.SessionEventsDays == 0 {
+		r.SessionEventsDays = 90
+	}
+	if r.AggregatedDataDays == 0 {
+		r.AggregatedDataDays = 365
+	}
+	if r.CleanupInterval == 0 {
+		r.CleanupInterval = 24 * time.Hour
+	}
+	r.EnableAutoCleanup = true
+}
+
+// Validate validates the retention configuration
+func (r *RetentionConfig) Validate() error {
+	if r.SessionEventsDays < 0 {
+		return fmt.Errorf("session events retention days cannot be negative")
+	}
+	if r.AggregatedDataDays < 0 {
+		return fmt.Errorf("aggregated data retention days cannot be negative")
+	}
+	if r.CleanupInterval <= 0 {
+		return fmt.Errorf("cleanup interval must be positive")
+	}
+	return nil
+}
+
+// CleanupResult reports the rows removed by a retention cleanup, or the rows
+// that would be removed for a dry run
+type CleanupResult struct {
+	DryRun            bool
+	EventsCutoff      time.Time
+	EventsRemoved     int64
+	AggregatesCutoff  time.Time
+	AggregatesRemoved int64
+}
+
+// RetentionManager enforces the retention policy, removing session events
+// older than SessionEventsDays and aggregates older than AggregatedDataDays
+type RetentionManager struct {
+	storage storage.Storage
+	config  *RetentionConfig
+	now     func() time.Time
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mutex   sync.Mutex
+	running bool
+}
+
+// NewRetentionManager creates a new retention manager
+func NewRetentionManager(storage storage.Storage, config *RetentionConfig) *RetentionManager {
+	if config == nil {
+		config = &RetentionConfig{}
+		config.SetDefaults()
+	}
+
+	return &RetentionManager{
+		storage: storage,
+		config:  config,
+		now:     time.Now,
+	}
+}
+
+// Cleanup removes data older than the retention periods. With dryRun set
+// nothing is deleted and the result counts the rows that would be. A
+// retention period of zero days keeps that data forever.
+func (r *RetentionManager) Cleanup(ctx context.Context, dryRun bool) (*CleanupResult, error) {
+	now := r.now()
+	result := &CleanupResult{DryRun: dryRun}
+
+	if r.config.SessionEventsDays > 0 {
+		result.EventsCutoff = now.AddDate(0, 0, -r.config.SessionEventsDays)
+
+		events := r.storage.Events()
+		var err error
+		if dryRun {
+			result.EventsRemoved, err = events.CountBefore(ctx, result.EventsCutoff)
+		} else {
+			result.EventsRemoved, err = events.DeleteBefore(ctx, result.EventsCutoff)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to clean up session events: %w", err)
+		}
+	}
+
+	if r.config.AggregatedDataDays > 0 {
+		result.AggregatesCutoff = now.AddDate(0, 0, -r.config.AggregatedDataDays)
+
+		aggregates := r.storage.Aggregates()
+		var err error
+		if dryRun {
+			result.AggregatesRemoved, err = aggregates.CountBefore(ctx, result.AggregatesCutoff)
+		} else {
+			result.AggregatesRemoved, err = aggregates.DeleteBefore(ctx, result.AggregatesCutoff)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to clean up aggregated data: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// Start runs Cleanup every CleanupInterval in the background. It does
+// nothing when EnableAutoCleanup is off.
+func (r *RetentionManager) Start(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.running {
+		return fmt.Errorf("retention manager is already running")
+	}
+
+	if err := r.config.Validate(); err != nil {
+		return fmt.Errorf("invalid retention configuration: %w", err)
+	}
+
+	if !r.config.EnableAutoCleanup {
+		return nil
+	}
+
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	r.running = true
+
+	r.wg.Add(1)
+	go r.cleanupLoop(r.ctx)
+
+	return nil
+}
+
+// Stop stops the background cleanup and waits for a running cleanup to
+// finish
+func (r *RetentionManager) Stop() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.running {
+		return nil
+	}
+
+	r.running = false
+	r.cancel()
+	r.wg.Wait()
+
+	return nil
+}
+
+// IsRunning returns whether background cleanup is running
+func (r *RetentionManager) IsRunning() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.running
+}
+
+// cleanupLoop runs Cleanup on every tick of the cleanup interval
+func (r *RetentionManager) cleanupLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			// Errors are retried on the next tick; a locked or missing
+			// database should not stop the loop
+			_, _ = r.Cleanup(ctx, false)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/storage"
+)
+
+// memoryAggregateRepository stores the timestamps of aggregate rows
+type memoryAggregateRepository struct {
+	rows []time.Time
+}
+
+func (r *memoryAggregateRepository) CountBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var count int64
+	for _, row := range r.rows {
+		if row.Before(cutoff) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memoryAggregateRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var kept []time.Time
+	for _, row := range r.rows {
+		if !row.Before(cutoff) {
+			kept = append(kept, row)
+		}
+	}
+	removed := int64(len(r.rows) - len(kept))
+	r.rows = kept
+	return removed, nil
+}
+
+// memoryStorage is a storage.Storage backed by the in-memory repositories
+type memoryStorage struct {
+	events     *memoryEventRepository
+	aggregates *memoryAggregateRepository
+}
+
+func (s *memoryStorage) Sessions() storage.SessionRepository                  { return nil }
+func (s *memoryStorage) Events() storage.SessionEventRepository               { return s.events }
+func (s *memoryStorage) Aggregates() storage.AggregateRepository              { return s.aggregates }
+func (s *memoryStorage) Migrate() error                                       { return nil }
+func (s *memoryStorage) Close() error                                         { return nil }
+func (s *memoryStorage) BeginTx(context.Context) (storage.Transaction, error) { return nil, nil }
+
+var retentionNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// newRetentionStorage seeds one event and one aggregate row for each age in
+// days before retentionNow
+func newRetentionStorage(eventAges, aggregateAges []int) *memoryStorage {
+	s := &memoryStorage{
+		events:     &memoryEventRepository{},
+		aggregates: &memoryAggregateRepository{},
+	}
+	for _, days := range eventAges {
+		s.events.events = append(s.events.events, &storage.SessionEvent{
+			SessionID: "session",
+			EventType: EventTypeActivity,
+			Timestamp: retentionNow.AddDate(0, 0, -days),
+		})
+	}
+	for _, days := range aggregateAges {
+		s.aggregates.rows = append(s.aggregates.rows, retentionNow.AddDate(0, 0, -days))
+	}
+	return s
+}
+
+func newTestRetentionManager(s storage.Storage, config *RetentionConfig) *RetentionManager {
+	manager := NewRetentionManager(s, config)
+	manager.now = func() time.Time { return retentionNow }
+	return manager
+}
+
+func TestRetentionManager_CleanupRemovesOnlyOldData(t *testing.T) {
+	s := newRetentionStorage([]int{1, 30, 89, 91, 200}, []int{10, 364, 366, 400})
+	manager := newTestRetentionManager(s, &RetentionConfig{SessionEventsDays: 90, AggregatedDataDays: 365})
+
+	result, err := manager.Cleanup(context.Background(), false)
+	if err != nil {
+		t.Fatalf("Cleanup() error = %v", err)
+	}
+
+	if result.EventsRemoved != 2 {
+		t.Errorf("EventsRemoved = %d, want 2", result.EventsRemoved)
+	}
+	if result.AggregatesRemoved != 2 {
+		t.Errorf("AggregatesRemoved = %d, want 2", result.AggregatesRemoved)
+	}
+	if want := retentionNow.AddDate(0, 0, -90); !result.EventsCutoff.Equal(want) {
+		t.Errorf("EventsCutoff = %v, want %v", result.EventsCutoff, want)
+	}
+
+	if len(s.events.events) != 3 {
+		t.Fatalf("got %d events left, want 3", len(s.events.events))
+	}
+	for _, event := range s.events.events {
+		if event.Timestamp.Before(result.EventsCutoff) {
+			t.Errorf("event from %v was kept", event.Timestamp)
+		}
+	}
+	if len(s.aggregates.rows) != 2 {
+		t.Errorf("got %d aggregate rows left, want 2", len(s.aggregates.rows))
+	}
+}
+
+func TestRetentionManager_DryRun(t *testing.T) {
+	s := newRetentionStorage([]int{1, 91, 200}, []int{400})
+	manager := newTestRetentionManager(s, &RetentionConfig{SessionEventsDays: 90, AggregatedDataDays: 365})
+
+	result, err := manager.Cleanup(context.Background(), true)
+	if err != nil {
+		t.Fatalf("Cleanup() error = %v", err)
+	}
+
+	if !result.DryRun || result.EventsRemoved != 2 || result.AggregatesRemoved != 1 {
+		t.Errorf("result = %+v, want a dry run removing 2 events and 1 aggregate", result)
+	}
+	if len(s.events.events) != 3 || len(s.aggregates.rows) != 1 {
+		t.Errorf("dry run deleted data: %d events, %d aggregates left", len(s.events.events), len(s.aggregates.rows))
+	}
+}
+
+func TestRetentionManager_ZeroDaysKeepsData(t *testing.T) {
+	s := newRetentionStorage([]int{1000}, []int{1000})
+	manager := newTestRetentionManager(s, &RetentionConfig{})
+
+	result, err := manager.Cleanup(context.Background(), false)
+	if err != nil {
+		t.Fatalf("Cleanup() error = %v", err)
+	}
+	if result.EventsRemoved != 0 || result.AggregatesRemoved != 0 {
+		t.Errorf("result = %+v, want nothing removed", result)
+	}
+	if len(s.events.events) != 1 || len(s.aggregates.rows) != 1 {
+		t.Error("data was deleted without a retention period")
+	}
+}
+
+func TestRetentionManager_Start(t *testing.T) {
+	t.Run("auto cleanup disabled", func(t *testing.T) {
+		manager := NewRetentionManager(newRetentionStorage(nil, nil), &RetentionConfig{
+			SessionEventsDays: 90,
+			CleanupInterval:   time.Hour,
+		})
+		if err := manager.Start(context.Background()); err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+		if manager.IsRunning() {
+			t.Error("cleanup started with EnableAutoCleanup off")
+		}
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		manager := NewRetentionManager(newRetentionStorage(nil, nil), &RetentionConfig{EnableAutoCleanup: true})
+		if err := manager.Start(context.Background()); err == nil {
+			t.Error("Start() accepted a zero cleanup interval")
+		}
+	})
+
+	t.Run("runs on interval", func(t *testing.T) {
+		s := newRetentionStorage([]int{1, 91}, nil)
+		deleted := make(chan struct{}, 1)
+		s.events.onDelete = func() {
+			select {
+			case deleted <- struct{}{}:
+			default:
+			}
+		}
+
+		manager := newTestRetentionManager(s, &RetentionConfig{
+			SessionEventsDays: 90,
+			CleanupInterval:   10 * time.Millisecond,
+			EnableAutoCleanup: true,
+		})
+		if err := manager.Start(context.Background()); err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+		if !manager.IsRunning() {
+			t.Fatal("cleanup did not start")
+		}
+
+		select {
+		case <-deleted:
+		case <-time.After(time.Second):
+			t.Error("background cleanup did not run")
+		}
+
+		if err := manager.Stop(); err != nil {
+			t.Fatalf("Stop() error = %v", err)
+		}
+		if manager.IsRunning() {
+			t.Error("manager still running after Stop()")
+		}
+		if len(s.events.events) != 1 {
+			t.Errorf("got %d events after background cleanup, want 1", len(s.events.events))
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package claude
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// DefaultStopTimeout is how long StopProcess waits for a graceful exit before
+// forcibly killing the process
+const DefaultStopTimeout = 10 * time.Second
+
+// ErrProcessNotFound is returned when no Claude Code process matches an identifier
+var ErrProcessNotFound = errors.New("claude process not found")
+
+// FindProcess looks up a Claude Code process by session ID, PID, or tmux session
+// name. Tracked processes are checked first, then running processes are discovered.
+func (pm *ProcessManager) FindProcess(id string) (*ProcessInfo, error) {
+	if process, exists := pm.GetProcess(id); exists {
+		return process, nil
+	}
+
+	candidates := pm.GetAllProcesses()
+	if discovered, err := pm.DiscoverProcesses(); err == nil {
+		candidates = append(candidates, discovered...)
+	}
+
+	for _, process := range candidates {
+		if matchesProcessID(process, id) {
+			return process, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrProcessNotFound, id)
+}
+
+// StopProcess terminates the Claude Code process identified by id, waiting up
+// to timeout for it to exit after SIGTERM before sending SIGKILL
+func (pm *ProcessManager) StopProcess(id string, timeout time.Duration) (*ProcessInfo, error) {
+	process, err := pm.FindProcess(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := TerminateProcess(process.PID, timeout); err != nil {
+		return process, fmt.Errorf("failed to stop process %d: %w", process.PID, err)
+	}
+
+	if _, tracked := pm.GetProcess(process.SessionID); tracked {
+		pm.tracker.RemoveProcess(process.SessionID)
+	}
+	process.SetState(StateStopped)
+
+	return process, nil
+}
+
+// TerminateProcess sends SIGTERM to pid and waits up to timeout for it to exit,
+// falling back to SIGKILL
+func TerminateProcess(pid int, timeout time.Duration) error {
+	if pid <= 0 {
+		return fmt.Errorf("invalid PID: %d", pid)
+	}
+
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
+		if errors.Is(err, syscall.ESRCH) {
+			return nil
+		}
+		return fmt.Errorf("failed to send SIGTERM: %w", err)
+	}
+
+	if WaitForExit(pid, timeout) {
+		return nil
+	}
+
+	if err := syscall.Kill(pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("failed to send SIGKILL: %w", err)
+	}
+	return nil
+}
+
+// InterruptProcess sends SIGINT to pid, as Ctrl-C in its terminal would,
+// without waiting for it to exit. A process that is already gone is not an
+// error.
+func InterruptProcess(pid int) error {
+	if pid <= 0 {
+		return fmt.Errorf("invalid PID: %d", pid)
+	}
+
+	if err := syscall.Kill(pid, syscall.SIGINT); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("failed to send SIGINT: %w", err)
+	}
+	return nil
+}
+
+// KillProcess interrupts pid and sends SIGKILL if it has not exited within
+// timeout. It reports whether SIGKILL was needed.
+func KillProcess(pid int, timeout time.Duration) (bool, error) {
+	if err := InterruptProcess(pid); err != nil {
+		return false, err
+	}
+
+	if WaitForExit(pid, timeout) {
+		return false, nil
+	}
+
+	if err := syscall.Kill(pid, syscall.SIGKILL); err != nil {
+		if errors.Is(err, syscall.ESRCH) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to send SIGKILL: %w", err)
+	}
+	return true, nil
+}
+
+// WaitForExit waits up to timeout for pid to exit and reports whether it did
+func WaitForExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if !processAlive(pid) {
+			return true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return !processAlive(pid)
+}
+
+// matchesProcessID reports whether id refers to process
+func matchesProcessID(process *ProcessInfo, id string) bool {
+	if process.SessionID == id || (process.TmuxSession != "" && process.TmuxSession == id) {
+		return true
+	}
+	pid, err := strconv.Atoi(id)
+	return err == nil && pid == process.PID
+}
+
+// processAlive reports whether a process with pid still exists
+func processAlive(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
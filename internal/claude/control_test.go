@@ -0,0 +1,186 @@
+package claude
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func startSleepProcess(t *testing.T) *exec.Cmd {
+	cmd := exec.Command("sleep", "30")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start sleep process: %v", err)
+	}
+	// Reap the child so it does not linger as a zombie once signalled
+	go cmd.Wait()
+	t.Cleanup(func() { cmd.Process.Kill() })
+	return cmd
+}
+
+func TestTerminateProcess(t *testing.T) {
+	cmd := startSleepProcess(t)
+
+	if err := TerminateProcess(cmd.Process.Pid, 2*time.Second); err != nil {
+		t.Fatalf("TerminateProcess() error = %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for processAlive(cmd.Process.Pid) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if processAlive(cmd.Process.Pid) {
+		t.Error("Expected process to be terminated")
+	}
+}
+
+func TestTerminateProcess_InvalidPID(t *testing.T) {
+	if err := TerminateProcess(0, time.Second); err == nil {
+		t.Error("Expected error for invalid PID")
+	}
+}
+
+func TestInterruptProcess(t *testing.T) {
+	cmd := startSleepProcess(t)
+
+	if err := InterruptProcess(cmd.Process.Pid); err != nil {
+		t.Fatalf("InterruptProcess() error = %v", err)
+	}
+	if !WaitForExit(cmd.Process.Pid, 2*time.Second) {
+		t.Error("Expected interrupted process to exit")
+	}
+}
+
+func TestInterruptProcess_InvalidPID(t *testing.T) {
+	if err := InterruptProcess(-1); err == nil {
+		t.Error("Expected error for invalid PID")
+	}
+}
+
+func TestKillProcess_ExitsOnInterrupt(t *testing.T) {
+	cmd := startSleepProcess(t)
+
+	forced, err := KillProcess(cmd.Process.Pid, 2*time.Second)
+	if err != nil {
+		t.Fatalf("KillProcess() error = %v", err)
+	}
+	if forced {
+		t.Error("Expected process to exit on SIGINT without SIGKILL")
+	}
+}
+
+func TestKillProcess_EscalatesToSIGKILL(t *testing.T) {
+	// The shell ignores SIGINT and exec passes the ignored disposition on
+	cmd := exec.Command("sh", "-c", `trap "" INT; exec sleep 30`)
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start process: %v", err)
+	}
+	go cmd.Wait()
+	t.Cleanup(func() { cmd.Process.Kill() })
+	// Give the shell time to install the trap before it is interrupted
+	time.Sleep(100 * time.Millisecond)
+
+	forced, err := KillProcess(cmd.Process.Pid, 300*time.Millisecond)
+	if err != nil {
+		t.Fatalf("KillProcess() error = %v", err)
+	}
+	if !forced {
+		t.Error("Expected SIGKILL for a process ignoring SIGINT")
+	}
+	if !WaitForExit(cmd.Process.Pid, time.Second) {
+		t.Error("Expected process to be killed")
+	}
+}
+
+func TestWaitForExit_Timeout(t *testing.T) {
+	cmd := startSleepProcess(t)
+
+	start := time.Now()
+	if WaitForExit(cmd.Process.Pid, 200*time.Millisecond) {
+		t.Error("Expected running process not to exit")
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("Expected WaitForExit to wait for the timeout, returned after %v", elapsed)
+	}
+}
+
+func TestMatchesProcessID(t *testing.T) {
+	process := &ProcessInfo{PID: 4242, SessionID: "claude-4242-100", TmuxSession: "ccmgr-proj-main"}
+
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"claude-4242-100", true},
+		{"4242", true},
+		{"ccmgr-proj-main", true},
+		{"424", false},
+		{"claude-1-1", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesProcessID(process, tt.id); got != tt.want {
+			t.Errorf("matchesProcessID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestProcessManager_StopProcess(t *testing.T) {
+	manager, err := NewProcessManager(nil)
+	if err != nil {
+		t.Fatalf("Failed to create process manager: %v", err)
+	}
+
+	cmd := startSleepProcess(t)
+	process := &ProcessInfo{PID: cmd.Process.Pid, SessionID: "claude-test-stop", State: StateIdle}
+	if err := manager.tracker.AddProcess(process); err != nil {
+		t.Fatalf("Failed to track process: %v", err)
+	}
+
+	stopped, err := manager.StopProcess("claude-test-stop", 2*time.Second)
+	if err != nil {
+		t.Fatalf("StopProcess() error = %v", err)
+	}
+	if stopped.GetState() != StateStopped {
+		t.Errorf("Expected stopped state, got %s", stopped.GetState())
+	}
+	if _, tracked := manager.GetProcess("claude-test-stop"); tracked {
+		t.Error("Expected stopped process to no longer be tracked")
+	}
+}
+
+func TestProcessManager_FindProcess(t *testing.T) {
+	manager, err := NewProcessManager(nil)
+	if err != nil {
+		t.Fatalf("Failed to create process manager: %v", err)
+	}
+
+	process := &ProcessInfo{PID: 4242, SessionID: "claude-4242-100", TmuxSession: "ccmgr-proj-main", State: StateIdle}
+	if err := manager.tracker.AddProcess(process); err != nil {
+		t.Fatalf("Failed to track process: %v", err)
+	}
+
+	for _, id := range []string{"claude-4242-100", "4242", "ccmgr-proj-main"} {
+		found, err := manager.FindProcess(id)
+		if err != nil {
+			t.Errorf("FindProcess(%q) error = %v", id, err)
+			continue
+		}
+		if found.PID != 4242 {
+			t.Errorf("FindProcess(%q) PID = %d, want 4242", id, found.PID)
+		}
+	}
+}
+
+func TestProcessManager_FindProcess_NotFound(t *testing.T) {
+	manager, err := NewProcessManager(nil)
+	if err != nil {
+		t.Fatalf("Failed to create process manager: %v", err)
+	}
+
+	_, err = manager.FindProcess("claude-does-not-exist")
+	if !errors.Is(err, ErrProcessNotFound) {
+		t.Errorf("Expected ErrProcessNotFound, got %v", err)
+	}
+}
@@ -149,18 +149,20 @@ func (d *DefaultDetector) GetProcessInfo(pid int) (*ProcessInfo, error) {
 	// Try to determine worktree ID from working directory
 	worktreeID := d.getWorktreeID(workingDir)
 
+	now := time.Now()
 	processInfo := &ProcessInfo{
-		PID:         pid,
-		SessionID:   sessionID,
-		WorkingDir:  workingDir,
-		Command:     cmdline,
-		StartTime:   startTime,
-		State:       StateStarting, // Default to starting state
-		LastUpdate:  time.Now(),
-		TmuxSession: tmuxSession,
-		WorktreeID:  worktreeID,
-		CPUPercent:  cpuPercent,
-		MemoryMB:    memoryMB,
+		PID:          pid,
+		SessionID:    sessionID,
+		WorkingDir:   workingDir,
+		Command:      cmdline,
+		StartTime:    startTime,
+		State:        StateStarting, // Default to starting state
+		StateChanged: now,
+		LastUpdate:   now,
+		TmuxSession:  tmuxSession,
+		WorktreeID:   worktreeID,
+		CPUPercent:   cpuPercent,
+		MemoryMB:     memoryMB,
 	}
 
 	return processInfo, nil
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -12,6 +13,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
 )
 
 // DefaultStateMonitor implements StateMonitor interface
@@ -19,6 +22,7 @@ type DefaultStateMonitor struct {
 	config      *ProcessConfig
 	detector    ProcessDetector
 	logMonitors map[string]*LogMonitor
+	watchedLogs []string // log files matched by config.LogPaths at the last poll
 	running     bool
 	mutex       sync.RWMutex
 	stopCh      chan struct{}
@@ -53,6 +57,8 @@ func (m *DefaultStateMonitor) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to compile state patterns: %w", err)
 	}
 
+	m.watchedLogs = expandLogPaths(m.config.LogPaths)
+
 	go m.monitorLoop()
 	return nil
 }
@@ -145,12 +151,100 @@ func (m *DefaultStateMonitor) monitorLoop() {
 		case <-m.ctx.Done():
 			return
 		case <-ticker.C:
-			// This method would be called by the tracker
-			// Individual process monitoring is handled by MonitorState
+			// Individual process monitoring is handled by MonitorState, called
+			// by the tracker; re-glob here so new log files are picked up
+			m.refreshWatchedLogs()
 		}
 	}
 }
 
+// refreshWatchedLogs re-expands the configured log paths
+func (m *DefaultStateMonitor) refreshWatchedLogs() {
+	paths := expandLogPaths(m.config.LogPaths)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	watched := make(map[string]bool, len(m.watchedLogs))
+	for _, path := range m.watchedLogs {
+		watched[path] = true
+	}
+	for _, path := range paths {
+		if !watched[path] {
+			slog.Debug("watching claude log file", "path", path)
+		}
+	}
+	m.watchedLogs = paths
+}
+
+// WatchedLogs returns the log files matched by the configured log paths when
+// they were last expanded
+func (m *DefaultStateMonitor) WatchedLogs() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return append([]string(nil), m.watchedLogs...)
+}
+
+// expandLogPaths expands ~, environment variables and glob patterns in the
+// configured log paths. Directories contribute the .log files they contain.
+// Paths that match nothing are skipped.
+func expandLogPaths(patterns []string) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	for _, pattern := range patterns {
+		expanded := config.ExpandPath(pattern)
+
+		matches, err := filepath.Glob(expanded)
+		if err != nil {
+			slog.Debug("skipping invalid claude log path", "path", pattern, "error", err)
+			continue
+		}
+		if len(matches) == 0 {
+			slog.Debug("skipping claude log path with no matches", "path", pattern)
+			continue
+		}
+
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil {
+				continue
+			}
+			if !info.IsDir() {
+				add(match)
+				continue
+			}
+			for _, file := range logFilesInDir(match) {
+				add(file)
+			}
+		}
+	}
+
+	return paths
+}
+
+// logFilesInDir returns the .log files directly inside dir
+func logFilesInDir(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".log") {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files
+}
+
 // detectStateFromResources analyzes CPU and memory usage to infer state
 func (m *DefaultStateMonitor) detectStateFromResources(process *ProcessInfo) (ProcessState, error) {
 	// Get current resource usage
@@ -234,8 +328,8 @@ func (m *DefaultStateMonitor) detectStateFromActivity(process *ProcessInfo) (Pro
 
 // detectStateFromTmux analyzes tmux session output
 func (m *DefaultStateMonitor) detectStateFromTmux(process *ProcessInfo) (ProcessState, error) {
-	// Capture tmux pane content
-	cmd := exec.Command("tmux", "capture-pane", "-t", process.TmuxSession, "-p")
+	// Capture tmux pane content; '=' matches the session name exactly
+	cmd := exec.Command("tmux", "capture-pane", "-t", "="+process.TmuxSession+":", "-p")
 	output, err := cmd.Output()
 	if err != nil {
 		return StateUnknown, fmt.Errorf("failed to capture tmux pane: %w", err)
@@ -247,30 +341,17 @@ func (m *DefaultStateMonitor) detectStateFromTmux(process *ProcessInfo) (Process
 
 // findLogFiles attempts to locate log files for a process
 func (m *DefaultStateMonitor) findLogFiles(process *ProcessInfo) []string {
-	var logPaths []string
-
-	// Check configured log paths
-	for _, pattern := range m.config.LogPaths {
-		// Expand tilde and environment variables
-		expandedPattern := os.ExpandEnv(pattern)
-		if strings.HasPrefix(expandedPattern, "~/") {
-			homeDir, err := os.UserHomeDir()
-			if err == nil {
-				expandedPattern = filepath.Join(homeDir, expandedPattern[2:])
-			}
-		}
+	m.mutex.RLock()
+	running := m.running
+	m.mutex.RUnlock()
 
-		// Handle patterns with wildcards
-		if strings.Contains(expandedPattern, "*") {
-			matches, err := filepath.Glob(expandedPattern)
-			if err == nil {
-				logPaths = append(logPaths, matches...)
-			}
-		} else {
-			if _, err := os.Stat(expandedPattern); err == nil {
-				logPaths = append(logPaths, expandedPattern)
-			}
-		}
+	// A running monitor keeps the configured paths expanded; otherwise expand
+	// them now
+	var logPaths []string
+	if running {
+		logPaths = m.WatchedLogs()
+	} else {
+		logPaths = expandLogPaths(m.config.LogPaths)
 	}
 
 	// Also check common locations relative to working directory
@@ -282,17 +363,7 @@ func (m *DefaultStateMonitor) findLogFiles(process *ProcessInfo) []string {
 		}
 
 		for _, path := range commonPaths {
-			if info, err := os.Stat(path); err == nil && info.IsDir() {
-				// Look for log files in this directory
-				entries, err := os.ReadDir(path)
-				if err == nil {
-					for _, entry := range entries {
-						if strings.HasSuffix(entry.Name(), ".log") {
-							logPaths = append(logPaths, filepath.Join(path, entry.Name()))
-						}
-					}
-				}
-			}
+			logPaths = append(logPaths, logFilesInDir(path)...)
 		}
 	}
 
@@ -426,6 +497,7 @@ func (m *DefaultStateMonitor) GetMonitorStats() map[string]interface{} {
 	stats := map[string]interface{}{
 		"running":             m.running,
 		"log_monitors":        len(m.logMonitors),
+		"watched_logs":        len(m.watchedLogs),
 		"poll_interval":       m.config.PollInterval.String(),
 		"log_parsing":         m.config.EnableLogParsing,
 		"resource_monitoring": m.config.EnableResourceMonitoring,
@@ -0,0 +1,98 @@
+package claude
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeLogFile(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("Ready\n"), 0644); err != nil {
+		t.Fatalf("Failed to write log file: %v", err)
+	}
+}
+
+func TestExpandLogPaths(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	tmp := t.TempDir()
+
+	writeLogFile(t, filepath.Join(tmp, "claude-1.log"))
+	writeLogFile(t, filepath.Join(tmp, "claude-2.log"))
+	writeLogFile(t, filepath.Join(tmp, "other.log"))
+	writeLogFile(t, filepath.Join(home, ".claude", "logs", "session.log"))
+	writeLogFile(t, filepath.Join(home, ".claude", "logs", "notes.txt"))
+
+	got := expandLogPaths([]string{
+		filepath.Join(tmp, "claude-*"),
+		"~/.claude/logs",
+		filepath.Join(tmp, "missing-*"),
+		filepath.Join(tmp, "absent.log"),
+		filepath.Join(tmp, "claude-1.log"), // already matched by the glob
+	})
+
+	want := []string{
+		filepath.Join(tmp, "claude-1.log"),
+		filepath.Join(tmp, "claude-2.log"),
+		filepath.Join(home, ".claude", "logs", "session.log"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expandLogPaths() = %v, want %v", got, want)
+	}
+}
+
+func TestExpandLogPaths_InvalidPattern(t *testing.T) {
+	if got := expandLogPaths([]string{"/tmp/claude-["}); len(got) != 0 {
+		t.Errorf("Expected invalid pattern to be skipped, got %v", got)
+	}
+}
+
+func TestStateMonitor_WatchesNewLogFiles(t *testing.T) {
+	tmp := t.TempDir()
+	existing := filepath.Join(tmp, "claude-existing.log")
+	writeLogFile(t, existing)
+
+	config := &ProcessConfig{}
+	config.SetDefaults()
+	config.LogPaths = []string{filepath.Join(tmp, "claude-*")}
+	config.PollInterval = 20 * time.Millisecond
+
+	monitor := NewDefaultStateMonitor(config, nil)
+	if err := monitor.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer monitor.Stop()
+
+	if got := monitor.WatchedLogs(); !reflect.DeepEqual(got, []string{existing}) {
+		t.Fatalf("WatchedLogs() after start = %v, want %v", got, []string{existing})
+	}
+
+	created := filepath.Join(tmp, "claude-created.log")
+	writeLogFile(t, created)
+
+	want := []string{created, existing}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		got := monitor.WatchedLogs()
+		if reflect.DeepEqual(got, want) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("WatchedLogs() = %v, want %v", got, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The watched file is used for state detection
+	found := monitor.findLogFiles(&ProcessInfo{PID: 1})
+	if !reflect.DeepEqual(found, want) {
+		t.Errorf("findLogFiles() = %v, want %v", found, want)
+	}
+}
@@ -3,6 +3,7 @@ package claude
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 	// "github.com/unbracketed/ccmgr-ultra/internal/analytics" // Commented out to avoid import cycle
@@ -193,7 +194,7 @@ func (pm *ProcessManager) OnStateChange(ctx context.Context, event StateChangeEv
 	for _, handler := range handlers {
 		if err := handler.OnStateChange(ctx, event); err != nil {
 			// Log error but continue with other handlers
-			fmt.Printf("Error in state change handler: %v\n", err)
+			slog.Error("claude state change handler failed", "error", err)
 		}
 	}
 
@@ -287,7 +288,11 @@ func (pm *ProcessManager) EmitSessionEvent(eventType, sessionID, project, worktr
 
 // DiscoverProcesses manually triggers process discovery
 func (pm *ProcessManager) DiscoverProcesses() ([]*ProcessInfo, error) {
-	return pm.detector.DetectProcesses(pm.ctx)
+	ctx := pm.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return pm.detector.DetectProcesses(ctx)
 }
 
 // WaitForState waits for a process to reach a specific state with timeout
@@ -3,6 +3,7 @@ package claude
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 )
@@ -74,7 +75,7 @@ func (t *DefaultProcessTracker) Stop() error {
 	// Stop the monitor
 	if err := t.monitor.Stop(); err != nil {
 		// Log error but don't fail
-		fmt.Printf("Warning: failed to stop monitor: %v\n", err)
+		slog.Warn("failed to stop claude process monitor", "error", err)
 	}
 
 	select {
@@ -267,7 +268,7 @@ func (t *DefaultProcessTracker) cleanupLoop() {
 func (t *DefaultProcessTracker) discoverNewProcesses() {
 	processes, err := t.detector.DetectProcesses(t.ctx)
 	if err != nil {
-		fmt.Printf("Error detecting processes: %v\n", err)
+		slog.Error("failed to detect claude processes", "error", err)
 		return
 	}
 
@@ -275,7 +276,7 @@ func (t *DefaultProcessTracker) discoverNewProcesses() {
 		// Check if we're already tracking this process
 		if _, exists := t.GetProcess(process.SessionID); !exists {
 			if err := t.AddProcess(process); err != nil {
-				fmt.Printf("Error adding process %s: %v\n", process.SessionID, err)
+				slog.Error("failed to track claude process", "session", process.SessionID, "error", err)
 			}
 		}
 	}
@@ -289,7 +290,7 @@ func (t *DefaultProcessTracker) monitorExistingProcesses() {
 		// Check if process still exists and update its state
 		currentState, err := t.monitor.MonitorState(t.ctx, process)
 		if err != nil {
-			fmt.Printf("Error monitoring process %s: %v\n", process.SessionID, err)
+			slog.Error("failed to monitor claude process", "session", process.SessionID, "error", err)
 			continue
 		}
 
@@ -316,7 +317,7 @@ func (t *DefaultProcessTracker) monitorExistingProcesses() {
 		// If process is stopped, remove it from tracking
 		if currentState == StateStopped {
 			if err := t.RemoveProcess(process.SessionID); err != nil {
-				fmt.Printf("Error removing stopped process %s: %v\n", process.SessionID, err)
+				slog.Error("failed to remove stopped claude process", "session", process.SessionID, "error", err)
 			}
 		}
 	}
@@ -373,7 +374,7 @@ func (t *DefaultProcessTracker) notifySubscribers(event StateChangeEvent) {
 			defer cancel()
 
 			if err := handler.OnStateChange(ctx, event); err != nil {
-				fmt.Printf("Error in state change handler: %v\n", err)
+				slog.Error("claude state change handler failed", "error", err)
 			}
 		}()
 	}
@@ -2,7 +2,9 @@ package claude
 
 import (
 	"context"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 )
@@ -44,18 +46,19 @@ func (s ProcessState) String() string {
 
 // ProcessInfo holds information about a Claude Code process
 type ProcessInfo struct {
-	PID         int          `json:"pid"`
-	SessionID   string       `json:"session_id"`
-	WorkingDir  string       `json:"working_dir"`
-	Command     []string     `json:"command"`
-	StartTime   time.Time    `json:"start_time"`
-	State       ProcessState `json:"state"`
-	LastUpdate  time.Time    `json:"last_update"`
-	TmuxSession string       `json:"tmux_session,omitempty"`
-	WorktreeID  string       `json:"worktree_id,omitempty"`
-	CPUPercent  float64      `json:"cpu_percent"`
-	MemoryMB    int64        `json:"memory_mb"`
-	mutex       sync.RWMutex `json:"-"`
+	PID          int          `json:"pid"`
+	SessionID    string       `json:"session_id"`
+	WorkingDir   string       `json:"working_dir"`
+	Command      []string     `json:"command"`
+	StartTime    time.Time    `json:"start_time"`
+	State        ProcessState `json:"state"`
+	StateChanged time.Time    `json:"state_changed"` // when State last changed
+	LastUpdate   time.Time    `json:"last_update"`
+	TmuxSession  string       `json:"tmux_session,omitempty"`
+	WorktreeID   string       `json:"worktree_id,omitempty"`
+	CPUPercent   float64      `json:"cpu_percent"`
+	MemoryMB     int64        `json:"memory_mb"`
+	mutex        sync.RWMutex `json:"-"`
 }
 
 // GetState safely returns the current state
@@ -69,8 +72,32 @@ func (p *ProcessInfo) GetState() ProcessState {
 func (p *ProcessInfo) SetState(state ProcessState) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
+	now := time.Now()
+	if p.State != state {
+		p.StateChanged = now
+	}
 	p.State = state
-	p.LastUpdate = time.Now()
+	p.LastUpdate = now
+}
+
+// LastStateChange safely returns when the state last changed
+func (p *ProcessInfo) LastStateChange() time.Time {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.StateChanged
+}
+
+// InDirectory reports whether the process runs in dir or a directory below it
+func (p *ProcessInfo) InDirectory(dir string) bool {
+	if p.WorkingDir == "" || dir == "" {
+		return false
+	}
+
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(p.WorkingDir))
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
 }
 
 // UpdateStats safely updates process statistics
@@ -54,6 +54,44 @@ func TestProcessInfo_SetState(t *testing.T) {
 	if process.LastUpdate.Before(before) || process.LastUpdate.After(after) {
 		t.Errorf("LastUpdate not properly set: %v", process.LastUpdate)
 	}
+	if changed := process.LastStateChange(); changed.Before(before) || changed.After(after) {
+		t.Errorf("StateChanged not properly set: %v", changed)
+	}
+}
+
+func TestProcessInfo_SetState_Unchanged(t *testing.T) {
+	changed := time.Now().Add(-time.Hour)
+	process := &ProcessInfo{PID: 1234, State: StateBusy, StateChanged: changed}
+
+	process.SetState(StateBusy)
+
+	if got := process.LastStateChange(); !got.Equal(changed) {
+		t.Errorf("StateChanged = %v, want unchanged %v", got, changed)
+	}
+}
+
+func TestProcessInfo_InDirectory(t *testing.T) {
+	tests := []struct {
+		workingDir string
+		dir        string
+		want       bool
+	}{
+		{"/work/app", "/work/app", true},
+		{"/work/app/src/cmd", "/work/app", true},
+		{"/work/app/", "/work/app", true},
+		{"/work/app-auth", "/work/app", false},
+		{"/work", "/work/app", false},
+		{"/work/..app", "/work", true},
+		{"", "/work/app", false},
+		{"/work/app", "", false},
+	}
+
+	for _, tt := range tests {
+		process := &ProcessInfo{WorkingDir: tt.workingDir}
+		if got := process.InDirectory(tt.dir); got != tt.want {
+			t.Errorf("ProcessInfo{WorkingDir: %q}.InDirectory(%q) = %v, want %v", tt.workingDir, tt.dir, got, tt.want)
+		}
+	}
 }
 
 func TestProcessInfo_UpdateStats(t *testing.T) {
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ColorMode selects when CLI output uses ANSI styling
+type ColorMode string
+
+const (
+	ColorAuto   ColorMode = "auto"
+	ColorNever  ColorMode = "never"
+	ColorAlways ColorMode = "always"
+)
+
+// colorMode is the mode used by ColorEnabled
+var colorMode = ColorAuto
+
+// stdoutIsTerminal reports whether stdout is a terminal; replaced in tests
+var stdoutIsTerminal = IsInteractiveTerminal
+
+// SetColorMode sets when CLI output uses ANSI styling
+func SetColorMode(mode ColorMode) {
+	colorMode = mode
+}
+
+// CurrentColorMode returns when CLI output uses ANSI styling
+func CurrentColorMode() ColorMode {
+	return colorMode
+}
+
+// ValidateColorMode parses a color mode name
+func ValidateColorMode(mode string) (ColorMode, error) {
+	switch strings.ToLower(mode) {
+	case "auto":
+		return ColorAuto, nil
+	case "never":
+		return ColorNever, nil
+	case "always":
+		return ColorAlways, nil
+	default:
+		return ColorAuto, fmt.Errorf("unsupported color mode: %s (supported: auto, never, always)", mode)
+	}
+}
+
+// ColorEnabled reports whether CLI output may contain ANSI styling. In auto
+// mode that is only when stdout is a terminal and NO_COLOR is unset.
+func ColorEnabled() bool {
+	switch colorMode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	return os.Getenv("NO_COLOR") == "" && stdoutIsTerminal()
+}
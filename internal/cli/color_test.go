@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// withColorMode sets the color mode and whether stdout is a terminal for
+// the duration of a test
+func withColorMode(t *testing.T, mode ColorMode, terminal bool) {
+	t.Helper()
+
+	previousMode, previousTerminal := colorMode, stdoutIsTerminal
+	t.Cleanup(func() {
+		colorMode, stdoutIsTerminal = previousMode, previousTerminal
+	})
+
+	SetColorMode(mode)
+	stdoutIsTerminal = func() bool { return terminal }
+}
+
+func TestValidateColorMode(t *testing.T) {
+	for input, expected := range map[string]ColorMode{
+		"auto":   ColorAuto,
+		"never":  ColorNever,
+		"always": ColorAlways,
+		"NEVER":  ColorNever,
+	} {
+		mode, err := ValidateColorMode(input)
+		if err != nil {
+			t.Errorf("ValidateColorMode(%q) error = %v", input, err)
+		}
+		if mode != expected {
+			t.Errorf("ValidateColorMode(%q) = %q, want %q", input, mode, expected)
+		}
+	}
+
+	if _, err := ValidateColorMode("sometimes"); err == nil {
+		t.Error("ValidateColorMode(\"sometimes\") succeeded, want error")
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     ColorMode
+		terminal bool
+		noColor  string
+		expected bool
+	}{
+		{name: "auto on terminal", mode: ColorAuto, terminal: true, expected: true},
+		{name: "auto piped", mode: ColorAuto, terminal: false, expected: false},
+		{name: "auto with NO_COLOR", mode: ColorAuto, terminal: true, noColor: "1", expected: false},
+		{name: "never on terminal", mode: ColorNever, terminal: true, expected: false},
+		{name: "always piped", mode: ColorAlways, terminal: false, expected: true},
+		{name: "always overrides NO_COLOR", mode: ColorAlways, terminal: false, noColor: "1", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withColorMode(t, tt.mode, tt.terminal)
+			t.Setenv("NO_COLOR", tt.noColor)
+
+			if got := ColorEnabled(); got != tt.expected {
+				t.Errorf("ColorEnabled() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestColorMode_PipedOutputHasNoEscapes(t *testing.T) {
+	data := struct {
+		Worktrees []struct {
+			Name     string
+			Branch   string
+			Head     string
+			IsClean  bool
+			Modified int
+		}
+		Total int
+	}{
+		Worktrees: []struct {
+			Name     string
+			Branch   string
+			Head     string
+			IsClean  bool
+			Modified int
+		}{
+			{Name: "dirty-worktree", Branch: "feature/dirty", Head: "abc1234567890", Modified: 2},
+		},
+		Total: 1,
+	}
+
+	tests := []struct {
+		name        string
+		mode        ColorMode
+		terminal    bool
+		wantEscapes bool
+	}{
+		{name: "auto piped", mode: ColorAuto, terminal: false},
+		{name: "never on terminal", mode: ColorNever, terminal: true},
+		{name: "always piped", mode: ColorAlways, terminal: false, wantEscapes: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withColorMode(t, tt.mode, tt.terminal)
+			t.Setenv("NO_COLOR", "")
+
+			var buf bytes.Buffer
+			if err := NewWorktreeTableFormatter(&buf).Format(data); err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if got := strings.Contains(buf.String(), "\033["); got != tt.wantEscapes {
+				t.Errorf("output contains escape sequences = %v, want %v\nOutput:\n%q", got, tt.wantEscapes, buf.String())
+			}
+
+			style := DefaultConfirmationStyle()
+			if got := style.PromptColor != ""; got != tt.wantEscapes {
+				t.Errorf("confirmation style colored = %v, want %v", got, tt.wantEscapes)
+			}
+		})
+	}
+}
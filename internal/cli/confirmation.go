@@ -33,8 +33,12 @@ type ConfirmationOptions struct {
 	Style           ConfirmationStyle
 }
 
-// DefaultConfirmationStyle returns the default confirmation style
+// DefaultConfirmationStyle returns the default confirmation style, which is
+// unstyled when ColorEnabled is false
 func DefaultConfirmationStyle() ConfirmationStyle {
+	if !ColorEnabled() {
+		return ConfirmationStyle{}
+	}
 	return ConfirmationStyle{
 		WarningColor: "\033[33m", // Yellow
 		ErrorColor:   "\033[31m", // Red
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 )
@@ -17,12 +19,54 @@ const (
 	ExitTimeout ExitCode = 4
 )
 
+// ErrorOutput selects how HandleCLIError renders errors
+type ErrorOutput string
+
+const (
+	ErrorOutputText ErrorOutput = "text"
+	ErrorOutputJSON ErrorOutput = "json"
+)
+
+// errorOutput is the rendering used by HandleCLIError
+var errorOutput = ErrorOutputText
+
+// SetErrorOutput sets how HandleCLIError renders errors
+func SetErrorOutput(output ErrorOutput) {
+	errorOutput = output
+}
+
+// CurrentErrorOutput returns how HandleCLIError renders errors
+func CurrentErrorOutput() ErrorOutput {
+	return errorOutput
+}
+
+// ValidateErrorOutput parses an error output name
+func ValidateErrorOutput(output string) (ErrorOutput, error) {
+	switch strings.ToLower(output) {
+	case "text":
+		return ErrorOutputText, nil
+	case "json":
+		return ErrorOutputJSON, nil
+	default:
+		return ErrorOutputText, fmt.Errorf("unsupported output: %s (supported: text, json)", output)
+	}
+}
+
 // CLIError represents a CLI-specific error with additional context
 type CLIError struct {
 	Message    string
 	Suggestion string
 	ExitCode   ExitCode
 	Cause      error
+
+	reported bool // already written by HandleCLIError
+}
+
+// errorEnvelope is the JSON form of a CLIError
+type errorEnvelope struct {
+	Error      string `json:"error"`
+	Cause      string `json:"cause,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
 }
 
 func (e *CLIError) Error() string {
@@ -91,18 +135,39 @@ func HandleCLIError(err error) error {
 		cliErr = NewErrorWithCause("command failed", err)
 	}
 
-	// Print error message to stderr
-	fmt.Fprintf(os.Stderr, "Error: %s\n", cliErr.Message)
+	WriteError(os.Stderr, cliErr, errorOutput)
+	cliErr.reported = true
 
-	if cliErr.Cause != nil && cliErr.Cause.Error() != cliErr.Message {
-		fmt.Fprintf(os.Stderr, "Cause: %v\n", cliErr.Cause)
+	return cliErr
+}
+
+// WriteError renders err to w, either as "Error:", "Cause:" and "Suggestion:"
+// lines or as a single JSON object with error, cause and suggestion fields
+func WriteError(w io.Writer, err *CLIError, output ErrorOutput) error {
+	var cause string
+	if err.Cause != nil && err.Cause.Error() != err.Message {
+		cause = err.Cause.Error()
 	}
 
-	if cliErr.Suggestion != "" {
-		fmt.Fprintf(os.Stderr, "Suggestion: %s\n", cliErr.Suggestion)
+	if output == ErrorOutputJSON {
+		return json.NewEncoder(w).Encode(errorEnvelope{
+			Error:      err.Message,
+			Cause:      cause,
+			Suggestion: err.Suggestion,
+		})
 	}
 
-	return cliErr
+	fmt.Fprintf(w, "Error: %s\n", err.Message)
+
+	if cause != "" {
+		fmt.Fprintf(w, "Cause: %s\n", cause)
+	}
+
+	if err.Suggestion != "" {
+		fmt.Fprintf(w, "Suggestion: %s\n", err.Suggestion)
+	}
+
+	return nil
 }
 
 // ExitWithError handles an error and exits with the appropriate code
@@ -112,9 +177,12 @@ func ExitWithError(err error) {
 		return
 	}
 
-	HandleCLIError(err)
+	cliErr, ok := err.(*CLIError)
+	if !ok || !cliErr.reported {
+		HandleCLIError(err)
+	}
 
-	if cliErr, ok := err.(*CLIError); ok {
+	if ok {
 		os.Exit(int(cliErr.ExitCode))
 	}
 
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriteError_JSON(t *testing.T) {
+	err := NewErrorWithSuggestion("failed to create worktree", "Use --force to overwrite")
+	err.Cause = errors.New("path already exists")
+
+	var buf bytes.Buffer
+	if writeErr := WriteError(&buf, err, ErrorOutputJSON); writeErr != nil {
+		t.Fatalf("WriteError failed: %v", writeErr)
+	}
+
+	var envelope map[string]string
+	if jsonErr := json.Unmarshal(buf.Bytes(), &envelope); jsonErr != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", jsonErr, buf.String())
+	}
+
+	expected := map[string]string{
+		"error":      "failed to create worktree",
+		"cause":      "path already exists",
+		"suggestion": "Use --force to overwrite",
+	}
+	for key, want := range expected {
+		if envelope[key] != want {
+			t.Errorf("Expected %s %q, got %q", key, want, envelope[key])
+		}
+	}
+}
+
+func TestWriteError_JSONOmitsEmptyFields(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteError(&buf, NewError("not in a git repository"), ErrorOutputJSON); err != nil {
+		t.Fatalf("WriteError failed: %v", err)
+	}
+
+	output := strings.TrimSpace(buf.String())
+	if output != `{"error":"not in a git repository"}` {
+		t.Errorf("Unexpected JSON output: %s", output)
+	}
+}
+
+func TestWriteError_Text(t *testing.T) {
+	err := NewErrorWithCause("failed to list sessions", errors.New("tmux not running")).
+		WithSuggestion("Start tmux first")
+
+	var buf bytes.Buffer
+	if writeErr := WriteError(&buf, err, ErrorOutputText); writeErr != nil {
+		t.Fatalf("WriteError failed: %v", writeErr)
+	}
+
+	expected := "Error: failed to list sessions\nCause: tmux not running\nSuggestion: Start tmux first\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestValidateErrorOutput(t *testing.T) {
+	for input, want := range map[string]ErrorOutput{"text": ErrorOutputText, "JSON": ErrorOutputJSON} {
+		got, err := ValidateErrorOutput(input)
+		if err != nil || got != want {
+			t.Errorf("ValidateErrorOutput(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+
+	if _, err := ValidateErrorOutput("xml"); err == nil {
+		t.Error("Expected error for unsupported output")
+	}
+}
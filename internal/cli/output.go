@@ -97,6 +97,42 @@ func NewWorktreeFormatter(format OutputFormat, writer io.Writer) OutputFormatter
 	}
 }
 
+// NewProcessFormatter creates a new formatter specifically for Claude Code process data
+func NewProcessFormatter(format OutputFormat, writer io.Writer) OutputFormatter {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	switch format {
+	case FormatJSON:
+		return &JSONFormatter{writer: writer}
+	case FormatYAML:
+		return &YAMLFormatter{writer: writer}
+	case FormatTable:
+		return NewProcessTableFormatter(writer)
+	default:
+		return &SimpleTableFormatter{writer: writer}
+	}
+}
+
+// NewPullRequestFormatter creates a new formatter specifically for pull request data
+func NewPullRequestFormatter(format OutputFormat, writer io.Writer) OutputFormatter {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	switch format {
+	case FormatJSON:
+		return &JSONFormatter{writer: writer}
+	case FormatYAML:
+		return &YAMLFormatter{writer: writer}
+	case FormatTable:
+		return NewPullRequestTableFormatter(writer)
+	default:
+		return &SimpleTableFormatter{writer: writer}
+	}
+}
+
 // SimpleTableFormatter formats output as a simple table (for backward compatibility)
 type SimpleTableFormatter struct {
 	writer io.Writer
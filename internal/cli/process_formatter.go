@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProcessTableFormatter formats Claude Code process data as a table
+type ProcessTableFormatter struct {
+	writer io.Writer
+}
+
+// NewProcessTableFormatter creates a new process table formatter
+func NewProcessTableFormatter(writer io.Writer) *ProcessTableFormatter {
+	return &ProcessTableFormatter{
+		writer: writer,
+	}
+}
+
+// Format formats the process data as a structured table
+func (f *ProcessTableFormatter) Format(data interface{}) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return fmt.Errorf("process data is nil")
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("invalid data type for process formatter: expected struct, got %T", data)
+	}
+
+	processesField := v.FieldByName("Processes")
+	totalField := v.FieldByName("Total")
+
+	if !processesField.IsValid() || processesField.Len() == 0 {
+		fmt.Fprintf(f.writer, "No Claude Code processes found\n")
+		return nil
+	}
+
+	f.formatProcessesReflection(processesField)
+
+	if totalField.IsValid() {
+		fmt.Fprintf(f.writer, "\nTotal processes: %d\n", int(totalField.Int()))
+	}
+
+	return nil
+}
+
+// formatProcessesReflection formats processes using reflection
+func (f *ProcessTableFormatter) formatProcessesReflection(processesField reflect.Value) {
+	f.printSectionHeader("Claude Processes")
+
+	headers := []string{"ID", "PID", "State", "In State", "Worktree", "Session", "Uptime", "Directory"}
+	widths := []int{24, 8, 8, 8, 15, 20, 8, 30}
+
+	f.printTableHeader(headers, widths)
+
+	for i := 0; i < processesField.Len(); i++ {
+		process := processesField.Index(i)
+
+		uptime := "-"
+		if started := getFieldTime(process, "StartTime"); !started.IsZero() {
+			uptime = formatDuration(time.Since(started).Truncate(time.Second))
+		}
+
+		changed := "-"
+		if at := getFieldTime(process, "StateChanged"); !at.IsZero() {
+			changed = formatDuration(time.Since(at).Truncate(time.Second))
+		}
+
+		row := []string{
+			shortenPath(getFieldString(process, "ID"), 24),
+			strconv.Itoa(getFieldInt(process, "PID")),
+			getFieldString(process, "State"),
+			changed,
+			shortenPath(getFieldString(process, "Worktree"), 15),
+			shortenPath(getFieldString(process, "TmuxSession"), 20),
+			uptime,
+			shortenPath(getFieldString(process, "Directory"), 30),
+		}
+		f.printTableRow(row, widths)
+	}
+
+	f.printTableFooter(widths)
+}
+
+// Helper printing functions (reused from status_formatter.go pattern)
+
+func (f *ProcessTableFormatter) printSectionHeader(title string) {
+	fmt.Fprintf(f.writer, "┌─ %s ─", title)
+	padding := 60 - len(title) - 4
+	if padding > 0 {
+		fmt.Fprint(f.writer, strings.Repeat("─", padding))
+	}
+	fmt.Fprintf(f.writer, "┐\n")
+}
+
+func (f *ProcessTableFormatter) printTableHeader(headers []string, widths []int) {
+	fmt.Fprintf(f.writer, "│ ")
+	for i, width := range widths {
+		fmt.Fprintf(f.writer, "%-*s", width, headers[i])
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, " │ ")
+		}
+	}
+	fmt.Fprintf(f.writer, " │\n")
+
+	fmt.Fprintf(f.writer, "├")
+	for i, width := range widths {
+		fmt.Fprint(f.writer, strings.Repeat("─", width+2))
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, "┼")
+		}
+	}
+	fmt.Fprintf(f.writer, "┤\n")
+}
+
+func (f *ProcessTableFormatter) printTableRow(row []string, widths []int) {
+	fmt.Fprintf(f.writer, "│ ")
+	for i, width := range widths {
+		value := ""
+		if i < len(row) {
+			value = row[i]
+		}
+		fmt.Fprintf(f.writer, "%-*s", width, value)
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, " │ ")
+		}
+	}
+	fmt.Fprintf(f.writer, " │\n")
+}
+
+func (f *ProcessTableFormatter) printTableFooter(widths []int) {
+	fmt.Fprintf(f.writer, "└")
+	for i, width := range widths {
+		fmt.Fprint(f.writer, strings.Repeat("─", width+2))
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, "┴")
+		}
+	}
+	fmt.Fprintf(f.writer, "┘\n")
+}
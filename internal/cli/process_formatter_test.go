@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProcessTableFormatter_EmptyList(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := NewProcessTableFormatter(&buf)
+
+	data := struct {
+		Processes []struct{} `json:"processes"`
+		Total     int        `json:"total"`
+	}{}
+
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "No Claude Code processes found") {
+		t.Errorf("Expected 'No Claude Code processes found', got: %s", buf.String())
+	}
+}
+
+func TestProcessTableFormatter_Processes(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := NewProcessTableFormatter(&buf)
+
+	type process struct {
+		ID           string
+		PID          int
+		State        string
+		StateChanged time.Time
+		Worktree     string
+		TmuxSession  string
+		Directory    string
+		StartTime    time.Time
+	}
+
+	data := &struct {
+		Processes []process
+		Total     int
+	}{
+		Processes: []process{
+			{
+				ID:           "claude-4242-1700000000",
+				PID:          4242,
+				State:        "busy",
+				StateChanged: time.Now().Add(-3 * time.Minute),
+				Worktree:     "feature-auth",
+				TmuxSession:  "ccmgr-app-feature",
+				Directory:    "/work/app/feature-auth",
+				StartTime:    time.Now().Add(-90 * time.Minute),
+			},
+			{ID: "claude-7-1", PID: 7, State: "idle"},
+		},
+		Total: 2,
+	}
+
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{
+		"Claude Processes", "ID", "PID", "State", "In State", "Worktree", "Session", "Uptime",
+		"claude-4242-1700000000", "4242", "busy", "3m 0s", "feature-auth", "ccmgr-app-feature", "1h 30m",
+		"claude-7-1", "idle",
+		"Total processes: 2",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q\nOutput:\n%s", expected, output)
+		}
+	}
+}
+
+func TestProcessTableFormatter_InvalidData(t *testing.T) {
+	formatter := NewProcessTableFormatter(&bytes.Buffer{})
+
+	if err := formatter.Format("not a struct"); err == nil {
+		t.Error("Expected error for non-struct data")
+	}
+}
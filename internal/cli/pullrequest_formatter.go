@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// PullRequestTableFormatter formats pull request data as a table
+type PullRequestTableFormatter struct {
+	writer io.Writer
+}
+
+// NewPullRequestTableFormatter creates a new pull request table formatter
+func NewPullRequestTableFormatter(writer io.Writer) *PullRequestTableFormatter {
+	return &PullRequestTableFormatter{
+		writer: writer,
+	}
+}
+
+// Format formats the pull request data as a structured table
+func (f *PullRequestTableFormatter) Format(data interface{}) error {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return fmt.Errorf("pull request data is nil")
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("invalid data type for pull request formatter: expected struct, got %T", data)
+	}
+
+	pullRequestsField := v.FieldByName("PullRequests")
+	totalField := v.FieldByName("Total")
+
+	if !pullRequestsField.IsValid() || pullRequestsField.Len() == 0 {
+		fmt.Fprintf(f.writer, "No open pull requests found\n")
+		return nil
+	}
+
+	f.formatPullRequestsReflection(pullRequestsField)
+
+	if totalField.IsValid() {
+		fmt.Fprintf(f.writer, "\nTotal pull requests: %d\n", int(totalField.Int()))
+	}
+
+	return nil
+}
+
+// formatPullRequestsReflection formats pull requests using reflection
+func (f *PullRequestTableFormatter) formatPullRequestsReflection(pullRequestsField reflect.Value) {
+	f.printSectionHeader("Pull Requests")
+
+	headers := []string{"#", "Title", "Branch", "Target", "Worktree", "URL"}
+	widths := []int{6, 30, 20, 10, 15, 40}
+
+	f.printTableHeader(headers, widths)
+
+	for i := 0; i < pullRequestsField.Len(); i++ {
+		pr := pullRequestsField.Index(i)
+
+		title := getFieldString(pr, "Title")
+		if getFieldBool(pr, "Draft") {
+			title = "[draft] " + title
+		}
+
+		row := []string{
+			fmt.Sprintf("#%d", getFieldInt(pr, "Number")),
+			truncateTitle(title, 30),
+			shortenPath(getFieldString(pr, "SourceBranch"), 20),
+			shortenPath(getFieldString(pr, "TargetBranch"), 10),
+			shortenPath(getFieldString(pr, "Worktree"), 15),
+			getFieldString(pr, "URL"),
+		}
+		f.printTableRow(row, widths)
+	}
+
+	f.printTableFooter(widths)
+}
+
+// truncateTitle shortens title to maxLen characters, marking the cut with "..."
+func truncateTitle(title string, maxLen int) string {
+	runes := []rune(title)
+	if len(runes) <= maxLen {
+		return title
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
+
+// Helper printing functions (reused from status_formatter.go pattern)
+
+func (f *PullRequestTableFormatter) printSectionHeader(title string) {
+	fmt.Fprintf(f.writer, "┌─ %s ─", title)
+	padding := 60 - len(title) - 4
+	if padding > 0 {
+		fmt.Fprint(f.writer, strings.Repeat("─", padding))
+	}
+	fmt.Fprintf(f.writer, "┐\n")
+}
+
+func (f *PullRequestTableFormatter) printTableHeader(headers []string, widths []int) {
+	fmt.Fprintf(f.writer, "│ ")
+	for i, width := range widths {
+		fmt.Fprintf(f.writer, "%-*s", width, headers[i])
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, " │ ")
+		}
+	}
+	fmt.Fprintf(f.writer, " │\n")
+
+	fmt.Fprintf(f.writer, "├")
+	for i, width := range widths {
+		fmt.Fprint(f.writer, strings.Repeat("─", width+2))
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, "┼")
+		}
+	}
+	fmt.Fprintf(f.writer, "┤\n")
+}
+
+func (f *PullRequestTableFormatter) printTableRow(row []string, widths []int) {
+	fmt.Fprintf(f.writer, "│ ")
+	for i, width := range widths {
+		value := ""
+		if i < len(row) {
+			value = row[i]
+		}
+		fmt.Fprintf(f.writer, "%-*s", width, value)
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, " │ ")
+		}
+	}
+	fmt.Fprintf(f.writer, " │\n")
+}
+
+func (f *PullRequestTableFormatter) printTableFooter(widths []int) {
+	fmt.Fprintf(f.writer, "└")
+	for i, width := range widths {
+		fmt.Fprint(f.writer, strings.Repeat("─", width+2))
+		if i < len(widths)-1 {
+			fmt.Fprintf(f.writer, "┴")
+		}
+	}
+	fmt.Fprintf(f.writer, "┘\n")
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPullRequestTableFormatter_EmptyList(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := NewPullRequestTableFormatter(&buf)
+
+	data := struct {
+		PullRequests []struct{} `json:"pull_requests"`
+		Total        int        `json:"total"`
+	}{}
+
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "No open pull requests found") {
+		t.Errorf("Expected 'No open pull requests found', got: %s", buf.String())
+	}
+}
+
+func TestPullRequestTableFormatter_PullRequests(t *testing.T) {
+	var buf bytes.Buffer
+	formatter := NewPullRequestTableFormatter(&buf)
+
+	type pullRequest struct {
+		Number       int
+		Title        string
+		Draft        bool
+		SourceBranch string
+		TargetBranch string
+		Worktree     string
+		URL          string
+	}
+
+	data := &struct {
+		PullRequests []pullRequest
+		Total        int
+	}{
+		PullRequests: []pullRequest{
+			{
+				Number:       42,
+				Title:        "Add token scope pre-check before creating pull requests",
+				Draft:        true,
+				SourceBranch: "feature/scopes",
+				TargetBranch: "main",
+				Worktree:     "app-scopes",
+				URL:          "https://github.com/user/repo/pull/42",
+			},
+		},
+		Total: 1,
+	}
+
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"#42", "[draft] Add token", "...", "feature/scopes", "app-scopes", "https://github.com/user/repo/pull/42", "Total pull requests: 1"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got: %s", expected, output)
+		}
+	}
+}
+
+func TestTruncateTitle(t *testing.T) {
+	if got := truncateTitle("short", 10); got != "short" {
+		t.Errorf("truncateTitle() = %q, want %q", got, "short")
+	}
+	if got := truncateTitle("a much longer title", 10); got != "a much ..." {
+		t.Errorf("truncateTitle() = %q, want %q", got, "a much ...")
+	}
+}
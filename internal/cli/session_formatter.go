@@ -63,6 +63,13 @@ func (f *SessionTableFormatter) formatSessionsReflection(sessionsField reflect.V
 	headers := []string{"Name", "Project", "Branch", "Status", "Directory", "Created", "Last Access"}
 	widths := []int{25, 15, 15, 8, 30, 12, 12}
 
+	// Show upstream status only when it was collected (session list --with-git)
+	withGit := sessionsHaveGitStatus(sessionsField)
+	if withGit {
+		headers = append(headers[:4], append([]string{"Git"}, headers[4:]...)...)
+		widths = append(widths[:4], append([]int{12}, widths[4:]...)...)
+	}
+
 	// Print header
 	f.printTableHeader(headers, widths)
 
@@ -80,6 +87,9 @@ func (f *SessionTableFormatter) formatSessionsReflection(sessionsField reflect.V
 			formatTimeAgo(getFieldTime(session, "Created")),
 			formatTimeAgo(getFieldTime(session, "LastAccess")),
 		}
+		if withGit {
+			row = append(row[:4], append([]string{getFieldString(session, "GitStatus")}, row[4:]...)...)
+		}
 		f.printTableRow(row, widths)
 	}
 
@@ -87,6 +97,17 @@ func (f *SessionTableFormatter) formatSessionsReflection(sessionsField reflect.V
 	return nil
 }
 
+// sessionsHaveGitStatus reports whether any session carries a git status
+func sessionsHaveGitStatus(sessionsField reflect.Value) bool {
+	for i := 0; i < sessionsField.Len(); i++ {
+		session := reflect.ValueOf(sessionsField.Index(i).Interface())
+		if getFieldString(session, "GitStatus") != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper printing functions (reused from status_formatter.go pattern)
 
 func (f *SessionTableFormatter) printSectionHeader(title string) {
@@ -286,3 +286,49 @@ func TestSessionTableFormatter_LongPathShortening(t *testing.T) {
 		t.Errorf("Expected long path to be shortened, but found full path in output: %s", output)
 	}
 }
+
+func TestSessionTableFormatter_GitStatusColumn(t *testing.T) {
+	type sessionItem struct {
+		Name      string    `json:"name"`
+		Branch    string    `json:"branch"`
+		Active    bool      `json:"active"`
+		GitStatus string    `json:"git_status"`
+		Created   time.Time `json:"created"`
+	}
+
+	tests := []struct {
+		name      string
+		gitStatus string
+		wantGit   bool
+	}{
+		{"shown when collected", "↑2↓1", true},
+		{"hidden when not collected", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			formatter := NewSessionTableFormatter(&buf)
+
+			data := struct {
+				Sessions []interface{} `json:"sessions"`
+				Total    int           `json:"total"`
+			}{
+				Sessions: []interface{}{sessionItem{Name: "ccmgr-app-main", Branch: "main", GitStatus: tt.gitStatus}},
+				Total:    1,
+			}
+
+			if err := formatter.Format(data); err != nil {
+				t.Fatalf("Format failed: %v", err)
+			}
+
+			output := buf.String()
+			if got := strings.Contains(output, "Git "); got != tt.wantGit {
+				t.Errorf("Git column shown = %v, want %v\n%s", got, tt.wantGit, output)
+			}
+			if tt.wantGit && !strings.Contains(output, tt.gitStatus) {
+				t.Errorf("Expected git status %q in output, got: %s", tt.gitStatus, output)
+			}
+		})
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"time"
 )
 
-// Spinner provides a simple text-based progress indicator
+// Spinner provides a simple text-based progress indicator. When its writer
+// is not a terminal it does not animate, since the carriage returns would
+// corrupt piped output and logs, and only StopWithMessage prints a line.
 type Spinner struct {
 	writer  io.Writer
+	animate bool
 	message string
 	frames  []string
 	delay   time.Duration
@@ -20,10 +23,21 @@ type Spinner struct {
 	mu      sync.Mutex
 }
 
+// writerIsTerminal reports whether w is a terminal; replaced in tests
+var writerIsTerminal = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
 // NewSpinner creates a new spinner with default settings
 func NewSpinner(message string) *Spinner {
 	return &Spinner{
 		writer:  os.Stderr,
+		animate: writerIsTerminal(os.Stderr),
 		message: message,
 		frames:  []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
 		delay:   100 * time.Millisecond,
@@ -35,6 +49,7 @@ func NewSpinner(message string) *Spinner {
 func NewDotSpinner(message string) *Spinner {
 	return &Spinner{
 		writer:  os.Stderr,
+		animate: writerIsTerminal(os.Stderr),
 		message: message,
 		frames:  []string{".", "..", "...", "    "},
 		delay:   500 * time.Millisecond,
@@ -47,6 +62,7 @@ func (s *Spinner) SetWriter(w io.Writer) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.writer = w
+	s.animate = writerIsTerminal(w)
 }
 
 // SetMessage updates the spinner message
@@ -64,9 +80,12 @@ func (s *Spinner) Start() {
 		return
 	}
 	s.active = true
+	animate := s.animate
 	s.mu.Unlock()
 
-	go s.spin()
+	if animate {
+		go s.spin()
+	}
 }
 
 // Stop ends the spinner animation
@@ -77,10 +96,13 @@ func (s *Spinner) Stop() {
 		return
 	}
 	s.active = false
+	animate := s.animate
 	s.mu.Unlock()
 
-	s.done <- true
-	s.clearLine()
+	if animate {
+		s.done <- true
+		s.clearLine()
+	}
 }
 
 // StopWithMessage stops the spinner and displays a final message
@@ -101,9 +123,11 @@ func (s *Spinner) spin() {
 			return
 		case <-ticker.C:
 			s.mu.Lock()
+			// Stop is about to signal done; keep waiting for it rather than
+			// returning and leaving Stop blocked on the send
 			if !s.active {
 				s.mu.Unlock()
-				return
+				continue
 			}
 
 			frame := s.frames[frameIndex%len(s.frames)]
@@ -130,12 +154,11 @@ func (s *Spinner) clearLine() {
 // IsQuietMode checks if output should be suppressed based on environment
 func IsQuietMode() bool {
 	// This can be enhanced to check for global quiet flags
-	// For now, just check if stderr is not a terminal
 	return false
 }
 
 // ShouldShowProgress determines if progress indicators should be shown
 func ShouldShowProgress() bool {
 	// Don't show progress indicators in quiet mode or when output is redirected
-	return !IsQuietMode()
+	return !IsQuietMode() && stdoutIsTerminal()
 }
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer is a bytes.Buffer safe for the spinner goroutine to write to
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// withTerminalWriters makes every spinner writer look like a terminal, or
+// not, for the duration of a test
+func withTerminalWriters(t *testing.T, terminal bool) {
+	t.Helper()
+
+	previous := writerIsTerminal
+	t.Cleanup(func() { writerIsTerminal = previous })
+	writerIsTerminal = func(io.Writer) bool { return terminal }
+}
+
+func runSpinner(out io.Writer) {
+	spinner := NewSpinner("Working...")
+	spinner.SetWriter(out)
+	spinner.delay = 5 * time.Millisecond
+
+	spinner.Start()
+	time.Sleep(30 * time.Millisecond)
+	spinner.SetMessage("Still working...")
+	time.Sleep(30 * time.Millisecond)
+	spinner.StopWithMessage("Done")
+}
+
+func TestSpinner_Terminal(t *testing.T) {
+	withTerminalWriters(t, true)
+
+	var out syncBuffer
+	runSpinner(&out)
+
+	output := out.String()
+	if !strings.Contains(output, "\r") {
+		t.Errorf("spinner on a terminal did not animate\nOutput: %q", output)
+	}
+	if !strings.HasSuffix(output, "Done\n") {
+		t.Errorf("spinner output does not end with the final message\nOutput: %q", output)
+	}
+}
+
+func TestSpinner_Pipe(t *testing.T) {
+	withTerminalWriters(t, false)
+
+	var out syncBuffer
+	runSpinner(&out)
+
+	if output := out.String(); output != "Done\n" {
+		t.Errorf("spinner off a terminal output = %q, want only the final message", output)
+	}
+
+	// Stop without a message prints nothing
+	spinner := NewSpinner("Working...")
+	spinner.SetWriter(&out)
+	spinner.Start()
+	spinner.Stop()
+	if output := out.String(); output != "Done\n" {
+		t.Errorf("Stop() off a terminal wrote output: %q", output)
+	}
+}
+
+func TestShouldShowProgress(t *testing.T) {
+	previous := stdoutIsTerminal
+	t.Cleanup(func() { stdoutIsTerminal = previous })
+
+	stdoutIsTerminal = func() bool { return true }
+	if !ShouldShowProgress() {
+		t.Error("ShouldShowProgress() = false on a terminal")
+	}
+
+	stdoutIsTerminal = func() bool { return false }
+	if ShouldShowProgress() {
+		t.Error("ShouldShowProgress() = true when stdout is not a terminal")
+	}
+}
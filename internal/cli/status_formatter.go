@@ -253,6 +253,17 @@ func getFieldBool(v reflect.Value, fieldName string) bool {
 	return field.Bool()
 }
 
+func getFieldStrings(v reflect.Value, fieldName string) []string {
+	field := v.FieldByName(fieldName)
+	if !field.IsValid() {
+		return nil
+	}
+	if values, ok := field.Interface().([]string); ok {
+		return values
+	}
+	return nil
+}
+
 func getFieldTime(v reflect.Value, fieldName string) time.Time {
 	field := v.FieldByName(fieldName)
 	if !field.IsValid() {
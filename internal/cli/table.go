@@ -67,6 +67,10 @@ type TableTheme struct {
 	DataColor      string
 	AlternateColor string
 	SelectedColor  string
+	SuccessColor   string
+	WarningColor   string
+	ErrorColor     string
+	InfoColor      string
 }
 
 // BorderStyle defines table border appearance
@@ -136,8 +140,12 @@ func DefaultTableTheme() TableTheme {
 		AlternateStyle: CellStyle{
 			BgColor: "\033[48;5;236m", // Dark gray background
 		},
-		BorderColor: "\033[90m", // Dark gray
-		DataColor:   "\033[0m",  // Default
+		BorderColor:  "\033[90m", // Dark gray
+		DataColor:    "\033[0m",  // Default
+		SuccessColor: "\033[32m", // Green
+		WarningColor: "\033[33m", // Yellow
+		ErrorColor:   "\033[31m", // Red
+		InfoColor:    "\033[36m", // Cyan
 	}
 }
 
@@ -169,7 +177,7 @@ func NewTableFormatter(opts *TableOptions) *TableFormatter {
 		theme:        DefaultTableTheme(),
 		options:      *opts,
 		maxWidth:     120, // Default terminal width
-		colorEnabled: true,
+		colorEnabled: ColorEnabled(),
 	}
 }
 
@@ -9,16 +9,37 @@ import (
 
 // WorktreeTableFormatter formats worktree data using comprehensive TableFormatter
 type WorktreeTableFormatter struct {
-	writer io.Writer
+	writer       io.Writer
+	theme        TableTheme
+	colorEnabled bool
+	displayPath  func(string) string
 }
 
 // NewWorktreeTableFormatter creates a new worktree table formatter
 func NewWorktreeTableFormatter(writer io.Writer) *WorktreeTableFormatter {
 	return &WorktreeTableFormatter{
-		writer: writer,
+		writer:       writer,
+		theme:        DefaultTableTheme(),
+		colorEnabled: ColorEnabled(),
 	}
 }
 
+// SetColorEnabled enables or disables color output
+func (f *WorktreeTableFormatter) SetColorEnabled(enabled bool) {
+	f.colorEnabled = enabled
+}
+
+// SetPathFormatter adds a Path column showing each worktree's path as
+// rendered by displayPath
+func (f *WorktreeTableFormatter) SetPathFormatter(displayPath func(string) string) {
+	f.displayPath = displayPath
+}
+
+// SetTheme sets the table theme
+func (f *WorktreeTableFormatter) SetTheme(theme TableTheme) {
+	f.theme = theme
+}
+
 // Format formats the worktree data as properly structured tables
 func (f *WorktreeTableFormatter) Format(data interface{}) error {
 	v := reflect.ValueOf(data)
@@ -60,8 +81,12 @@ func (f *WorktreeTableFormatter) formatWorktreesReflection(worktreesField reflec
 	f.printSectionHeader("Worktrees")
 
 	// Define column headers and widths
-	headers := []string{"Name", "Branch", "Head", "Status", "Session", "Last Access"}
-	widths := []int{25, 20, 10, 10, 15, 12}
+	headers := []string{"Name", "Branch", "Head", "Status", "Git", "Session", "Last Access"}
+	widths := []int{25, 20, 10, 10, 16, 15, 12}
+	if f.displayPath != nil {
+		headers = append(headers, "Path")
+		widths = append(widths, 30)
+	}
 
 	// Print header
 	f.printTableHeader(headers, widths)
@@ -74,15 +99,29 @@ func (f *WorktreeTableFormatter) formatWorktreesReflection(worktreesField reflec
 			head = head[:8]
 		}
 
+		gitSummary, gitColor := f.formatGitSummary(wt)
+
+		branch := getFieldString(wt, "Branch")
+		status := formatWorktreeStatusFromFields(getFieldBool(wt, "IsClean"))
+		if getFieldBool(wt, "Detached") {
+			branch = "(detached)"
+			status = "⚠ Detached"
+		}
+
 		row := []string{
 			shortenPath(getFieldString(wt, "Name"), 25),
-			shortenPath(getFieldString(wt, "Branch"), 20),
+			shortenPath(branch, 20),
 			head,
-			formatWorktreeStatusFromFields(getFieldBool(wt, "IsClean")),
-			getFieldString(wt, "TmuxSession"),
+			status,
+			gitSummary,
+			formatSessionsCell(wt),
 			formatTimeAgo(getFieldTime(wt, "LastAccessed")),
 		}
-		f.printTableRow(row, widths)
+		if f.displayPath != nil {
+			row = append(row, shortenPath(f.displayPath(getFieldString(wt, "Path")), 30))
+		}
+		colors := []string{"", "", "", "", gitColor, "", ""}
+		f.printTableRow(row, colors, widths)
 	}
 
 	f.printTableFooter(widths)
@@ -97,6 +136,67 @@ func formatWorktreeStatusFromFields(isClean bool) string {
 	return "⚠ Dirty"
 }
 
+// formatSessionsCell shows the joined session names when available, summarizing
+// extra sessions as "+N", and falls back to the single tracked tmux session
+func formatSessionsCell(wt reflect.Value) string {
+	sessions := getFieldStrings(wt, "Sessions")
+	switch len(sessions) {
+	case 0:
+		return getFieldString(wt, "TmuxSession")
+	case 1:
+		return sessions[0]
+	default:
+		return fmt.Sprintf("%s +%d", sessions[0], len(sessions)-1)
+	}
+}
+
+// formatGitSummary builds a compact ahead/behind and
+// staged/modified/untracked/conflicted summary such as "↑2↓1 +3 ~1 ?4 !1"
+// along with the theme color to render it in
+func (f *WorktreeTableFormatter) formatGitSummary(wt reflect.Value) (string, string) {
+	ahead := getFieldInt(wt, "Ahead")
+	behind := getFieldInt(wt, "Behind")
+	staged := getFieldInt(wt, "Staged")
+	modified := getFieldInt(wt, "Modified")
+	untracked := getFieldInt(wt, "Untracked")
+	conflicted := getFieldInt(wt, "Conflicted")
+
+	var parts []string
+	if ahead > 0 || behind > 0 {
+		sync := ""
+		if ahead > 0 {
+			sync += fmt.Sprintf("↑%d", ahead)
+		}
+		if behind > 0 {
+			sync += fmt.Sprintf("↓%d", behind)
+		}
+		parts = append(parts, sync)
+	}
+	if staged > 0 {
+		parts = append(parts, fmt.Sprintf("+%d", staged))
+	}
+	if modified > 0 {
+		parts = append(parts, fmt.Sprintf("~%d", modified))
+	}
+	if untracked > 0 {
+		parts = append(parts, fmt.Sprintf("?%d", untracked))
+	}
+	if conflicted > 0 {
+		parts = append(parts, fmt.Sprintf("!%d", conflicted))
+	}
+
+	switch {
+	case conflicted > 0:
+		return strings.Join(parts, " "), f.theme.ErrorColor
+	case staged > 0 || modified > 0 || untracked > 0:
+		return strings.Join(parts, " "), f.theme.WarningColor
+	case ahead > 0 || behind > 0:
+		return strings.Join(parts, " "), f.theme.InfoColor
+	default:
+		return "✓", f.theme.SuccessColor
+	}
+}
+
 // Helper printing functions (reuse from status_formatter.go pattern)
 
 // printSectionHeader prints a section header with decorative styling
@@ -132,15 +232,21 @@ func (f *WorktreeTableFormatter) printTableHeader(headers []string, widths []int
 	fmt.Fprintf(f.writer, "┤\n")
 }
 
-// printTableRow prints a table data row
-func (f *WorktreeTableFormatter) printTableRow(row []string, widths []int) {
+// printTableRow prints a table data row, wrapping each cell in the matching
+// color when color output is enabled. Padding is applied before the escape
+// codes so columns stay aligned.
+func (f *WorktreeTableFormatter) printTableRow(row []string, colors []string, widths []int) {
 	fmt.Fprintf(f.writer, "│ ")
 	for i, width := range widths {
 		value := ""
 		if i < len(row) {
 			value = row[i]
 		}
-		fmt.Fprintf(f.writer, "%-*s", width, value)
+		cell := fmt.Sprintf("%-*s", width, value)
+		if f.colorEnabled && i < len(colors) && colors[i] != "" {
+			cell = colors[i] + cell + "\033[0m"
+		}
+		fmt.Fprint(f.writer, cell)
 		if i < len(widths)-1 {
 			fmt.Fprintf(f.writer, " │ ")
 		}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -192,3 +193,229 @@ func TestWorktreeTableFormatter_FormatWorktreeStatusFromFields(t *testing.T) {
 		})
 	}
 }
+
+func TestWorktreeTableFormatter_Detached(t *testing.T) {
+	type worktree struct {
+		Name     string
+		Branch   string
+		Head     string
+		Detached bool
+		IsClean  bool
+	}
+	data := struct {
+		Worktrees []worktree
+		Total     int
+	}{
+		Worktrees: []worktree{
+			{Name: "app-bisect", Head: "def456ghi", Detached: true, IsClean: true},
+		},
+		Total: 1,
+	}
+
+	var buf bytes.Buffer
+	if err := NewWorktreeTableFormatter(&buf).Format(data); err != nil {
+		t.Fatalf("WorktreeTableFormatter.Format() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"(detached)", "⚠ Detached"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("output does not contain %q\nOutput:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "Clean") {
+		t.Errorf("detached worktree shown as clean\nOutput:\n%s", output)
+	}
+}
+
+func TestWorktreeTableFormatter_PathColumn(t *testing.T) {
+	type worktree struct {
+		Name    string
+		Path    string
+		Branch  string
+		IsClean bool
+	}
+	data := struct {
+		Worktrees []worktree
+		Total     int
+	}{
+		Worktrees: []worktree{
+			{Name: "app-login", Path: "/work/.worktrees/app/app-login", Branch: "login", IsClean: true},
+		},
+		Total: 1,
+	}
+
+	var buf bytes.Buffer
+	if err := NewWorktreeTableFormatter(&buf).Format(data); err != nil {
+		t.Fatalf("WorktreeTableFormatter.Format() error = %v", err)
+	}
+	if strings.Contains(buf.String(), "Path") {
+		t.Errorf("path column shown without a path formatter\nOutput:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	formatter := NewWorktreeTableFormatter(&buf)
+	formatter.SetPathFormatter(func(path string) string {
+		return strings.TrimPrefix(path, "/work/.worktrees/app/")
+	})
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("WorktreeTableFormatter.Format() error = %v", err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "Path") || !strings.Contains(output, "app-login") {
+		t.Errorf("output does not contain the path column\nOutput:\n%s", output)
+	}
+	if strings.Contains(output, "/work/.worktrees") {
+		t.Errorf("output contains the unformatted path\nOutput:\n%s", output)
+	}
+}
+
+func TestWorktreeTableFormatter_GitStatusColumn(t *testing.T) {
+	type worktree struct {
+		Name         string
+		Branch       string
+		Head         string
+		IsClean      bool
+		TmuxSession  string
+		LastAccessed time.Time
+		Ahead        int
+		Behind       int
+		Staged       int
+		Modified     int
+		Untracked    int
+	}
+	data := struct {
+		Worktrees []worktree
+		Total     int
+	}{
+		Worktrees: []worktree{
+			{
+				Name:      "mixed-worktree",
+				Branch:    "feature/mixed",
+				Head:      "abc1234567890",
+				Ahead:     2,
+				Behind:    1,
+				Staged:    3,
+				Modified:  1,
+				Untracked: 4,
+			},
+		},
+		Total: 1,
+	}
+
+	tests := []struct {
+		name         string
+		colorEnabled bool
+		expected     string
+		notExpected  string
+	}{
+		{
+			name:         "color disabled",
+			colorEnabled: false,
+			expected:     "│ ↑2↓1 +3 ~1 ?4    │",
+			notExpected:  "\033[",
+		},
+		{
+			name:         "color enabled",
+			colorEnabled: true,
+			expected:     DefaultTableTheme().WarningColor + "↑2↓1 +3 ~1 ?4   \033[0m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			formatter := NewWorktreeTableFormatter(&buf)
+			formatter.SetColorEnabled(tt.colorEnabled)
+
+			if err := formatter.Format(data); err != nil {
+				t.Fatalf("WorktreeTableFormatter.Format() error = %v", err)
+			}
+
+			output := buf.String()
+			if !strings.Contains(output, "Git") {
+				t.Errorf("output does not contain Git column header\nOutput:\n%s", output)
+			}
+			if !strings.Contains(output, tt.expected) {
+				t.Errorf("output does not contain %q\nOutput:\n%s", tt.expected, output)
+			}
+			if tt.notExpected != "" && strings.Contains(output, tt.notExpected) {
+				t.Errorf("output unexpectedly contains %q\nOutput:\n%s", tt.notExpected, output)
+			}
+		})
+	}
+}
+
+func TestWorktreeTableFormatter_FormatGitSummary(t *testing.T) {
+	theme := DefaultTableTheme()
+	tests := []struct {
+		name          string
+		item          struct{ Ahead, Behind, Staged, Modified, Untracked, Conflicted int }
+		expected      string
+		expectedColor string
+	}{
+		{
+			name:          "clean and in sync",
+			expected:      "✓",
+			expectedColor: theme.SuccessColor,
+		},
+		{
+			name:          "ahead only",
+			item:          struct{ Ahead, Behind, Staged, Modified, Untracked, Conflicted int }{Ahead: 3},
+			expected:      "↑3",
+			expectedColor: theme.InfoColor,
+		},
+		{
+			name:          "local changes",
+			item:          struct{ Ahead, Behind, Staged, Modified, Untracked, Conflicted int }{Modified: 2, Untracked: 1},
+			expected:      "~2 ?1",
+			expectedColor: theme.WarningColor,
+		},
+		{
+			name:          "conflicts",
+			item:          struct{ Ahead, Behind, Staged, Modified, Untracked, Conflicted int }{Staged: 1, Conflicted: 2},
+			expected:      "+1 !2",
+			expectedColor: theme.ErrorColor,
+		},
+	}
+
+	formatter := NewWorktreeTableFormatter(&bytes.Buffer{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, color := formatter.formatGitSummary(reflect.ValueOf(tt.item))
+			if summary != tt.expected {
+				t.Errorf("formatGitSummary() summary = %q, want %q", summary, tt.expected)
+			}
+			if color != tt.expectedColor {
+				t.Errorf("formatGitSummary() color = %q, want %q", color, tt.expectedColor)
+			}
+		})
+	}
+}
+
+func TestFormatSessionsCell(t *testing.T) {
+	type item struct {
+		TmuxSession string
+		Sessions    []string
+	}
+
+	tests := []struct {
+		name     string
+		item     item
+		expected string
+	}{
+		{"no sessions", item{}, ""},
+		{"tracked session only", item{TmuxSession: "ccmgr-tracked"}, "ccmgr-tracked"},
+		{"single joined session", item{TmuxSession: "ccmgr-tracked", Sessions: []string{"ccmgr-a"}}, "ccmgr-a"},
+		{"multiple joined sessions", item{Sessions: []string{"ccmgr-a", "ccmgr-b", "ccmgr-c"}}, "ccmgr-a +2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSessionsCell(reflect.ValueOf(tt.item)); got != tt.expected {
+				t.Errorf("formatSessionsCell() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/user"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -18,47 +20,211 @@ const (
 	ConfigDirName  = "ccmgr-ultra"
 )
 
-// LoadFromPath loads configuration from the specified path
+// LoadFromPath loads configuration from the specified path. Failures are
+// returned as *LoadError so callers can tell a missing file from a parse or
+// validation error.
 func LoadFromPath(path string) (*Config, error) {
+	config, migrated, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate configuration
+	if err := config.Validate(); err != nil {
+		return nil, &LoadError{Kind: LoadErrorValidation, Path: path, Err: err}
+	}
+
+	if migrated {
+		// Writing back is best effort: a read-only config still loads and
+		// is simply migrated again next time
+		if err := BackupConfig(path); err == nil {
+			Save(config, path)
+		}
+	}
+
+	return config, nil
+}
+
+// ReadConfigFile parses the configuration at path, migrates it and fills in
+// defaults without validating it or writing it back. Failures are returned as
+// *LoadError.
+func ReadConfigFile(path string) (*Config, error) {
+	config, _, err := readConfigFile(path)
+	return config, err
+}
+
+// readConfigFile implements ReadConfigFile and reports whether the
+// configuration was migrated
+func readConfigFile(path string) (*Config, bool, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		kind := LoadErrorRead
+		if os.IsNotExist(err) {
+			kind = LoadErrorNotFound
+		}
+		return nil, false, &LoadError{Kind: kind, Path: path, Err: err}
 	}
 
+	// Settings missing from the file keep their boolean defaults
 	var config Config
+	config.Git.setSafetyDefaults()
 	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
+		return nil, false, newParseError(path, err)
+	}
+
+	// Upgrade configurations written by older versions
+	migrated, err := Migrate(&config)
+	if err != nil {
+		return nil, false, &LoadError{Kind: LoadErrorValidation, Path: path, Err: err}
 	}
 
 	// Set defaults for missing values
 	config.SetDefaults()
 
-	// Validate configuration
+	return &config, migrated, nil
+}
+
+// ProjectConfigFileNames are the project-local configuration files looked up
+// at the repository root, in order of preference
+var ProjectConfigFileNames = []string{".ccmgr.yaml", ".ccmgr-ultra.yaml"}
+
+// Load loads configuration from default locations: the global config, with
+// the project-local config of the repository containing the current
+// directory merged over it
+func Load() (*Config, error) {
+	return LoadLayered(GetGlobalConfigPath(), currentProjectConfig(), "")
+}
+
+// LoadLayered builds the configuration from its layers, lowest precedence
+// first: the defaults, the global config at globalPath (created when
+// missing), the project-local config at projectPath, the config at
+// overridePath and the CCMGR_* environment variables. The project-local and
+// override configs only change the settings they contain. Empty paths are
+// skipped, and the merged configuration is validated.
+func LoadLayered(globalPath, projectPath, overridePath string) (*Config, error) {
+	config, err := LoadOrCreate(globalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	topPath := globalPath
+	for _, path := range []string{projectPath, overridePath} {
+		if path == "" {
+			continue
+		}
+		if err := config.MergeFile(path); err != nil {
+			return nil, err
+		}
+		topPath = path
+	}
+
+	if err := ApplyEnvironmentOverrides(config); err != nil {
+		return nil, fmt.Errorf("invalid environment override: %w", err)
+	}
+	config.ExpandPaths()
+
 	if err := config.Validate(); err != nil {
-		return nil, fmt.Errorf("config validation failed: %w", err)
+		return nil, &LoadError{Kind: LoadErrorValidation, Path: topPath, Err: err}
 	}
 
-	return &config, nil
+	config.layers = configLayers{global: globalPath, project: projectPath, override: overridePath}
+	return config, nil
 }
 
-// Load loads configuration from default locations
-func Load() (*Config, error) {
-	// Try to load from default config path
-	configPath := GetConfigPath()
-	configFile := filepath.Join(configPath, ConfigFileName)
-
-	// Check if config file exists
-	if _, err := os.Stat(configFile); os.IsNotExist(err) {
-		// Create default config
-		config := DefaultConfig()
-		if err := Save(config, configFile); err != nil {
-			return nil, fmt.Errorf("failed to create default config: %w", err)
+// configLayers records the files a configuration was loaded from by
+// LoadLayered
+type configLayers struct {
+	global   string
+	project  string
+	override string
+}
+
+// Reload loads the configuration again from the files LoadLayered merged for
+// c, reading the CCMGR_* environment variables afresh and overlaying the
+// active profile again, so the result matches what startup would load now
+func (c *Config) Reload() (*Config, error) {
+	if c.layers.global == "" {
+		return nil, fmt.Errorf("configuration was not loaded from a config file")
+	}
+
+	config, err := LoadLayered(c.layers.global, c.layers.project, c.layers.override)
+	if err != nil {
+		return nil, err
+	}
+	if c.ActiveProfile != "" {
+		if err := config.ApplyProfile(c.ActiveProfile); err != nil {
+			return nil, fmt.Errorf("failed to apply config profile: %w", err)
 		}
-		return config, nil
 	}
+	config.ConfigFile = c.ConfigFile
+	return config, nil
+}
 
-	// Load existing config using the path-based Load function
-	return LoadFromPath(configFile)
+// EditablePath returns the file to edit to change the configuration: the
+// --config file when one was given, otherwise the global config. It is empty
+// for configurations not loaded by LoadLayered.
+func (c *Config) EditablePath() string {
+	if c.layers.override != "" {
+		return c.layers.override
+	}
+	return c.layers.global
+}
+
+// MergeFile overlays the YAML configuration at path onto the config. Only the
+// settings present in the file change; lists in the file replace the
+// existing ones. Failures are returned as *LoadError.
+func (c *Config) MergeFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		kind := LoadErrorRead
+		if os.IsNotExist(err) {
+			kind = LoadErrorNotFound
+		}
+		return &LoadError{Kind: kind, Path: path, Err: err}
+	}
+
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return newParseError(path, err)
+	}
+
+	return nil
+}
+
+// FindProjectConfig returns the project-local configuration file at the root
+// of the git repository containing dir, or "" when there is none
+func FindProjectConfig(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			for _, name := range ProjectConfigFileNames {
+				path := filepath.Join(dir, name)
+				if info, err := os.Stat(path); err == nil && !info.IsDir() {
+					return path
+				}
+			}
+			return ""
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// currentProjectConfig returns the project-local configuration file of the
+// repository containing the current directory, or ""
+func currentProjectConfig() string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return FindProjectConfig(cwd)
 }
 
 // Save saves configuration to the specified path
@@ -95,20 +261,35 @@ func Save(config *Config, path string) error {
 
 // LoadOrCreate loads configuration or creates default if not exists
 func LoadOrCreate(path string) (*Config, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		// Create default config
-		config := DefaultConfig()
+	config, err := LoadFromPath(path)
+	if IsNotFoundError(err) {
+		// Missing file is not an error: create and use the defaults
+		config = DefaultConfig()
 		if err := Save(config, path); err != nil {
 			return nil, fmt.Errorf("failed to create default config: %w", err)
 		}
 		return config, nil
 	}
 
-	return LoadFromPath(path)
+	return config, err
 }
 
-// GetConfigPath returns the user config directory path
-func GetConfigPath() string {
+// configDirOverride is the config directory set with SetConfigDir
+var configDirOverride string
+
+// SetConfigDir makes ConfigDir return dir, as the --config-dir flag does. An
+// empty dir restores the default location.
+func SetConfigDir(dir string) {
+	configDirOverride = dir
+}
+
+// ConfigDir returns the ccmgr-ultra config directory: the directory set with
+// SetConfigDir, $XDG_CONFIG_HOME/ccmgr-ultra, or ~/.config/ccmgr-ultra
+func ConfigDir() string {
+	if configDirOverride != "" {
+		return ExpandPath(configDirOverride)
+	}
+
 	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
 		return filepath.Join(configHome, ConfigDirName)
 	}
@@ -122,6 +303,24 @@ func GetConfigPath() string {
 	return filepath.Join(home, ".config", ConfigDirName)
 }
 
+// ConfigDirPath joins elem onto ConfigDir for use as a default setting,
+// abbreviating the home directory to ~ so saved configs stay portable
+func ConfigDirPath(elem ...string) string {
+	path := filepath.Join(append([]string{ConfigDir()}, elem...)...)
+
+	if home, err := os.UserHomeDir(); err == nil {
+		if rel, err := filepath.Rel(home, path); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return "~/" + filepath.ToSlash(rel)
+		}
+	}
+	return path
+}
+
+// GetConfigPath returns the user config directory path
+func GetConfigPath() string {
+	return ConfigDir()
+}
+
 // GetProjectConfigPath returns project-specific config path
 func GetProjectConfigPath(projectPath string) string {
 	return filepath.Join(projectPath, ".ccmgr-ultra", ConfigFileName)
@@ -203,6 +402,7 @@ func DefaultConfig() *Config {
 
 	// Set all defaults using the SetDefaults method
 	config.SetDefaults()
+	config.Git.setSafetyDefaults()
 
 	return config
 }
@@ -298,22 +498,57 @@ func generateTemplate(config *Config) ([]byte, error) {
 	return yaml.Marshal(config)
 }
 
-// ExpandPath expands ~ and environment variables in paths
+// ExpandPath expands a leading ~ or ~user and environment variables in path
 func ExpandPath(path string) string {
-	if path == "" {
+	return os.ExpandEnv(expandHome(path))
+}
+
+// expandHome replaces a leading ~ with the current user's home directory and
+// ~user with that user's home directory. Paths naming an unknown user are
+// returned unchanged.
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~") {
 		return path
 	}
 
-	// Expand ~ to home directory
-	if path[0] == '~' {
-		home, err := os.UserHomeDir()
-		if err == nil {
-			path = filepath.Join(home, path[1:])
+	name, rest, _ := strings.Cut(path[1:], "/")
+	var home string
+	if name == "" {
+		dir, err := os.UserHomeDir()
+		if err != nil {
+			return path
+		}
+		home = dir
+	} else {
+		u, err := user.Lookup(name)
+		if err != nil {
+			return path
 		}
+		home = u.HomeDir
 	}
 
-	// Expand environment variables
-	return os.ExpandEnv(path)
+	return filepath.Join(home, rest)
+}
+
+// ExpandPaths expands ~, ~user and environment variables in the settings
+// holding file system paths: hook scripts, the tmux state file, the Claude
+// log paths and the worktree base directory
+func (c *Config) ExpandPaths() {
+	for _, hook := range []*HookConfig{
+		&c.StatusHooks.IdleHook,
+		&c.StatusHooks.BusyHook,
+		&c.StatusHooks.WaitingHook,
+		&c.WorktreeHooks.CreationHook,
+		&c.WorktreeHooks.ActivationHook,
+	} {
+		hook.Script = ExpandPath(hook.Script)
+	}
+
+	c.Tmux.StateFile = ExpandPath(c.Tmux.StateFile)
+	c.Worktree.BaseDirectory = ExpandPath(c.Worktree.BaseDirectory)
+	for i, path := range c.Claude.LogPaths {
+		c.Claude.LogPaths[i] = ExpandPath(path)
+	}
 }
 
 // BackupConfig creates a backup of the configuration file
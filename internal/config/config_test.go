@@ -2,7 +2,10 @@ package config
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"os"
+	"os/user"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -59,6 +62,50 @@ func TestConfigValidation(t *testing.T) {
 		assert.Contains(t, err.Error(), "must contain template variables")
 	})
 
+	t.Run("registered pattern validator rejects directory pattern", func(t *testing.T) {
+		original := directoryPatternValidator
+		defer func() { directoryPatternValidator = original }()
+		RegisterDirectoryPatternValidator(func(pattern string) error {
+			if strings.Contains(pattern, "/") {
+				return errors.New("pattern contains dangerous sequence: /")
+			}
+			return nil
+		})
+
+		config := DefaultConfig()
+		config.Git.DirectoryPattern = "work/{{.Branch}}"
+		err := config.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid git.directory_pattern")
+	})
+
+	t.Run("non-positive git API timeout fails validation", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Git.APITimeout = -time.Second
+		err := config.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "api timeout must be positive")
+	})
+
+	t.Run("invalid GitHub API URL fails validation", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Git.GitHubAPIURL = "github.example.com/api/v3"
+		err := config.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "github api url must be an http or https URL")
+
+		config.Git.GitHubAPIURL = "https://github.example.com/api/v3"
+		assert.NoError(t, config.Validate())
+	})
+
+	t.Run("negative git API max attempts fails validation", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Git.APIMaxAttempts = -1
+		err := config.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "api max attempts must be at least 1")
+	})
+
 	t.Run("empty shortcut key fails validation", func(t *testing.T) {
 		config := DefaultConfig()
 		config.Shortcuts[""] = "some_action"
@@ -108,6 +155,54 @@ func TestHookConfigValidation(t *testing.T) {
 	})
 }
 
+func TestClaudeConfigStatePatternsValidation(t *testing.T) {
+	newConfig := func(patterns map[string]string) ClaudeConfig {
+		config := ClaudeConfig{}
+		config.SetDefaults()
+		config.StatePatterns = patterns
+		return config
+	}
+	validPatterns := func() map[string]string {
+		return map[string]string{
+			"busy":    `(?i)(Processing|Running)`,
+			"idle":    `(?i)(Ready|Idle)`,
+			"waiting": `(?i)(Continue\?|Y/n)`,
+			"error":   `(?i)(Error|Failed)`,
+		}
+	}
+
+	t.Run("valid patterns pass validation", func(t *testing.T) {
+		config := newConfig(validPatterns())
+		assert.NoError(t, config.Validate())
+	})
+
+	t.Run("no patterns pass validation", func(t *testing.T) {
+		config := newConfig(nil)
+		assert.NoError(t, config.Validate())
+	})
+
+	t.Run("malformed pattern fails validation", func(t *testing.T) {
+		patterns := validPatterns()
+		patterns["waiting"] = `(?i)(Continue\?|Y/n`
+		config := newConfig(patterns)
+
+		err := config.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid state pattern for 'waiting'")
+		assert.Contains(t, err.Error(), "missing closing )")
+	})
+
+	t.Run("missing required key fails validation", func(t *testing.T) {
+		patterns := validPatterns()
+		delete(patterns, "error")
+		config := newConfig(patterns)
+
+		err := config.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "state pattern for 'error' is required")
+	})
+}
+
 func TestCommandsConfigValidation(t *testing.T) {
 	t.Run("empty claude command fails validation", func(t *testing.T) {
 		config := CommandsConfig{
@@ -149,6 +244,27 @@ func TestCommandsConfigValidation(t *testing.T) {
 	})
 }
 
+func TestTUIThemeColorsValidation(t *testing.T) {
+	t.Run("hex colors pass validation", func(t *testing.T) {
+		tui := TUIConfig{RefreshInterval: 5, DefaultScreen: "dashboard"}
+		tui.ThemeColors = TUIThemeColors{Primary: "#646CFF", Error: "#f00"}
+		assert.NoError(t, tui.Validate())
+	})
+
+	t.Run("named color fails validation", func(t *testing.T) {
+		tui := TUIConfig{RefreshInterval: 5, DefaultScreen: "dashboard"}
+		tui.ThemeColors = TUIThemeColors{Background: "black"}
+		err := tui.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `background: "black" is not a hex color`)
+	})
+
+	t.Run("unknown theme name passes validation", func(t *testing.T) {
+		tui := TUIConfig{Theme: "no-such-theme", RefreshInterval: 5, DefaultScreen: "dashboard"}
+		assert.NoError(t, tui.Validate(), "the TUI warns and falls back instead")
+	})
+}
+
 func TestConfigFileOperations(t *testing.T) {
 	tmpDir := t.TempDir()
 	configPath := filepath.Join(tmpDir, "config.yaml")
@@ -294,9 +410,11 @@ func TestConfigDefaults(t *testing.T) {
 		config := &Config{}
 		config.SetDefaults()
 
-		assert.Equal(t, "2.0.0", config.Version)
+		assert.Equal(t, CurrentConfigVersion, config.Version)
 		assert.NotEmpty(t, config.Shortcuts)
 		assert.Equal(t, "claude", config.Commands.ClaudeCommand)
+		assert.Equal(t, DefaultAPITimeout, config.Git.APITimeout)
+		assert.Equal(t, DefaultAPIMaxAttempts, config.Git.APIMaxAttempts)
 	})
 
 	t.Run("DefaultShortcuts returns expected shortcuts", func(t *testing.T) {
@@ -329,6 +447,50 @@ func TestExpandPath(t *testing.T) {
 		expanded := ExpandPath("")
 		assert.Equal(t, "", expanded)
 	})
+
+	t.Run("expands ~/.claude/logs to the caller's home", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		assert.Equal(t, filepath.Join(home, ".claude", "logs"), ExpandPath("~/.claude/logs"))
+		assert.Equal(t, home, ExpandPath("~"))
+	})
+
+	t.Run("expands ~user to that user's home", func(t *testing.T) {
+		current, err := user.Current()
+		require.NoError(t, err)
+
+		assert.Equal(t, filepath.Join(current.HomeDir, ".claude", "logs"), ExpandPath("~"+current.Username+"/.claude/logs"))
+		assert.Equal(t, "~no-such-user-ccmgr/logs", ExpandPath("~no-such-user-ccmgr/logs"))
+	})
+
+	t.Run("leaves absolute paths untouched", func(t *testing.T) {
+		assert.Equal(t, "/var/log/claude", ExpandPath("/var/log/claude"))
+		assert.Equal(t, "/tmp/claude-*", ExpandPath("/tmp/claude-*"))
+	})
+}
+
+func TestLoadLayered_ExpandsPaths(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	global := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(global, []byte(`version: "2.0.0"
+tmux:
+  state_file: "~/state/tmux.json"
+claude:
+  log_paths: ["~/.claude/logs", "/var/log/claude"]
+status_hooks:
+  idle:
+    script: "~/hooks/idle.sh"
+`), 0600))
+
+	config, err := LoadLayered(global, "", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(home, "state", "tmux.json"), config.Tmux.StateFile)
+	assert.Equal(t, []string{filepath.Join(home, ".claude", "logs"), "/var/log/claude"}, config.Claude.LogPaths)
+	assert.Equal(t, filepath.Join(home, "hooks", "idle.sh"), config.StatusHooks.IdleHook.Script)
 }
 
 func TestBackupConfig(t *testing.T) {
@@ -427,3 +589,279 @@ func TestImportExportConfig(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to decode config")
 	})
 }
+
+func TestLoadFromPathErrors(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	tests := []struct {
+		name        string
+		content     string
+		missing     bool
+		kind        LoadErrorKind
+		line        int
+		errContains []string
+	}{
+		{
+			name:        "missing file",
+			missing:     true,
+			kind:        LoadErrorNotFound,
+			errContains: []string{"failed to read config file"},
+		},
+		{
+			name:        "malformed YAML",
+			content:     "version: \"1.0.0\"\ntmux:\n  session_prefix: ccmgr: extra\n",
+			kind:        LoadErrorParse,
+			line:        3,
+			errContains: []string{"failed to parse config file", "at line 3"},
+		},
+		{
+			name:        "wrong value type",
+			content:     "version: \"1.0.0\"\ntmux:\n  max_session_name: lots\n",
+			kind:        LoadErrorParse,
+			line:        3,
+			errContains: []string{"failed to parse config file", "at line 3"},
+		},
+		{
+			name:        "validation failure",
+			content:     "version: \"1.0.0\"\ntui:\n  default_screen: nowhere\n",
+			kind:        LoadErrorValidation,
+			errContains: []string{"config validation failed", "tui validation failed", "invalid default screen: nowhere"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(tmpDir, fmt.Sprintf("config-%d.yaml", i))
+			if !tt.missing {
+				require.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+			}
+
+			cfg, err := LoadFromPath(path)
+			require.Error(t, err)
+			assert.Nil(t, cfg)
+
+			var loadErr *LoadError
+			require.True(t, errors.As(err, &loadErr))
+			assert.Equal(t, tt.kind, loadErr.Kind)
+			assert.Equal(t, path, loadErr.Path)
+			assert.Equal(t, tt.line, loadErr.Line)
+
+			assert.Equal(t, tt.kind == LoadErrorNotFound, IsNotFoundError(err))
+			assert.Equal(t, tt.kind == LoadErrorParse, IsParseError(err))
+			assert.Equal(t, tt.kind == LoadErrorValidation, IsValidationError(err))
+
+			assert.Contains(t, err.Error(), path)
+			for _, s := range tt.errContains {
+				assert.Contains(t, err.Error(), s)
+			}
+		})
+	}
+}
+
+func TestLoadOrCreate_ParseErrorIsNotReplacedByDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("tmux: [\n"), 0600))
+
+	_, err := LoadOrCreate(path)
+	require.Error(t, err)
+	assert.True(t, IsParseError(err))
+
+	// The broken file must be left untouched for the user to fix
+	data, readErr := os.ReadFile(path)
+	require.NoError(t, readErr)
+	assert.Equal(t, "tmux: [\n", string(data))
+}
+
+func TestConfigValidationIssues(t *testing.T) {
+	t.Run("valid config has no issues", func(t *testing.T) {
+		assert.Empty(t, DefaultConfig().ValidationIssues())
+	})
+
+	t.Run("reports every failing section", func(t *testing.T) {
+		config := DefaultConfig()
+		config.LogLevel = "verbose"
+		config.Worktree.DefaultBranch = ""
+		config.Git.MaxWorktrees = -1
+		config.Shortcuts["x"] = ""
+
+		issues := config.ValidationIssues()
+		fields := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			fields = append(fields, issue.Field)
+		}
+		assert.Equal(t, []string{"log_level", "worktree", "git", "shortcuts.x"}, fields)
+	})
+}
+
+func TestReadConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("version: \"2.0.0\"\ngit:\n  max_worktrees: -1\n"), 0600))
+
+	config, err := ReadConfigFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, -1, config.Git.MaxWorktrees)
+	assert.Len(t, config.ValidationIssues(), 1)
+
+	_, err = LoadFromPath(path)
+	assert.True(t, IsValidationError(err))
+}
+
+// writeConfigLayer writes a config layer file in dir
+func writeConfigLayer(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestFindProjectConfig(t *testing.T) {
+	repo := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(repo, ".git"), 0755))
+	subdir := filepath.Join(repo, "internal", "pkg")
+	require.NoError(t, os.MkdirAll(subdir, 0755))
+
+	assert.Empty(t, FindProjectConfig(subdir), "no project config")
+
+	alt := writeConfigLayer(t, repo, ".ccmgr-ultra.yaml", "")
+	assert.Equal(t, alt, FindProjectConfig(subdir))
+
+	preferred := writeConfigLayer(t, repo, ".ccmgr.yaml", "")
+	assert.Equal(t, preferred, FindProjectConfig(subdir))
+	assert.Equal(t, preferred, FindProjectConfig(repo))
+
+	// Config files below the repository root are not project configs
+	writeConfigLayer(t, subdir, ".ccmgr.yaml", "")
+	assert.Equal(t, preferred, FindProjectConfig(subdir))
+}
+
+func TestLoadLayered(t *testing.T) {
+	dir := t.TempDir()
+	global := writeConfigLayer(t, dir, "config.yaml", `version: "2.0.0"
+worktree:
+  default_branch: global
+  directory_pattern: "global-{{.Branch}}"
+git:
+  directory_pattern: "{{.Project}}-{{.Branch}}"
+  max_worktrees: 7
+`)
+	project := writeConfigLayer(t, dir, ".ccmgr.yaml", `git:
+  directory_pattern: "../{{.Project}}-wt/{{.Branch}}"
+worktree:
+  default_branch: project
+`)
+	override := writeConfigLayer(t, dir, "override.yaml", `worktree:
+  default_branch: override
+`)
+
+	t.Run("project config overrides git.directory_pattern", func(t *testing.T) {
+		config, err := LoadLayered(global, project, "")
+		require.NoError(t, err)
+		assert.Equal(t, "../{{.Project}}-wt/{{.Branch}}", config.Git.DirectoryPattern)
+		assert.Equal(t, "project", config.Worktree.DefaultBranch)
+		assert.Equal(t, 7, config.Git.MaxWorktrees, "unset keys keep the global value")
+		assert.Equal(t, "global-{{.Branch}}", config.Worktree.DirectoryPattern)
+	})
+
+	t.Run("--config overrides project config", func(t *testing.T) {
+		config, err := LoadLayered(global, project, override)
+		require.NoError(t, err)
+		assert.Equal(t, "override", config.Worktree.DefaultBranch)
+		assert.Equal(t, "../{{.Project}}-wt/{{.Branch}}", config.Git.DirectoryPattern)
+	})
+
+	t.Run("environment overrides every file", func(t *testing.T) {
+		t.Setenv("CCMGR_WORKTREE_DEFAULT_BRANCH", "env")
+		config, err := LoadLayered(global, project, override)
+		require.NoError(t, err)
+		assert.Equal(t, "env", config.Worktree.DefaultBranch)
+	})
+
+	t.Run("merged result is validated", func(t *testing.T) {
+		broken := writeConfigLayer(t, t.TempDir(), ".ccmgr.yaml", "git:\n  max_worktrees: -1\n")
+		_, err := LoadLayered(global, broken, "")
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+
+		var loadErr *LoadError
+		require.True(t, errors.As(err, &loadErr))
+		assert.Equal(t, broken, loadErr.Path)
+	})
+
+	t.Run("unparsable project config", func(t *testing.T) {
+		broken := writeConfigLayer(t, t.TempDir(), ".ccmgr.yaml", "git: [\n")
+		_, err := LoadLayered(global, broken, "")
+		assert.True(t, IsParseError(err))
+	})
+}
+
+func TestConfigReload(t *testing.T) {
+	dir := t.TempDir()
+	global := writeConfigLayer(t, dir, "config.yaml", `version: "2.0.0"
+log_level: info
+profiles:
+  work:
+    tmux:
+      session_prefix: job
+`)
+	override := writeConfigLayer(t, dir, "override.yaml", "worktree:\n  default_branch: override\n")
+
+	config, err := LoadLayered(global, "", override)
+	require.NoError(t, err)
+	require.NoError(t, config.ApplyProfile("work"))
+	assert.Equal(t, override, config.EditablePath())
+
+	writeConfigLayer(t, dir, "config.yaml", `version: "2.0.0"
+log_level: debug
+profiles:
+  work:
+    tmux:
+      session_prefix: job
+`)
+	t.Setenv("CCMGR_WORKTREE_AUTO_DIRECTORY", "false")
+
+	reloaded, err := config.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, "debug", reloaded.LogLevel, "files are read again")
+	assert.Equal(t, "override", reloaded.Worktree.DefaultBranch, "the --config file is merged again")
+	assert.Equal(t, "job", reloaded.Tmux.SessionPrefix, "the profile is overlaid again")
+	assert.False(t, reloaded.Worktree.AutoDirectory, "the environment is read again")
+
+	_, err = DefaultConfig().Reload()
+	assert.Error(t, err, "a config not loaded from files cannot be reloaded")
+}
+
+func TestConfigDir(t *testing.T) {
+	t.Cleanup(func() { SetConfigDir("") })
+
+	t.Run("XDG_CONFIG_HOME redirects default paths", func(t *testing.T) {
+		xdg := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", xdg)
+
+		dir := filepath.Join(xdg, ConfigDirName)
+		assert.Equal(t, dir, ConfigDir())
+		assert.Equal(t, filepath.Join(dir, ConfigFileName), GetGlobalConfigPath())
+
+		config := DefaultConfig()
+		assert.Equal(t, filepath.Join(dir, "hooks", "idle.sh"), config.StatusHooks.IdleHook.Script)
+		assert.Equal(t, filepath.Join(dir, "tmux-sessions.json"), config.Tmux.StateFile)
+	})
+
+	t.Run("SetConfigDir wins over XDG_CONFIG_HOME", func(t *testing.T) {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+		dir := t.TempDir()
+		SetConfigDir(dir)
+		defer SetConfigDir("")
+
+		assert.Equal(t, dir, ConfigDir())
+		assert.Equal(t, filepath.Join(dir, ConfigFileName), GetGlobalConfigPath())
+		assert.Equal(t, filepath.Join(dir, "hooks", "creation.sh"), DefaultConfig().WorktreeHooks.CreationHook.Script)
+	})
+
+	t.Run("defaults under the home directory use ~", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv("XDG_CONFIG_HOME", "")
+
+		assert.Equal(t, filepath.Join(home, ".config", ConfigDirName), ConfigDir())
+		assert.Equal(t, "~/.config/ccmgr-ultra/hooks/busy.sh", ConfigDirPath("hooks", "busy.sh"))
+	})
+}
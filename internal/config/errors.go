@@ -0,0 +1,113 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadErrorKind classifies why a configuration file could not be loaded
+type LoadErrorKind int
+
+const (
+	// LoadErrorNotFound means the config file does not exist
+	LoadErrorNotFound LoadErrorKind = iota
+	// LoadErrorRead means the config file exists but could not be read
+	LoadErrorRead
+	// LoadErrorParse means the config file is not valid YAML for the schema
+	LoadErrorParse
+	// LoadErrorValidation means the config file parsed but failed validation
+	LoadErrorValidation
+)
+
+// String returns a human-readable name for the error kind
+func (k LoadErrorKind) String() string {
+	switch k {
+	case LoadErrorNotFound:
+		return "not found"
+	case LoadErrorRead:
+		return "read error"
+	case LoadErrorParse:
+		return "parse error"
+	case LoadErrorValidation:
+		return "validation error"
+	default:
+		return "unknown"
+	}
+}
+
+// LoadError describes a failure to load a configuration file
+type LoadError struct {
+	Kind LoadErrorKind
+	Path string
+	Line int // 1-based line of a parse error, 0 when unknown
+	Err  error
+}
+
+// Error implements the error interface
+func (e *LoadError) Error() string {
+	switch e.Kind {
+	case LoadErrorNotFound, LoadErrorRead:
+		return fmt.Sprintf("failed to read config file %s: %v", e.Path, e.Err)
+	case LoadErrorParse:
+		if e.Line > 0 {
+			return fmt.Sprintf("failed to parse config file %s at line %d: %v", e.Path, e.Line, e.Err)
+		}
+		return fmt.Sprintf("failed to parse config file %s: %v", e.Path, e.Err)
+	case LoadErrorValidation:
+		return fmt.Sprintf("config validation failed for %s: %v", e.Path, e.Err)
+	default:
+		return fmt.Sprintf("failed to load config file %s: %v", e.Path, e.Err)
+	}
+}
+
+// Unwrap returns the underlying error
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFoundError reports whether err is a config file not found error
+func IsNotFoundError(err error) bool {
+	return loadErrorKind(err) == LoadErrorNotFound
+}
+
+// IsParseError reports whether err is a config file parse error
+func IsParseError(err error) bool {
+	return loadErrorKind(err) == LoadErrorParse
+}
+
+// IsValidationError reports whether err is a config validation error
+func IsValidationError(err error) bool {
+	return loadErrorKind(err) == LoadErrorValidation
+}
+
+func loadErrorKind(err error) LoadErrorKind {
+	var loadErr *LoadError
+	if errors.As(err, &loadErr) {
+		return loadErr.Kind
+	}
+	return -1
+}
+
+var yamlLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// newParseError wraps a YAML unmarshal error, extracting the line number
+// reported by the YAML library when available
+func newParseError(path string, err error) *LoadError {
+	loadErr := &LoadError{Kind: LoadErrorParse, Path: path, Err: err}
+
+	message := err.Error()
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) && len(typeErr.Errors) > 0 {
+		message = typeErr.Errors[0]
+	}
+
+	if matches := yamlLineRegex.FindStringSubmatch(message); len(matches) == 2 {
+		loadErr.Line, _ = strconv.Atoi(matches[1])
+	}
+
+	return loadErr
+}
@@ -0,0 +1,238 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnknownKeyError is returned when a dotted config key does not name a setting
+type UnknownKeyError struct {
+	Key         string
+	Suggestions []string // nearest valid keys, closest first
+}
+
+// Error implements the error interface
+func (e *UnknownKeyError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("unknown config key '%s'", e.Key)
+	}
+	return fmt.Sprintf("unknown config key '%s' (did you mean %s?)", e.Key, strings.Join(e.Suggestions, ", "))
+}
+
+// maxKeySuggestions limits how many similar keys an UnknownKeyError lists
+const maxKeySuggestions = 3
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Keys returns every settable dotted key, such as "git.default_remote", in
+// sorted order. Entries of map settings like shortcuts are not included.
+func (c *Config) Keys() []string {
+	var keys []string
+	collectKeys(reflect.TypeOf(*c), "", &keys)
+	sort.Strings(keys)
+	return keys
+}
+
+// GetValue returns the value of the setting named by a dotted key. A key may
+// also name a section, or an entry of a map setting such as "shortcuts.quit".
+func (c *Config) GetValue(key string) (interface{}, error) {
+	value, err := c.lookupKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return value.Interface(), nil
+}
+
+// SetValue parses value according to the type of the setting named by a
+// dotted key and stores it. Durations use Go duration syntax ("30s"), lists
+// are comma separated, and map entries are addressed as "section.map.entry".
+// The configuration is not validated; call Validate afterwards.
+func (c *Config) SetValue(key, value string) error {
+	parts := strings.Split(key, ".")
+	target := reflect.ValueOf(c).Elem()
+
+	for i, part := range parts {
+		if target.Kind() == reflect.Map {
+			if i != len(parts)-1 || target.Type().Elem().Kind() != reflect.String {
+				return c.unknownKey(key)
+			}
+			if target.IsNil() {
+				target.Set(reflect.MakeMap(target.Type()))
+			}
+			target.SetMapIndex(reflect.ValueOf(part), reflect.ValueOf(value))
+			return nil
+		}
+
+		field, ok := fieldByYAMLName(target, part)
+		if !ok {
+			return c.unknownKey(key)
+		}
+		target = field
+	}
+
+	return setFieldValue(target, key, value)
+}
+
+// lookupKey resolves a dotted key to its value
+func (c *Config) lookupKey(key string) (reflect.Value, error) {
+	target := reflect.ValueOf(c).Elem()
+
+	for _, part := range strings.Split(key, ".") {
+		if target.Kind() == reflect.Map {
+			if target.Type().Key().Kind() != reflect.String {
+				return reflect.Value{}, c.unknownKey(key)
+			}
+			entry := target.MapIndex(reflect.ValueOf(part))
+			if !entry.IsValid() {
+				return reflect.Value{}, fmt.Errorf("config key '%s' is not set", key)
+			}
+			target = entry
+			continue
+		}
+
+		field, ok := fieldByYAMLName(target, part)
+		if !ok {
+			return reflect.Value{}, c.unknownKey(key)
+		}
+		target = field
+	}
+
+	return target, nil
+}
+
+// unknownKey builds an UnknownKeyError with the keys closest to key
+func (c *Config) unknownKey(key string) error {
+	keys := c.Keys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return levenshtein(key, keys[i]) < levenshtein(key, keys[j])
+	})
+
+	if len(keys) > maxKeySuggestions {
+		keys = keys[:maxKeySuggestions]
+	}
+	return &UnknownKeyError{Key: key, Suggestions: keys}
+}
+
+// fieldByYAMLName returns the settable field of struct v whose yaml tag is name
+func fieldByYAMLName(v reflect.Value, name string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct || v.Type() == timeType {
+		return reflect.Value{}, false
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if yamlName(v.Type().Field(i)) == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// yamlName returns the yaml key of a struct field, or "" when it is not
+// serialized or cannot be set from the command line
+func yamlName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+	if name == "-" || name == "profiles" || name == "last_modified" {
+		return ""
+	}
+	return name
+}
+
+// collectKeys appends the dotted keys of the leaf settings of struct type t
+func collectKeys(t reflect.Type, prefix string, keys *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := yamlName(field)
+		if name == "" {
+			continue
+		}
+
+		key := prefix + name
+		if field.Type.Kind() == reflect.Struct && field.Type != timeType {
+			collectKeys(field.Type, key+".", keys)
+			continue
+		}
+		*keys = append(*keys, key)
+	}
+}
+
+// setFieldValue parses value into field according to the field's type
+func setFieldValue(field reflect.Value, key, value string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration for %s: %q (use a value like 30s or 5m)", key, value)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean for %s: %q (use true or false)", key, value)
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer for %s: %q", key, value)
+		}
+		field.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number for %s: %q", key, value)
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("config key '%s' cannot be set from the command line", key)
+		}
+		items := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	case reflect.Struct, reflect.Map:
+		return fmt.Errorf("config key '%s' is a section; set one of its keys instead", key)
+	default:
+		return fmt.Errorf("config key '%s' cannot be set from the command line", key)
+	}
+
+	return nil
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigGetValue(t *testing.T) {
+	config := DefaultConfig()
+
+	value, err := config.GetValue("git.default_remote")
+	require.NoError(t, err)
+	assert.Equal(t, "origin", value)
+
+	value, err = config.GetValue("tmux.monitor_interval")
+	require.NoError(t, err)
+	assert.Equal(t, config.Tmux.MonitorInterval, value)
+
+	value, err = config.GetValue("shortcuts.q")
+	require.NoError(t, err)
+	assert.Equal(t, config.Shortcuts["q"], value)
+
+	section, err := config.GetValue("worktree")
+	require.NoError(t, err)
+	assert.IsType(t, WorktreeConfig{}, section)
+}
+
+func TestConfigSetValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value string
+		check func(t *testing.T, c *Config)
+	}{
+		{
+			name:  "string",
+			key:   "git.default_remote",
+			value: "upstream",
+			check: func(t *testing.T, c *Config) { assert.Equal(t, "upstream", c.Git.DefaultRemote) },
+		},
+		{
+			name:  "nested duration",
+			key:   "analytics.collector.poll_interval",
+			value: "45s",
+			check: func(t *testing.T, c *Config) { assert.Equal(t, 45*time.Second, c.Analytics.Collector.PollInterval) },
+		},
+		{
+			name:  "boolean",
+			key:   "tui.mouse_support",
+			value: "false",
+			check: func(t *testing.T, c *Config) { assert.False(t, c.TUI.MouseSupport) },
+		},
+		{
+			name:  "integer",
+			key:   "git.max_worktrees",
+			value: "25",
+			check: func(t *testing.T, c *Config) { assert.Equal(t, 25, c.Git.MaxWorktrees) },
+		},
+		{
+			name:  "string slice",
+			key:   "git.protected_branches",
+			value: "main, release ,develop",
+			check: func(t *testing.T, c *Config) {
+				assert.Equal(t, []string{"main", "release", "develop"}, c.Git.ProtectedBranches)
+			},
+		},
+		{
+			name:  "map entry",
+			key:   "commands.environment.EDITOR",
+			value: "vim",
+			check: func(t *testing.T, c *Config) { assert.Equal(t, "vim", c.Commands.Environment["EDITOR"]) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			require.NoError(t, config.SetValue(tt.key, tt.value))
+			tt.check(t, config)
+			assert.NoError(t, config.Validate())
+		})
+	}
+}
+
+func TestConfigSetValue_Errors(t *testing.T) {
+	config := DefaultConfig()
+
+	err := config.SetValue("tmux.monitor_interval", "soon")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid duration")
+
+	err = config.SetValue("tui.mouse_support", "maybe")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid boolean")
+
+	err = config.SetValue("worktree", "x")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is a section")
+
+	err = config.SetValue("git.default_remot", "origin")
+	var unknown *UnknownKeyError
+	require.True(t, errors.As(err, &unknown))
+	assert.Equal(t, "git.default_remote", unknown.Suggestions[0])
+	assert.LessOrEqual(t, len(unknown.Suggestions), maxKeySuggestions)
+}
+
+func TestConfigKeys(t *testing.T) {
+	keys := DefaultConfig().Keys()
+
+	assert.Contains(t, keys, "git.default_remote")
+	assert.Contains(t, keys, "analytics.performance.max_query_time")
+	assert.Contains(t, keys, "shortcuts")
+	assert.NotContains(t, keys, "last_modified")
+	assert.NotContains(t, keys, "profiles")
+	assert.NotContains(t, keys, "git")
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -373,3 +374,101 @@ func migrateV100ToV200(oldConfig map[string]interface{}) (map[string]interface{}
 
 	return newConfig, nil
 }
+
+// CurrentConfigVersion is the configuration version written by this release
+const CurrentConfigVersion = "2.0.0"
+
+// configMigration is a migration step applied to a loaded configuration
+type configMigration struct {
+	version string
+	apply   func(c *Config)
+}
+
+// configMigrations lists the in-memory migration steps in version order
+var configMigrations = []configMigration{
+	{version: "2.0.0", apply: migrateTemplateVariables},
+}
+
+// Migrate upgrades a loaded configuration to CurrentConfigVersion by applying
+// every migration step newer than its Version. It reports whether the
+// configuration was changed. Versions that are not semantic versions are left
+// untouched; a missing version is treated as a pre-1.0.0 configuration.
+func Migrate(c *Config) (bool, error) {
+	if c == nil {
+		return false, fmt.Errorf("config is nil")
+	}
+
+	current := c.Version
+	if current == "" {
+		current = "0.9.0"
+	} else if !isSemanticVersion(current) {
+		return false, nil
+	}
+
+	if !NeedsMigration(current, CurrentConfigVersion) {
+		return false, nil
+	}
+
+	for _, step := range configMigrations {
+		if compareVersions(step.version, current) > 0 {
+			step.apply(c)
+		}
+	}
+
+	c.Version = CurrentConfigVersion
+	return true, nil
+}
+
+// isSemanticVersion reports whether version consists of dot-separated numbers
+func isSemanticVersion(version string) bool {
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return false
+	}
+	for _, part := range parts {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// templateActionPattern matches a single {{ ... }} template action
+var templateActionPattern = regexp.MustCompile(`\{\{[^}]*\}\}`)
+
+// templateFieldPattern matches a field reference such as .branch inside an action
+var templateFieldPattern = regexp.MustCompile(`\.([A-Za-z]+)\b`)
+
+// templateVariableNames maps lowercase template variable names to the
+// capitalized fields the pattern manager expects
+var templateVariableNames = map[string]string{
+	"project":   "Project",
+	"branch":    "Branch",
+	"worktree":  "Worktree",
+	"timestamp": "Timestamp",
+	"username":  "UserName",
+	"user":      "UserName",
+	"prefix":    "Prefix",
+	"suffix":    "Suffix",
+}
+
+// migrateTemplateVariables rewrites lowercase template variables such as
+// {{.project}} in directory patterns to their capitalized form
+func migrateTemplateVariables(c *Config) {
+	c.Worktree.DirectoryPattern = NormalizeTemplateVariables(c.Worktree.DirectoryPattern)
+	c.Worktree.BaseDirectory = NormalizeTemplateVariables(c.Worktree.BaseDirectory)
+	c.Git.DirectoryPattern = NormalizeTemplateVariables(c.Git.DirectoryPattern)
+}
+
+// NormalizeTemplateVariables capitalizes known template variables in pattern,
+// so {{.project}} and {{.Project}} resolve to the same field
+func NormalizeTemplateVariables(pattern string) string {
+	return templateActionPattern.ReplaceAllStringFunc(pattern, func(action string) string {
+		return templateFieldPattern.ReplaceAllStringFunc(action, func(field string) string {
+			if name, ok := templateVariableNames[strings.ToLower(field[1:])]; ok {
+				return "." + name
+			}
+			return field
+		})
+	})
+}
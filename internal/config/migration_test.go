@@ -341,3 +341,129 @@ func TestExportImportForMigration(t *testing.T) {
 		assert.Contains(t, err.Error(), "config validation failed")
 	})
 }
+
+func TestMigrate(t *testing.T) {
+	t.Run("capitalizes lowercase template variables", func(t *testing.T) {
+		config := &Config{
+			Version: "1.0.0",
+			Worktree: WorktreeConfig{
+				DirectoryPattern: "{{.project}}-{{ .branch | lower }}",
+				BaseDirectory:    "../.worktrees/{{.project}}",
+			},
+			Git: GitConfig{DirectoryPattern: "{{.user}}/{{.Branch}}"},
+		}
+
+		changed, err := Migrate(config)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, CurrentConfigVersion, config.Version)
+		assert.Equal(t, "{{.Project}}-{{ .Branch | lower }}", config.Worktree.DirectoryPattern)
+		assert.Equal(t, "../.worktrees/{{.Project}}", config.Worktree.BaseDirectory)
+		assert.Equal(t, "{{.UserName}}/{{.Branch}}", config.Git.DirectoryPattern)
+	})
+
+	t.Run("leaves current config unchanged", func(t *testing.T) {
+		config := &Config{Version: CurrentConfigVersion, Worktree: WorktreeConfig{DirectoryPattern: "{{.project}}"}}
+
+		changed, err := Migrate(config)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, "{{.project}}", config.Worktree.DirectoryPattern)
+	})
+
+	t.Run("leaves unrecognized versions unchanged", func(t *testing.T) {
+		config := &Config{Version: "custom"}
+
+		changed, err := Migrate(config)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, "custom", config.Version)
+	})
+
+	t.Run("nil config fails", func(t *testing.T) {
+		_, err := Migrate(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadFromPath_MigratesConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	fixture := `version: "1.0.0"
+worktree:
+  auto_directory: true
+  directory_pattern: "{{.project}}-{{.branch}}"
+  default_branch: main
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(fixture), 0600))
+
+	config, err := LoadFromPath(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, CurrentConfigVersion, config.Version)
+	assert.Equal(t, "{{.Project}}-{{.Branch}}", config.Worktree.DirectoryPattern)
+
+	// The migrated config is written back and the original is kept as a backup
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, CurrentConfigVersion, mustDetectVersion(t, data))
+	assert.Contains(t, string(data), "{{.Project}}-{{.Branch}}")
+
+	backups, err := filepath.Glob(configPath + ".backup.*")
+	require.NoError(t, err)
+	assert.NotEmpty(t, backups)
+}
+
+func mustDetectVersion(t *testing.T, data []byte) string {
+	version, err := DetectConfigVersion(data)
+	require.NoError(t, err)
+	return version
+}
+
+func TestNormalizeTemplateVariables(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"{{.project}}-{{.branch}}", "{{.Project}}-{{.Branch}}"},
+		{"{{.Project}}-{{.Branch}}", "{{.Project}}-{{.Branch}}"},
+		{"{{.project}}-{{.Branch}}", "{{.Project}}-{{.Branch}}"},
+		{"{{ .username | lower }}", "{{ .UserName | lower }}"},
+		{"{{.unknown}}", "{{.unknown}}"},
+		{"static.project", "static.project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeTemplateVariables(tt.pattern))
+		})
+	}
+}
+
+func TestReadConfigFile_GitSafetySettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected bool
+	}{
+		{name: "missing settings default to true", content: "version: \"2.0.0\"\n", expected: true},
+		{name: "explicit false is kept", content: "version: \"2.0.0\"\ngit:\n  require_clean_workdir: false\n  confirm_destructive: false\n  backup_on_delete: false\n", expected: false},
+		{name: "explicit false in an older config is kept", content: "version: \"1.0.0\"\ngit:\n  require_clean_workdir: false\n  confirm_destructive: false\n  backup_on_delete: false\n", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+
+			config, err := ReadConfigFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config.Git.RequireCleanWorkdir)
+			assert.Equal(t, tt.expected, config.Git.ConfirmDestructive)
+			assert.Equal(t, tt.expected, config.Git.BackupOnDelete)
+		})
+	}
+
+	assert.True(t, DefaultConfig().Git.RequireCleanWorkdir)
+	assert.True(t, DefaultConfig().Git.BackupOnDelete)
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileEnvVar names the environment variable that selects a config profile
+// when --profile is not given
+const ProfileEnvVar = "CCMGR_PROFILE"
+
+// ResolveProfileName returns the profile to apply: the explicit name if set,
+// otherwise the value of CCMGR_PROFILE
+func ResolveProfileName(explicit string) string {
+	if explicit != "" {
+		return explicit
+	}
+	return os.Getenv(ProfileEnvVar)
+}
+
+// ProfileNames returns the names of the configured profiles in sorted order
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ApplyProfile overlays the named profile on the configuration. Only the keys
+// present in the profile are changed. The merged result is validated.
+func (c *Config) ApplyProfile(name string) error {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		if len(c.Profiles) == 0 {
+			return fmt.Errorf("profile '%s' not found: no profiles are defined", name)
+		}
+		return fmt.Errorf("profile '%s' not found (available: %s)", name, strings.Join(c.ProfileNames(), ", "))
+	}
+
+	if _, nested := profile["profiles"]; nested {
+		return fmt.Errorf("profile '%s' cannot define nested profiles", name)
+	}
+
+	data, err := yaml.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("failed to encode profile '%s': %w", name, err)
+	}
+
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("failed to apply profile '%s': %w", name, err)
+	}
+
+	c.SetDefaults()
+	if err := c.Validate(); err != nil {
+		return fmt.Errorf("profile '%s' produces an invalid configuration: %w", name, err)
+	}
+
+	c.ActiveProfile = name
+	return nil
+}
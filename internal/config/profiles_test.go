@@ -0,0 +1,94 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesTestYAML = `version: "1.0.0"
+worktree:
+  directory_pattern: "{{.Project}}-{{.Branch}}"
+  default_branch: main
+tmux:
+  session_prefix: ccmgr
+profiles:
+  work:
+    worktree:
+      directory_pattern: "work-{{.Branch}}"
+    tmux:
+      session_prefix: job
+  personal:
+    worktree:
+      default_branch: trunk
+  broken:
+    tui:
+      default_screen: nowhere
+`
+
+func loadProfilesTestConfig(t *testing.T) *Config {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(profilesTestYAML), 0600))
+
+	cfg, err := LoadFromPath(path)
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestApplyProfile(t *testing.T) {
+	t.Run("overlays only profile keys", func(t *testing.T) {
+		cfg := loadProfilesTestConfig(t)
+
+		require.NoError(t, cfg.ApplyProfile("work"))
+		assert.Equal(t, "work", cfg.ActiveProfile)
+		assert.Equal(t, "work-{{.Branch}}", cfg.Worktree.DirectoryPattern)
+		assert.Equal(t, "job", cfg.Tmux.SessionPrefix)
+		assert.Equal(t, "main", cfg.Worktree.DefaultBranch)
+	})
+
+	t.Run("other profile leaves pattern untouched", func(t *testing.T) {
+		cfg := loadProfilesTestConfig(t)
+
+		require.NoError(t, cfg.ApplyProfile("personal"))
+		assert.Equal(t, "{{.Project}}-{{.Branch}}", cfg.Worktree.DirectoryPattern)
+		assert.Equal(t, "trunk", cfg.Worktree.DefaultBranch)
+	})
+
+	t.Run("unknown profile lists available profiles", func(t *testing.T) {
+		cfg := loadProfilesTestConfig(t)
+
+		err := cfg.ApplyProfile("missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "available: broken, personal, work")
+	})
+
+	t.Run("invalid merged result fails validation", func(t *testing.T) {
+		cfg := loadProfilesTestConfig(t)
+
+		err := cfg.ApplyProfile("broken")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid default screen: nowhere")
+		assert.Empty(t, cfg.ActiveProfile)
+	})
+
+	t.Run("no profiles defined", func(t *testing.T) {
+		cfg := DefaultConfig()
+
+		err := cfg.ApplyProfile("work")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no profiles are defined")
+	})
+}
+
+func TestResolveProfileName(t *testing.T) {
+	t.Setenv(ProfileEnvVar, "personal")
+
+	assert.Equal(t, "work", ResolveProfileName("work"))
+	assert.Equal(t, "personal", ResolveProfileName(""))
+
+	t.Setenv(ProfileEnvVar, "")
+	assert.Equal(t, "", ResolveProfileName(""))
+}
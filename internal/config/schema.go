@@ -3,8 +3,13 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/logging"
 )
 
 // Config represents the main configuration structure
@@ -22,8 +27,15 @@ type Config struct {
 	Commands      CommandsConfig      `yaml:"commands" json:"commands"`
 	LastModified  time.Time           `yaml:"last_modified" json:"last_modified"`
 
+	// Profiles holds named partial configurations that can be overlaid on the
+	// base configuration with --profile or CCMGR_PROFILE
+	Profiles map[string]map[string]interface{} `yaml:"profiles,omitempty" json:"profiles,omitempty"`
+
 	// Additional common config fields
-	ConfigFile      string `yaml:"-" json:"-"`
+	ConfigFile    string `yaml:"-" json:"-"`
+	ActiveProfile string `yaml:"-" json:"-"`
+	// layers are the files LoadLayered merged, so Reload can merge them again
+	layers          configLayers
 	LogLevel        string `yaml:"log_level" json:"log_level" default:"info"`
 	RefreshInterval int    `yaml:"refresh_interval" json:"refresh_interval" default:"5"`
 }
@@ -75,6 +87,10 @@ type WorktreeConfig struct {
 	// Default: "../.worktrees/{{.Project}}" (sibling directory pattern)
 	// Example: "/tmp/worktrees/{{.Project}}" or "../my-worktrees"
 	BaseDirectory string `yaml:"base_directory" json:"base_directory"`
+
+	// AutoSuffixOnCollision appends -2, -3, ... to a generated worktree path
+	// that already exists instead of failing
+	AutoSuffixOnCollision bool `yaml:"auto_suffix_on_collision" json:"auto_suffix_on_collision"`
 }
 
 // CommandsConfig defines command configuration
@@ -157,6 +173,16 @@ type GitConfig struct {
 	// GitHub-specific configuration (Phase 5.3)
 	GitHubPRTemplate      string `yaml:"github_pr_template" json:"github_pr_template"`
 	DefaultPRTargetBranch string `yaml:"default_pr_target_branch" json:"default_pr_target_branch" default:"main"`
+	// GitHubAPIURL is the GitHub API base URL, such as
+	// https://github.example.com/api/v3 for GitHub Enterprise. When empty it
+	// is derived from the origin remote's host.
+	GitHubAPIURL string `yaml:"github_api_url" json:"github_api_url"`
+
+	// APITimeout bounds each request to the GitHub and GitLab APIs
+	APITimeout time.Duration `yaml:"api_timeout" json:"api_timeout" default:"30s"`
+	// APIMaxAttempts is how many times a failed API request is tried before
+	// giving up, including the first attempt
+	APIMaxAttempts int `yaml:"api_max_attempts" json:"api_max_attempts" default:"3"`
 
 	// Safety settings
 	RequireCleanWorkdir bool `yaml:"require_clean_workdir" json:"require_clean_workdir" default:"true"`
@@ -167,9 +193,10 @@ type GitConfig struct {
 // TUIConfig defines TUI application configuration
 type TUIConfig struct {
 	// Display settings
-	Theme           string `yaml:"theme" json:"theme" default:"default"`
-	RefreshInterval int    `yaml:"refresh_interval" json:"refresh_interval" default:"5"` // seconds
-	MouseSupport    bool   `yaml:"mouse_support" json:"mouse_support" default:"true"`
+	Theme           string         `yaml:"theme" json:"theme" default:"default"`
+	ThemeColors     TUIThemeColors `yaml:"theme_colors,omitempty" json:"theme_colors,omitempty"` // overrides colors of Theme
+	RefreshInterval int            `yaml:"refresh_interval" json:"refresh_interval" default:"5"` // seconds
+	MouseSupport    bool           `yaml:"mouse_support" json:"mouse_support" default:"true"`
 
 	// Screen settings
 	DefaultScreen string `yaml:"default_screen" json:"default_screen" default:"dashboard"`
@@ -182,6 +209,21 @@ type TUIConfig struct {
 	DebugMode   bool `yaml:"debug_mode" json:"debug_mode" default:"false"`
 }
 
+// TUIThemeColors defines hex colors, such as "#646CFF", that replace the
+// matching colors of the selected theme. Empty fields keep the theme's color.
+type TUIThemeColors struct {
+	Primary    string `yaml:"primary,omitempty" json:"primary,omitempty"`
+	Secondary  string `yaml:"secondary,omitempty" json:"secondary,omitempty"`
+	Accent     string `yaml:"accent,omitempty" json:"accent,omitempty"`
+	Background string `yaml:"background,omitempty" json:"background,omitempty"`
+	Text       string `yaml:"text,omitempty" json:"text,omitempty"`
+	Muted      string `yaml:"muted,omitempty" json:"muted,omitempty"`
+	Success    string `yaml:"success,omitempty" json:"success,omitempty"`
+	Warning    string `yaml:"warning,omitempty" json:"warning,omitempty"`
+	Error      string `yaml:"error,omitempty" json:"error,omitempty"`
+	Info       string `yaml:"info,omitempty" json:"info,omitempty"`
+}
+
 // AnalyticsConfig defines analytics configuration
 type AnalyticsConfig struct {
 	Enabled     bool                       `yaml:"enabled" json:"enabled" default:"true"`
@@ -233,12 +275,37 @@ type AnalyticsPerformanceConfig struct {
 	EnableMonitoring bool          `yaml:"enable_monitoring" json:"enable_monitoring" default:"true"`
 }
 
+// directoryPatternValidator checks directory patterns beyond basic template
+// syntax. The git package registers it so config does not import git.
+var directoryPatternValidator func(pattern string) error
+
+// RegisterDirectoryPatternValidator sets the function used to validate
+// directory patterns during configuration validation
+func RegisterDirectoryPatternValidator(validate func(pattern string) error) {
+	directoryPatternValidator = validate
+}
+
+// validateDirectoryPattern runs the registered validator on the pattern at key
+func validateDirectoryPattern(key, pattern string) error {
+	if directoryPatternValidator == nil {
+		return nil
+	}
+	if err := directoryPatternValidator(pattern); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", key, pattern, err)
+	}
+	return nil
+}
+
 // Validate validates the entire configuration
 func (c *Config) Validate() error {
 	if c.Version == "" {
 		return errors.New("config version is required")
 	}
 
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		return fmt.Errorf("invalid log_level: %w", err)
+	}
+
 	if err := c.StatusHooks.Validate(); err != nil {
 		return fmt.Errorf("status hooks validation failed: %w", err)
 	}
@@ -288,6 +355,55 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// ValidationIssue is a validation failure in one section of the configuration
+type ValidationIssue struct {
+	Field string // config key of the failing section, such as "worktree"
+	Err   error
+}
+
+// ValidationIssues validates each configuration section independently and
+// returns every failure, where Validate stops at the first one
+func (c *Config) ValidationIssues() []ValidationIssue {
+	var issues []ValidationIssue
+	add := func(field string, err error) {
+		if err != nil {
+			issues = append(issues, ValidationIssue{Field: field, Err: err})
+		}
+	}
+
+	if c.Version == "" {
+		add("version", errors.New("config version is required"))
+	}
+	if _, err := logging.ParseLevel(c.LogLevel); err != nil {
+		add("log_level", err)
+	}
+
+	add("status_hooks", c.StatusHooks.Validate())
+	add("worktree_hooks", c.WorktreeHooks.Validate())
+	add("worktree", c.Worktree.Validate())
+	add("commands", c.Commands.Validate())
+	add("tmux", c.Tmux.Validate())
+	add("git", c.Git.Validate())
+	add("claude", c.Claude.Validate())
+	add("tui", c.TUI.Validate())
+	add("analytics", c.Analytics.Validate())
+
+	keys := make([]string, 0, len(c.Shortcuts))
+	for key := range c.Shortcuts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == "" {
+			add("shortcuts", errors.New("shortcut key cannot be empty"))
+		} else if c.Shortcuts[key] == "" {
+			add("shortcuts."+key, fmt.Errorf("shortcut action for key '%s' cannot be empty", key))
+		}
+	}
+
+	return issues
+}
+
 // Validate validates status hooks configuration
 func (s *StatusHooksConfig) Validate() error {
 	if err := s.IdleHook.Validate(); err != nil {
@@ -354,6 +470,9 @@ func (w *WorktreeConfig) Validate() error {
 		if !strings.Contains(w.DirectoryPattern, "{{") || !strings.Contains(w.DirectoryPattern, "}}") {
 			return errors.New("directory pattern must contain template variables like {{.Project}} or {{.Branch}}")
 		}
+		if err := validateDirectoryPattern("worktree.directory_pattern", w.DirectoryPattern); err != nil {
+			return err
+		}
 	}
 
 	// Validate base directory is not empty if auto directory is enabled
@@ -416,6 +535,9 @@ func (g *GitConfig) Validate() error {
 		if !strings.Contains(g.DirectoryPattern, "{{") || !strings.Contains(g.DirectoryPattern, "}}") {
 			return errors.New("directory pattern must contain template variables like {{.Project}} or {{.Branch}}")
 		}
+		if err := validateDirectoryPattern("git.directory_pattern", g.DirectoryPattern); err != nil {
+			return err
+		}
 	}
 
 	if g.MaxWorktrees < 0 {
@@ -426,6 +548,21 @@ func (g *GitConfig) Validate() error {
 		return errors.New("cleanup age cannot be negative")
 	}
 
+	if g.APITimeout <= 0 {
+		return errors.New("api timeout must be positive")
+	}
+
+	if g.GitHubAPIURL != "" {
+		parsed, err := url.Parse(g.GitHubAPIURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("github api url must be an http or https URL: %s", g.GitHubAPIURL)
+		}
+	}
+
+	if g.APIMaxAttempts < 1 {
+		return errors.New("api max attempts must be at least 1")
+	}
+
 	// Validate protected branches
 	for _, branch := range g.ProtectedBranches {
 		if branch == "" {
@@ -498,23 +635,46 @@ func (c *ClaudeConfig) Validate() error {
 		}
 	}
 
-	// Validate state patterns
-	for key, pattern := range c.StatePatterns {
+	// Validate state patterns. Keys are checked in order so the same error
+	// is reported for the same config.
+	keys := make([]string, 0, len(c.StatePatterns))
+	for key := range c.StatePatterns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		pattern := c.StatePatterns[key]
 		if key == "" {
 			return errors.New("state pattern key cannot be empty")
 		}
 		if pattern == "" {
 			return fmt.Errorf("state pattern for '%s' cannot be empty", key)
 		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid state pattern for '%s': %w", key, err)
+		}
+	}
+
+	// A custom set replaces the defaults, so it must cover every state
+	if len(c.StatePatterns) > 0 {
+		for _, key := range requiredStatePatterns {
+			if _, ok := c.StatePatterns[key]; !ok {
+				return fmt.Errorf("state pattern for '%s' is required when state_patterns is set", key)
+			}
+		}
 	}
 
 	return nil
 }
 
+// requiredStatePatterns are the state_patterns keys the Claude state monitor
+// matches against
+var requiredStatePatterns = []string{"busy", "idle", "waiting", "error"}
+
 // SetDefaults sets default values for missing configuration
 func (c *Config) SetDefaults() {
 	if c.Version == "" {
-		c.Version = "2.0.0"
+		c.Version = CurrentConfigVersion
 	}
 
 	// Set default hooks
@@ -567,7 +727,7 @@ func (w *WorktreeHooksConfig) SetDefaults() {
 func (h *HookConfig) SetDefaults(hookType string) {
 	h.Enabled = true // Enable hooks by default
 	if h.Script == "" {
-		h.Script = fmt.Sprintf("~/.config/ccmgr-ultra/hooks/%s.sh", hookType)
+		h.Script = ConfigDirPath("hooks", hookType+".sh")
 	}
 	if h.Timeout == 0 {
 		h.Timeout = 30
@@ -619,7 +779,7 @@ func (t *TmuxConfig) SetDefaults() {
 		t.MonitorInterval = 2 * time.Second
 	}
 	if t.StateFile == "" {
-		t.StateFile = "~/.config/ccmgr-ultra/tmux-sessions.json"
+		t.StateFile = ConfigDirPath("tmux-sessions.json")
 	}
 	if t.DefaultEnv == nil {
 		t.DefaultEnv = make(map[string]string)
@@ -629,6 +789,43 @@ func (t *TmuxConfig) SetDefaults() {
 	}
 }
 
+// DefaultPRTemplate is the built-in pull request template, used when the
+// repository has no template file of its own
+const DefaultPRTemplate = `## Summary
+Brief description of changes
+
+## Testing
+How the changes were tested`
+
+// DefaultGitHubPRTemplate is the built-in GitHub pull request template, used
+// when the repository has no template file of its own
+const DefaultGitHubPRTemplate = `## Summary
+Brief description of changes
+
+## Test plan
+- [ ] Manual testing completed
+- [ ] Unit tests pass
+- [ ] Integration tests pass
+
+## Checklist
+- [ ] Code follows project conventions
+- [ ] Documentation updated if needed`
+
+// DefaultAPITimeout is the default bound on each GitHub or GitLab API request
+const DefaultAPITimeout = 30 * time.Second
+
+// DefaultAPIMaxAttempts is the default number of tries for each GitHub or
+// GitLab API request
+const DefaultAPIMaxAttempts = 3
+
+// setSafetyDefaults turns on the safety settings that default to true. Being
+// booleans, SetDefaults cannot tell them apart from an explicit false.
+func (g *GitConfig) setSafetyDefaults() {
+	g.RequireCleanWorkdir = true
+	g.ConfirmDestructive = true
+	g.BackupOnDelete = true
+}
+
 // SetDefaults sets default values for git config
 func (g *GitConfig) SetDefaults() {
 	if g.DirectoryPattern == "" {
@@ -650,32 +847,25 @@ func (g *GitConfig) SetDefaults() {
 		g.ProtectedBranches = []string{"main", "master", "develop"}
 	}
 	if g.PRTemplate == "" {
-		g.PRTemplate = `## Summary
-Brief description of changes
-
-## Testing
-How the changes were tested`
+		g.PRTemplate = DefaultPRTemplate
 	}
 
 	// GitHub-specific defaults (Phase 5.3)
 	if g.GitHubPRTemplate == "" {
-		g.GitHubPRTemplate = `## Summary
-Brief description of changes
-
-## Test plan
-- [ ] Manual testing completed
-- [ ] Unit tests pass
-- [ ] Integration tests pass
-
-## Checklist
-- [ ] Code follows project conventions
-- [ ] Documentation updated if needed`
+		g.GitHubPRTemplate = DefaultGitHubPRTemplate
 	}
 
 	if g.DefaultPRTargetBranch == "" {
 		g.DefaultPRTargetBranch = "main"
 	}
 
+	if g.APITimeout == 0 {
+		g.APITimeout = DefaultAPITimeout
+	}
+	if g.APIMaxAttempts == 0 {
+		g.APIMaxAttempts = DefaultAPIMaxAttempts
+	}
+
 	// Boolean defaults are handled by Go's zero values and struct tags
 }
 
@@ -756,6 +946,39 @@ func (t *TUIConfig) Validate() error {
 		return fmt.Errorf("invalid default screen: %s", t.DefaultScreen)
 	}
 
+	if err := t.ThemeColors.Validate(); err != nil {
+		return fmt.Errorf("invalid theme colors: %w", err)
+	}
+
+	return nil
+}
+
+// hexColorPattern matches #RGB and #RRGGBB colors
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Validate checks that every set color is a hex color
+func (c *TUIThemeColors) Validate() error {
+	colors := []struct {
+		name  string
+		value string
+	}{
+		{"primary", c.Primary},
+		{"secondary", c.Secondary},
+		{"accent", c.Accent},
+		{"background", c.Background},
+		{"text", c.Text},
+		{"muted", c.Muted},
+		{"success", c.Success},
+		{"warning", c.Warning},
+		{"error", c.Error},
+		{"info", c.Info},
+	}
+
+	for _, color := range colors {
+		if color.value != "" && !hexColorPattern.MatchString(color.value) {
+			return fmt.Errorf("%s: %q is not a hex color such as #646CFF", color.name, color.value)
+		}
+	}
 	return nil
 }
 
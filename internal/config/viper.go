@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/fsnotify/fsnotify"
@@ -106,7 +107,10 @@ func (vm *ViperManager) GetMergedConfig() (*Config, error) {
 	merged := MergeConfigs(&globalConfig, projectConfig)
 
 	// Apply environment variable overrides
-	vm.applyEnvironmentOverrides(merged)
+	if err := vm.applyEnvironmentOverrides(merged); err != nil {
+		return nil, fmt.Errorf("invalid environment override: %w", err)
+	}
+	merged.ExpandPaths()
 
 	// Validate merged config
 	if err := merged.Validate(); err != nil {
@@ -146,15 +150,15 @@ func (vm *ViperManager) setDefaults(v *viper.Viper) {
 	// Status hooks
 	v.SetDefault("status_hooks.enabled", true)
 	v.SetDefault("status_hooks.idle.enabled", true)
-	v.SetDefault("status_hooks.idle.script", "~/.config/ccmgr-ultra/hooks/idle.sh")
+	v.SetDefault("status_hooks.idle.script", ConfigDirPath("hooks", "idle.sh"))
 	v.SetDefault("status_hooks.idle.timeout", 30)
 	v.SetDefault("status_hooks.idle.async", true)
 	v.SetDefault("status_hooks.busy.enabled", true)
-	v.SetDefault("status_hooks.busy.script", "~/.config/ccmgr-ultra/hooks/busy.sh")
+	v.SetDefault("status_hooks.busy.script", ConfigDirPath("hooks", "busy.sh"))
 	v.SetDefault("status_hooks.busy.timeout", 30)
 	v.SetDefault("status_hooks.busy.async", true)
 	v.SetDefault("status_hooks.waiting.enabled", true)
-	v.SetDefault("status_hooks.waiting.script", "~/.config/ccmgr-ultra/hooks/waiting.sh")
+	v.SetDefault("status_hooks.waiting.script", ConfigDirPath("hooks", "waiting.sh"))
 	v.SetDefault("status_hooks.waiting.timeout", 30)
 	v.SetDefault("status_hooks.waiting.async", true)
 
@@ -163,6 +167,7 @@ func (vm *ViperManager) setDefaults(v *viper.Viper) {
 	v.SetDefault("worktree.directory_pattern", "{{.Project}}-{{.Branch}}")
 	v.SetDefault("worktree.default_branch", "main")
 	v.SetDefault("worktree.cleanup_on_merge", false)
+	v.SetDefault("worktree.auto_suffix_on_collision", false)
 
 	// Commands
 	v.SetDefault("commands.claude_command", "claude")
@@ -215,39 +220,73 @@ func (vm *ViperManager) bindProjectEnvironment(v *viper.Viper) {
 }
 
 // applyEnvironmentOverrides applies environment variable overrides to config
-func (vm *ViperManager) applyEnvironmentOverrides(config *Config) {
-	// Check for specific environment variable overrides
-	// This ensures environment variables take precedence over all config files
+func (vm *ViperManager) applyEnvironmentOverrides(config *Config) error {
+	return ApplyEnvironmentOverrides(config)
+}
 
-	// Status hooks
-	if val := os.Getenv("CCMGR_STATUS_HOOKS_ENABLED"); val != "" {
-		config.StatusHooks.Enabled = val == "true"
-	}
+// EnvPrefix prefixes the environment variables overriding config keys
+const EnvPrefix = "CCMGR_"
 
-	// Worktree
-	if val := os.Getenv("CCMGR_WORKTREE_AUTO_DIRECTORY"); val != "" {
-		config.Worktree.AutoDirectory = val == "true"
-	}
-	if val := os.Getenv("CCMGR_WORKTREE_DIRECTORY_PATTERN"); val != "" {
-		config.Worktree.DirectoryPattern = val
-	}
-	if val := os.Getenv("CCMGR_WORKTREE_DEFAULT_BRANCH"); val != "" {
-		config.Worktree.DefaultBranch = val
-	}
-	if val := os.Getenv("CCMGR_WORKTREE_CLEANUP_ON_MERGE"); val != "" {
-		config.Worktree.CleanupOnMerge = val == "true"
-	}
+// EnvVarName returns the environment variable overriding a dotted config key,
+// for example CCMGR_GIT_DEFAULT_REMOTE for git.default_remote
+func EnvVarName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
 
-	// Commands
-	if val := os.Getenv("CCMGR_COMMANDS_CLAUDE_COMMAND"); val != "" {
-		config.Commands.ClaudeCommand = val
-	}
-	if val := os.Getenv("CCMGR_COMMANDS_GIT_COMMAND"); val != "" {
-		config.Commands.GitCommand = val
+// ApplyEnvironmentOverrides overrides every config key whose environment
+// variable, as named by EnvVarName, is set and not empty. Settings with an
+// explicit env tag, such as git.github_token, also read that variable when
+// their CCMGR_* variable is unset. Booleans are true only for "true", and
+// lists are comma separated. Environment variables take precedence over all
+// config files.
+func ApplyEnvironmentOverrides(config *Config) error {
+	for _, key := range config.Keys() {
+		val := os.Getenv(EnvVarName(key))
+		if val == "" {
+			if name := envTag(key); name != "" {
+				val = os.Getenv(name)
+			}
+		}
+		if val == "" {
+			continue
+		}
+
+		field, err := config.lookupKey(key)
+		if err != nil {
+			return err
+		}
+		if field.Kind() == reflect.Bool {
+			field.SetBool(val == "true")
+			continue
+		}
+		if err := setFieldValue(field, key, val); err != nil {
+			return fmt.Errorf("%s: %w", EnvVarName(key), err)
+		}
 	}
-	if val := os.Getenv("CCMGR_COMMANDS_TMUX_PREFIX"); val != "" {
-		config.Commands.TmuxPrefix = val
+
+	return nil
+}
+
+// envTag returns the explicit env tag of the setting named by a dotted key
+func envTag(key string) string {
+	t := reflect.TypeOf(Config{})
+	var field reflect.StructField
+
+	for _, part := range strings.Split(key, ".") {
+		found := false
+		for i := 0; i < t.NumField(); i++ {
+			if yamlName(t.Field(i)) == part {
+				field, found = t.Field(i), true
+				break
+			}
+		}
+		if !found {
+			return ""
+		}
+		t = field.Type
 	}
+
+	return field.Tag.Get("env")
 }
 
 // GetValue gets a specific configuration value
@@ -298,7 +337,7 @@ func InitViper() *viper.Viper {
 
 	v.SetConfigName("config")
 	v.SetConfigType("yaml")
-	v.AddConfigPath("$HOME/.config/ccmgr-ultra")
+	v.AddConfigPath(ConfigDir())
 	v.AddConfigPath(".")
 
 	// Set all defaults
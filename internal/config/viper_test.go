@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -227,3 +228,74 @@ func TestViperManagerGetSetValue(t *testing.T) {
 		assert.Equal(t, "project", value)
 	})
 }
+
+func TestEnvVarName(t *testing.T) {
+	assert.Equal(t, "CCMGR_GIT_DEFAULT_REMOTE", EnvVarName("git.default_remote"))
+	assert.Equal(t, "CCMGR_REFRESH_INTERVAL", EnvVarName("refresh_interval"))
+}
+
+func TestApplyEnvironmentOverrides(t *testing.T) {
+	t.Run("derived names override any key", func(t *testing.T) {
+		t.Setenv("CCMGR_GIT_DEFAULT_REMOTE", "upstream")
+		t.Setenv("CCMGR_TUI_THEME", "dark")
+		t.Setenv("CCMGR_REFRESH_INTERVAL", "12")
+		t.Setenv("CCMGR_CLAUDE_POLL_INTERVAL", "750ms")
+		t.Setenv("CCMGR_GIT_PROTECTED_BRANCHES", "main, release")
+
+		config := DefaultConfig()
+		require.NoError(t, ApplyEnvironmentOverrides(config))
+
+		assert.Equal(t, "upstream", config.Git.DefaultRemote)
+		assert.Equal(t, "dark", config.TUI.Theme)
+		assert.Equal(t, 12, config.RefreshInterval)
+		assert.Equal(t, 750*time.Millisecond, config.Claude.PollInterval)
+		assert.Equal(t, []string{"main", "release"}, config.Git.ProtectedBranches)
+	})
+
+	t.Run("env tags are honored for tokens", func(t *testing.T) {
+		t.Setenv("GITHUB_TOKEN", "gh-token")
+		t.Setenv("GITLAB_TOKEN", "gl-token")
+		t.Setenv("CCMGR_GIT_GITLAB_TOKEN", "ccmgr-gl-token")
+
+		config := DefaultConfig()
+		require.NoError(t, ApplyEnvironmentOverrides(config))
+
+		assert.Equal(t, "gh-token", config.Git.GitHubToken)
+		assert.Equal(t, "ccmgr-gl-token", config.Git.GitLabToken, "CCMGR_* variable wins over the env tag")
+	})
+
+	t.Run("invalid values are reported", func(t *testing.T) {
+		t.Setenv("CCMGR_TUI_REFRESH_INTERVAL", "often")
+
+		err := ApplyEnvironmentOverrides(DefaultConfig())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CCMGR_TUI_REFRESH_INTERVAL")
+	})
+}
+
+func TestLoadLayered_EnvironmentBeatsFiles(t *testing.T) {
+	dir := t.TempDir()
+	global := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(global, []byte(`version: "2.0.0"
+git:
+  default_remote: origin
+tui:
+  theme: light
+  refresh_interval: 3
+`), 0600))
+	override := filepath.Join(dir, "override.yaml")
+	require.NoError(t, os.WriteFile(override, []byte("tui:\n  theme: solarized\n"), 0600))
+
+	t.Setenv("CCMGR_GIT_DEFAULT_REMOTE", "fork")
+	t.Setenv("CCMGR_TUI_THEME", "dark")
+
+	config, err := LoadLayered(global, "", override)
+	require.NoError(t, err)
+	assert.Equal(t, "fork", config.Git.DefaultRemote)
+	assert.Equal(t, "dark", config.TUI.Theme)
+	assert.Equal(t, 3, config.TUI.RefreshInterval, "keys without a variable keep the file value")
+
+	t.Setenv("CCMGR_TUI_REFRESH_INTERVAL", "often")
+	_, err = LoadLayered(global, "", override)
+	assert.ErrorContains(t, err, "CCMGR_TUI_REFRESH_INTERVAL")
+}
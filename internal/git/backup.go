@@ -0,0 +1,391 @@
+package git
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+const (
+	// backupMetadataFile is the archive entry describing a worktree backup
+	backupMetadataFile = "backup.json"
+	// backupPatchFile is the archive entry holding tracked changes as a patch
+	backupPatchFile = "changes.patch"
+	// backupUntrackedDir is the archive directory holding untracked files
+	backupUntrackedDir = "untracked/"
+	// backupExtension is the file extension of worktree backups
+	backupExtension = ".tar.gz"
+)
+
+// WorktreeBackup describes an archive of the uncommitted changes of a
+// deleted worktree
+type WorktreeBackup struct {
+	ID           string    `json:"id"`
+	Branch       string    `json:"branch"`
+	Head         string    `json:"head"`
+	WorktreePath string    `json:"worktree_path"`
+	RepoPath     string    `json:"repo_path"`
+	CreatedAt    time.Time `json:"created_at"`
+	ArchivePath  string    `json:"-"`
+}
+
+// WorktreeBackupDir returns the directory holding archives of uncommitted
+// changes taken before worktrees are deleted
+func WorktreeBackupDir() string {
+	return filepath.Join(config.ConfigDir(), "backups", "worktrees")
+}
+
+// ListWorktreeBackups returns the available worktree backups, newest first.
+// Archives whose metadata cannot be read are skipped.
+func ListWorktreeBackups() ([]WorktreeBackup, error) {
+	entries, err := os.ReadDir(WorktreeBackupDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []WorktreeBackup{}, nil
+		}
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	backups := make([]WorktreeBackup, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), backupExtension) {
+			continue
+		}
+		backup, err := readWorktreeBackup(filepath.Join(WorktreeBackupDir(), entry.Name()))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, *backup)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
+
+// FindWorktreeBackup returns the backup with the given id
+func FindWorktreeBackup(id string) (*WorktreeBackup, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return nil, fmt.Errorf("invalid backup id: %q", id)
+	}
+
+	backup, err := readWorktreeBackup(filepath.Join(WorktreeBackupDir(), id+backupExtension))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("backup not found: %s", id)
+		}
+		return nil, err
+	}
+	return backup, nil
+}
+
+// readWorktreeBackup reads the metadata entry at the start of a backup archive
+func readWorktreeBackup(archivePath string) (*WorktreeBackup, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup %s: %w", archivePath, err)
+	}
+	tr := tar.NewReader(gz)
+
+	header, err := tr.Next()
+	if err != nil || header.Name != backupMetadataFile {
+		return nil, fmt.Errorf("backup %s has no metadata", archivePath)
+	}
+
+	var backup WorktreeBackup
+	if err := json.NewDecoder(tr).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("failed to parse backup metadata in %s: %w", archivePath, err)
+	}
+	backup.ArchivePath = archivePath
+	return &backup, nil
+}
+
+// backupWorktree archives the uncommitted changes of a worktree as a tar.gz
+// holding its metadata, changes.patch, the diff of tracked files against
+// HEAD, and the untracked files under untracked/. It returns the archive path.
+func (wm *WorktreeManager) backupWorktree(info *WorktreeInfo) (string, error) {
+	// The patch must be kept byte for byte: trimming it drops the trailing
+	// whitespace of blank context lines and corrupts binary hunks
+	patch, err := executeRaw(wm.gitCmd, info.Path, "diff", "HEAD", "--binary")
+	if err != nil {
+		return "", fmt.Errorf("failed to diff worktree: %w", err)
+	}
+
+	untracked, err := wm.gitCmd.Execute(info.Path, "ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return "", fmt.Errorf("failed to list untracked files: %w", err)
+	}
+
+	backupDir := WorktreeBackupDir()
+	if err := os.MkdirAll(backupDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	// Create timestamped backup name
+	createdAt := time.Now()
+	backup := WorktreeBackup{
+		ID:           fmt.Sprintf("%s-%s", filepath.Base(info.Path), createdAt.Format("20060102-150405")),
+		Branch:       info.Branch,
+		Head:         info.Head,
+		WorktreePath: info.Path,
+		RepoPath:     wm.repo.RootPath,
+		CreatedAt:    createdAt,
+	}
+	backup.ArchivePath = filepath.Join(backupDir, backup.ID+backupExtension)
+
+	if err := writeWorktreeBackup(&backup, patch, strings.Split(untracked, "\x00")); err != nil {
+		os.Remove(backup.ArchivePath)
+		return "", err
+	}
+
+	return backup.ArchivePath, nil
+}
+
+// writeWorktreeBackup writes the backup archive for backupWorktree
+func writeWorktreeBackup(backup *WorktreeBackup, patch string, untracked []string) error {
+	metadata, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode backup metadata: %w", err)
+	}
+
+	file, err := os.Create(backup.ArchivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+
+	if err := addBytesToTar(tw, backupMetadataFile, metadata); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	if err := addBytesToTar(tw, backupPatchFile, []byte(patch)); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	for _, name := range untracked {
+		if name == "" {
+			continue
+		}
+		if err := addFileToTar(tw, filepath.Join(backup.WorktreePath, name), backupUntrackedDir+filepath.ToSlash(name)); err != nil {
+			return fmt.Errorf("failed to back up %s: %w", name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return file.Close()
+}
+
+// addBytesToTar writes data into tw as a regular file called name
+func addBytesToTar(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// addFileToTar copies the regular file or symlink at path into tw as name
+func addFileToTar(tw *tar.Writer, path, name string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+
+	var link string
+	if info.Mode()&os.ModeSymlink != 0 {
+		if link, err = os.Readlink(path); err != nil {
+			return err
+		}
+	} else if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if link != "" {
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// RestoreWorktree recreates the worktree a backup was taken from at path,
+// or at its original path when path is empty, checking out its branch and
+// reapplying its uncommitted changes. The branch is recreated at the backed
+// up commit if it no longer exists. If the changes cannot be reapplied the
+// new worktree, and any branch created for it, are removed again. It returns
+// the restored worktree path.
+func (wm *WorktreeManager) RestoreWorktree(backup *WorktreeBackup, path string) (string, error) {
+	if path == "" {
+		path = backup.WorktreePath
+	}
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("path already exists: %s", path)
+	}
+
+	unlock, err := wm.lockRepository()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	var args []string
+	createdBranch := false
+	switch {
+	case backup.Branch == "":
+		args = []string{"worktree", "add", "--detach", path, backup.Head}
+	case wm.localBranchExists(backup.Branch):
+		args = []string{"worktree", "add", path, backup.Branch}
+	default:
+		args = []string{"worktree", "add", "-b", backup.Branch, path, backup.Head}
+		createdBranch = true
+	}
+	if _, err := wm.gitCmd.Execute(wm.repo.RootPath, args...); err != nil {
+		return "", fmt.Errorf("failed to create worktree: %w", err)
+	}
+
+	if err := wm.extractWorktreeBackup(backup, path); err != nil {
+		if rollbackErr := wm.removeRestoredWorktree(path, backup.Branch, createdBranch); rollbackErr != nil {
+			return path, fmt.Errorf("worktree created at %s but its changes were not applied (%v), and removing it failed: %w", path, err, rollbackErr)
+		}
+		return "", fmt.Errorf("changes could not be applied, so the restored worktree was removed: %w", err)
+	}
+
+	return path, nil
+}
+
+// removeRestoredWorktree undoes a failed restore by removing the worktree at
+// path, and the branch when the restore created it
+func (wm *WorktreeManager) removeRestoredWorktree(path, branch string, createdBranch bool) error {
+	if _, err := wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "remove", "--force", path); err != nil {
+		return err
+	}
+	if createdBranch {
+		if _, err := wm.gitCmd.Execute(wm.repo.RootPath, "branch", "-D", branch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// localBranchExists reports whether a local branch called name exists
+func (wm *WorktreeManager) localBranchExists(name string) bool {
+	_, err := wm.gitCmd.Execute(wm.repo.RootPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// extractWorktreeBackup applies the patch and writes the untracked files of
+// a backup into the worktree at path
+func (wm *WorktreeManager) extractWorktreeBackup(backup *WorktreeBackup, path string) error {
+	file, err := os.Open(backup.ArchivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case header.Name == backupPatchFile:
+			patch, err := io.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if len(patch) == 0 {
+				continue
+			}
+			if _, err := wm.gitCmd.ExecuteWithInput(path, string(patch), "apply"); err != nil {
+				return fmt.Errorf("failed to apply changes: %w", err)
+			}
+
+		case strings.HasPrefix(header.Name, backupUntrackedDir):
+			name := strings.TrimPrefix(header.Name, backupUntrackedDir)
+			if err := extractUntrackedFile(tr, header, path, name); err != nil {
+				return fmt.Errorf("failed to restore %s: %w", name, err)
+			}
+		}
+	}
+}
+
+// extractUntrackedFile writes a file or symlink entry of a backup to name
+// inside dir, refusing names that would escape it
+func extractUntrackedFile(r io.Reader, header *tar.Header, dir, name string) error {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("invalid path in backup")
+	}
+	target := filepath.Join(dir, clean)
+
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	if header.Typeflag == tar.TypeSymlink {
+		return os.Symlink(header.Linkname, target)
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_EXCL, header.FileInfo().Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,205 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBackupTestWorktree creates a real repository with a linked worktree on
+// branch feature holding a committed file, and returns a manager for it
+// along with the worktree path
+func newBackupTestWorktree(t *testing.T) (*WorktreeManager, string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	gitCmd := NewGitCmd()
+	root := t.TempDir()
+	run := func(dir string, args ...string) {
+		t.Helper()
+		_, err := gitCmd.Execute(dir, args...)
+		require.NoError(t, err)
+	}
+
+	run(root, "init", "-b", "main")
+	run(root, "config", "user.email", "test@example.com")
+	run(root, "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(root, "README.md"), []byte("# Test\n"), 0644))
+	run(root, "add", ".")
+	run(root, "commit", "-m", "Initial commit")
+
+	worktreePath := filepath.Join(t.TempDir(), "feature")
+	run(root, "worktree", "add", "-b", "feature", worktreePath)
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "feature.txt"), []byte("v1\n"), 0644))
+	run(worktreePath, "add", ".")
+	run(worktreePath, "commit", "-m", "Add feature")
+
+	repo, err := NewRepositoryManager(gitCmd).DetectRepository(root)
+	require.NoError(t, err)
+
+	cfg := createTestConfig()
+	cfg.Git.BackupOnDelete = true
+	return NewWorktreeManager(repo, cfg, gitCmd), worktreePath
+}
+
+func TestRestoreWorktree_RoundTrip(t *testing.T) {
+	wm, worktreePath := newBackupTestWorktree(t)
+
+	// Leave a modified tracked file and an untracked file behind
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "feature.txt"), []byte("v2\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(worktreePath, "notes"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "notes", "todo.txt"), []byte("draft\n"), 0644))
+
+	backupPath, err := wm.DeleteWorktreeWithBackup(worktreePath, true)
+	require.NoError(t, err)
+	require.NotEmpty(t, backupPath)
+	assert.NoDirExists(t, worktreePath)
+
+	backups, err := ListWorktreeBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "feature", backups[0].Branch)
+	assert.Equal(t, worktreePath, backups[0].WorktreePath)
+	assert.Equal(t, backupPath, backups[0].ArchivePath)
+
+	backup, err := FindWorktreeBackup(backups[0].ID)
+	require.NoError(t, err)
+
+	// Remove the branch too, so restore has to recreate it
+	_, err = wm.gitCmd.Execute(wm.repo.RootPath, "branch", "-D", "feature")
+	require.NoError(t, err)
+
+	restored, err := wm.RestoreWorktree(backup, "")
+	require.NoError(t, err)
+	assert.Equal(t, worktreePath, restored)
+
+	branch, err := wm.gitCmd.Execute(restored, "branch", "--show-current")
+	require.NoError(t, err)
+	assert.Equal(t, "feature", branch)
+
+	content, err := os.ReadFile(filepath.Join(restored, "feature.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2\n", string(content))
+
+	content, err = os.ReadFile(filepath.Join(restored, "notes", "todo.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "draft\n", string(content))
+}
+
+func TestRestoreWorktree_BinaryChange(t *testing.T) {
+	wm, worktreePath := newBackupTestWorktree(t)
+
+	binaryPath := filepath.Join(worktreePath, "logo.bin")
+	require.NoError(t, os.WriteFile(binaryPath, []byte{0x89, 'P', 'N', 'G', 0x00, 0x01, 0x02, '\n'}, 0644))
+	_, err := wm.gitCmd.Execute(worktreePath, "add", "logo.bin")
+	require.NoError(t, err)
+	_, err = wm.gitCmd.Execute(worktreePath, "commit", "-m", "Add logo")
+	require.NoError(t, err)
+
+	changed := []byte{0x89, 'P', 'N', 'G', 0x00, 0xff, 0xfe, 0x00, '\n', ' ', '\n'}
+	require.NoError(t, os.WriteFile(binaryPath, changed, 0644))
+
+	restored := backupAndRestore(t, wm, worktreePath)
+
+	content, err := os.ReadFile(filepath.Join(restored, "logo.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, changed, content)
+}
+
+func TestRestoreWorktree_TrailingBlankContext(t *testing.T) {
+	wm, worktreePath := newBackupTestWorktree(t)
+
+	// A hunk whose last context lines are blank
+	listPath := filepath.Join(worktreePath, "list.txt")
+	require.NoError(t, os.WriteFile(listPath, []byte("one\ntwo\nthree\n\n\n"), 0644))
+	_, err := wm.gitCmd.Execute(worktreePath, "add", "list.txt")
+	require.NoError(t, err)
+	_, err = wm.gitCmd.Execute(worktreePath, "commit", "-m", "Add list")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(listPath, []byte("one\ntwo\nchanged\n\n\n"), 0644))
+
+	restored := backupAndRestore(t, wm, worktreePath)
+
+	content, err := os.ReadFile(filepath.Join(restored, "list.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\ntwo\nchanged\n\n\n", string(content))
+}
+
+func TestRestoreWorktree_RemovesWorktreeWhenApplyFails(t *testing.T) {
+	wm, worktreePath := newBackupTestWorktree(t)
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "feature.txt"), []byte("v2\n"), 0644))
+
+	_, err := wm.DeleteWorktreeWithBackup(worktreePath, true)
+	require.NoError(t, err)
+	backups, err := ListWorktreeBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	// Move the branch on so the backed up patch no longer applies
+	_, err = wm.gitCmd.Execute(wm.repo.RootPath, "branch", "-f", "feature", "main")
+	require.NoError(t, err)
+
+	restored, err := wm.RestoreWorktree(&backups[0], "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "restored worktree was removed")
+	assert.Empty(t, restored)
+	assert.NoDirExists(t, worktreePath)
+
+	worktrees, err := wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "list", "--porcelain")
+	require.NoError(t, err)
+	assert.NotContains(t, worktrees, worktreePath)
+}
+
+// backupAndRestore deletes the worktree with a backup and restores it from
+// that backup, returning the restored path
+func backupAndRestore(t *testing.T, wm *WorktreeManager, worktreePath string) string {
+	t.Helper()
+
+	_, err := wm.DeleteWorktreeWithBackup(worktreePath, true)
+	require.NoError(t, err)
+	backups, err := ListWorktreeBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	restored, err := wm.RestoreWorktree(&backups[0], "")
+	require.NoError(t, err)
+	return restored
+}
+
+func TestRestoreWorktree_ExistingPath(t *testing.T) {
+	wm, worktreePath := newBackupTestWorktree(t)
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "scratch.txt"), []byte("x"), 0644))
+
+	_, err := wm.DeleteWorktreeWithBackup(worktreePath, true)
+	require.NoError(t, err)
+	backups, err := ListWorktreeBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	occupied := t.TempDir()
+	_, err = wm.RestoreWorktree(&backups[0], occupied)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+}
+
+func TestFindWorktreeBackup_NotFound(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	_, err := FindWorktreeBackup("missing-20240101-000000")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup not found")
+
+	_, err = FindWorktreeBackup("../escape")
+	assert.Error(t, err)
+
+	backups, err := ListWorktreeBackups()
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+}
@@ -0,0 +1,123 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrRepositoryLocked is returned when another ccmgr operation holds the repository lock
+var ErrRepositoryLocked = errors.New("another ccmgr operation is in progress")
+
+const (
+	// RepoLockFileName is the name of the lock file created in the git directory
+	RepoLockFileName = "ccmgr.lock"
+
+	// DefaultLockTimeout is how long a mutating operation waits for the repository lock
+	DefaultLockTimeout = 10 * time.Second
+
+	lockRetryInterval = 100 * time.Millisecond
+)
+
+// RepoLock is an advisory, repository-scoped lock backed by a lock file under
+// the shared git directory. It serializes ccmgr operations that modify git's
+// worktree administrative state.
+type RepoLock struct {
+	path string
+}
+
+// AcquireRepoLock acquires the lock in gitDir, retrying until timeout elapses
+func AcquireRepoLock(gitDir string, timeout time.Duration) (*RepoLock, error) {
+	lockPath := filepath.Join(gitDir, RepoLockFileName)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			fmt.Fprintf(file, "%d\n", os.Getpid())
+			file.Close()
+			return &RepoLock{path: lockPath}, nil
+		}
+
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to create lock file %s: %w", lockPath, err)
+		}
+
+		if time.Now().After(deadline) {
+			holder := "unknown process"
+			if data, readErr := os.ReadFile(lockPath); readErr == nil && strings.TrimSpace(string(data)) != "" {
+				holder = "pid " + strings.TrimSpace(string(data))
+			}
+			return nil, fmt.Errorf("%w (lock held by %s; remove %s if no other ccmgr process is running)",
+				ErrRepositoryLocked, holder, lockPath)
+		}
+
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// Path returns the lock file path
+func (l *RepoLock) Path() string {
+	return l.path
+}
+
+// Release releases the lock
+func (l *RepoLock) Release() error {
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove lock file %s: %w", l.path, err)
+	}
+	return nil
+}
+
+// resolveGitCommonDir returns the git directory shared by all worktrees of the
+// repository rooted at rootPath. Linked worktrees have a .git file pointing at
+// their private git directory, which in turn records the common directory.
+func resolveGitCommonDir(rootPath string) (string, error) {
+	gitDir, err := resolveWorktreeGitDir(rootPath)
+	if err != nil {
+		return "", err
+	}
+
+	if common, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir := strings.TrimSpace(string(common))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		return filepath.Clean(commonDir), nil
+	}
+
+	return gitDir, nil
+}
+
+// resolveWorktreeGitDir returns the git directory of the worktree rooted at
+// rootPath: the .git directory of a main worktree, or the private directory
+// under .git/worktrees that a linked worktree's .git file points at.
+func resolveWorktreeGitDir(rootPath string) (string, error) {
+	gitPath := filepath.Join(rootPath, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return gitPath, nil
+	}
+
+	data, err := os.ReadFile(gitPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", gitPath, err)
+	}
+
+	content := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(content, "gitdir:") {
+		return "", fmt.Errorf("invalid .git file: %s", gitPath)
+	}
+
+	gitDir := strings.TrimSpace(strings.TrimPrefix(content, "gitdir:"))
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(rootPath, gitDir)
+	}
+	return filepath.Clean(gitDir), nil
+}
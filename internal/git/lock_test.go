@@ -0,0 +1,113 @@
+package git
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLockTestRepo(t *testing.T) string {
+	rootPath := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(rootPath, ".git"), 0755))
+	return rootPath
+}
+
+func TestAcquireRepoLock(t *testing.T) {
+	gitDir := filepath.Join(createLockTestRepo(t), ".git")
+
+	lock, err := AcquireRepoLock(gitDir, time.Second)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(gitDir, RepoLockFileName))
+
+	// A second acquisition times out while the first holds the lock
+	_, err = AcquireRepoLock(gitDir, 50*time.Millisecond)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrRepositoryLocked))
+	assert.Contains(t, err.Error(), "another ccmgr operation is in progress")
+
+	require.NoError(t, lock.Release())
+	assert.NoFileExists(t, filepath.Join(gitDir, RepoLockFileName))
+
+	lock, err = AcquireRepoLock(gitDir, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, lock.Release())
+}
+
+func TestAcquireRepoLock_WaitsForRelease(t *testing.T) {
+	gitDir := filepath.Join(createLockTestRepo(t), ".git")
+
+	lock, err := AcquireRepoLock(gitDir, time.Second)
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		lock.Release()
+	}()
+
+	start := time.Now()
+	second, err := AcquireRepoLock(gitDir, 2*time.Second)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	require.NoError(t, second.Release())
+}
+
+func TestResolveGitCommonDir_LinkedWorktree(t *testing.T) {
+	mainRoot := createLockTestRepo(t)
+	privateDir := filepath.Join(mainRoot, ".git", "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(privateDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(privateDir, "commondir"), []byte("../..\n"), 0644))
+
+	worktreeRoot := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeRoot, ".git"), []byte("gitdir: "+privateDir+"\n"), 0644))
+
+	gitDir, err := resolveGitCommonDir(worktreeRoot)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(mainRoot, ".git"), gitDir)
+}
+
+func TestWorktreeManager_MutationBlockedByRepoLock(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("worktree prune", "")
+
+	wm := NewWorktreeManager(repo, createTestConfig(), mockGit)
+	wm.SetLockTimeout(50 * time.Millisecond)
+
+	lock, err := AcquireRepoLock(filepath.Join(repo.RootPath, ".git"), time.Second)
+	require.NoError(t, err)
+
+	err = wm.PruneWorktrees()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrRepositoryLocked))
+
+	err = wm.MoveWorktree("/test/worktrees/a", "/test/worktrees/b")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrRepositoryLocked))
+
+	require.NoError(t, lock.Release())
+
+	// Once released, the mutation proceeds and releases the lock afterwards
+	require.NoError(t, wm.PruneWorktrees())
+	assert.NoFileExists(t, filepath.Join(repo.RootPath, ".git", RepoLockFileName))
+}
+
+func TestWorktreeManager_ReadsDoNotTakeRepoLock(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+	wm := NewWorktreeManager(repo, createTestConfig(), NewMockGitCmd())
+	wm.SetLockTimeout(50 * time.Millisecond)
+
+	lock, err := AcquireRepoLock(filepath.Join(repo.RootPath, ".git"), time.Second)
+	require.NoError(t, err)
+	defer lock.Release()
+
+	_, err = wm.GetWorktreeInfo(filepath.Join(repo.RootPath, "missing"))
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrRepositoryLocked))
+}
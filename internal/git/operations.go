@@ -11,8 +11,9 @@ import (
 
 // GitOperations handles low-level git operations
 type GitOperations struct {
-	repo   *Repository
-	gitCmd GitInterface
+	repo    *Repository
+	gitCmd  GitInterface
+	workDir string
 }
 
 // BranchInfo represents a git branch
@@ -35,6 +36,28 @@ type MergeResult struct {
 	Message      string
 }
 
+// MergeStrategy selects how a source branch is integrated into a target branch
+type MergeStrategy string
+
+const (
+	// MergeStrategyMerge creates a merge commit (or fast-forwards)
+	MergeStrategyMerge MergeStrategy = "merge"
+	// MergeStrategySquash squashes the source changes into a single commit
+	MergeStrategySquash MergeStrategy = "squash"
+	// MergeStrategyRebase rebases the source onto the target and fast-forwards
+	MergeStrategyRebase MergeStrategy = "rebase"
+)
+
+// MergeOptions configures MergeBranchWithOptions
+type MergeOptions struct {
+	Strategy MergeStrategy // defaults to MergeStrategyMerge
+	Message  string        // commit message; not used by the rebase strategy
+	// SourceDir is where the source branch is checked out. The rebase
+	// strategy runs there, since git cannot check out a branch that is
+	// already checked out in another worktree.
+	SourceDir string
+}
+
 // StashInfo represents a git stash entry
 type StashInfo struct {
 	Index   int
@@ -64,6 +87,15 @@ func NewGitOperations(repo *Repository, gitCmd GitInterface) *GitOperations {
 	}
 }
 
+// NewGitOperationsInDir creates a GitOperations instance that runs git commands
+// in the given working directory (for example a linked worktree) instead of the
+// repository root
+func NewGitOperationsInDir(repo *Repository, gitCmd GitInterface, workDir string) *GitOperations {
+	ops := NewGitOperations(repo, gitCmd)
+	ops.workDir = workDir
+	return ops
+}
+
 // Branch Management Operations
 
 // CreateBranch creates a new branch from the specified source
@@ -82,7 +114,7 @@ func (ops *GitOperations) CreateBranch(name, source string) error {
 	}
 
 	// Create the branch
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "branch", name, source)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "branch", name, source)
 	if err != nil {
 		return fmt.Errorf("failed to create branch '%s' from '%s': %w", name, source, err)
 	}
@@ -115,7 +147,7 @@ func (ops *GitOperations) DeleteBranch(name string, force bool) error {
 	}
 	args = append(args, name)
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, args...)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), args...)
 	if err != nil {
 		return fmt.Errorf("failed to delete branch '%s': %w", name, err)
 	}
@@ -125,10 +157,46 @@ func (ops *GitOperations) DeleteBranch(name string, force bool) error {
 
 // BranchExists checks if a branch exists
 func (ops *GitOperations) BranchExists(name string) bool {
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "rev-parse", "--verify", name)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "rev-parse", "--verify", name)
+	return err == nil
+}
+
+// RemoteBranchExists checks if a remote-tracking branch such as
+// origin/feature exists locally
+func (ops *GitOperations) RemoteBranchExists(remoteBranch string) bool {
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "rev-parse", "--verify", "refs/remotes/"+remoteBranch)
 	return err == nil
 }
 
+// CreateTrackingBranch creates branch name tracking remoteBranch, such as
+// origin/feature, fetching all remotes first when remoteBranch is not known
+// locally
+func (ops *GitOperations) CreateTrackingBranch(name, remoteBranch string) error {
+	if name == "" {
+		return fmt.Errorf("branch name cannot be empty")
+	}
+
+	if ops.BranchExists(name) {
+		return fmt.Errorf("branch '%s' already exists", name)
+	}
+
+	if !ops.RemoteBranchExists(remoteBranch) {
+		if err := ops.FetchAll(); err != nil {
+			return err
+		}
+		if !ops.RemoteBranchExists(remoteBranch) {
+			return fmt.Errorf("remote branch '%s' not found after fetching", remoteBranch)
+		}
+	}
+
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "branch", "--track", name, remoteBranch)
+	if err != nil {
+		return fmt.Errorf("failed to create branch '%s' tracking '%s': %w", name, remoteBranch, err)
+	}
+
+	return nil
+}
+
 // GetBranchInfo gets detailed information about a branch
 func (ops *GitOperations) GetBranchInfo(branch string) (*BranchInfo, error) {
 	if branch == "" {
@@ -145,13 +213,13 @@ func (ops *GitOperations) GetBranchInfo(branch string) (*BranchInfo, error) {
 	}
 
 	// Get HEAD commit
-	head, err := ops.gitCmd.Execute(ops.repo.RootPath, "rev-parse", branch)
+	head, err := ops.gitCmd.Execute(ops.workingDir(), "rev-parse", branch)
 	if err == nil {
 		info.Head = head
 	}
 
 	// Get upstream information
-	upstream, err := ops.gitCmd.Execute(ops.repo.RootPath, "rev-parse", "--abbrev-ref", branch+"@{upstream}")
+	upstream, err := ops.gitCmd.Execute(ops.workingDir(), "rev-parse", "--abbrev-ref", branch+"@{upstream}")
 	if err == nil {
 		info.Upstream = upstream
 		parts := strings.Split(upstream, "/")
@@ -169,6 +237,56 @@ func (ops *GitOperations) GetBranchInfo(branch string) (*BranchInfo, error) {
 	return info, nil
 }
 
+// CurrentBranch returns the branch checked out in the working directory, or
+// an empty string when HEAD is detached or the branch cannot be determined
+func (ops *GitOperations) CurrentBranch() string {
+	return ops.currentBranch()
+}
+
+// RemoteDefaultBranch returns the default branch of origin as recorded by
+// refs/remotes/origin/HEAD
+func (ops *GitOperations) RemoteDefaultBranch() (string, error) {
+	output, err := ops.gitCmd.Execute(ops.workingDir(), "symbolic-ref", "refs/remotes/origin/HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to get remote default branch: %w", err)
+	}
+
+	branch := strings.TrimPrefix(strings.TrimSpace(output), "refs/remotes/origin/")
+	if branch == "" {
+		return "", fmt.Errorf("remote default branch is empty")
+	}
+	return branch, nil
+}
+
+// ResolveBaseBranch returns the branch to base new work on when none is
+// given: the current branch, then origin's default branch, then fallback.
+// Fresh clones and detached HEADs have no current branch.
+func (ops *GitOperations) ResolveBaseBranch(fallback string) string {
+	if branch := ops.currentBranch(); branch != "" {
+		return branch
+	}
+	if branch, err := ops.RemoteDefaultBranch(); err == nil {
+		return branch
+	}
+	return fallback
+}
+
+// LocalBranchNames returns the names of all local branches
+func (ops *GitOperations) LocalBranchNames() ([]string, error) {
+	output, err := ops.gitCmd.Execute(ops.workingDir(), "for-each-ref", "--format=%(refname:short)", "refs/heads/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	var branches []string
+	for _, line := range strings.Split(output, "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			branches = append(branches, name)
+		}
+	}
+	return branches, nil
+}
+
 // ListBranches lists all branches in the repository
 func (ops *GitOperations) ListBranches(includeRemote bool) ([]BranchInfo, error) {
 	args := []string{"branch"}
@@ -176,7 +294,7 @@ func (ops *GitOperations) ListBranches(includeRemote bool) ([]BranchInfo, error)
 		args = append(args, "-a")
 	}
 
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, args...)
+	output, err := ops.gitCmd.Execute(ops.workingDir(), args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list branches: %w", err)
 	}
@@ -230,10 +348,34 @@ func (ops *GitOperations) ListBranches(includeRemote bool) ([]BranchInfo, error)
 
 // MergeBranch merges the source branch into the target branch
 func (ops *GitOperations) MergeBranch(source, target string) (*MergeResult, error) {
+	return ops.MergeBranchWithOptions(source, target, MergeOptions{})
+}
+
+// MergeBranchWithStrategy merges the source branch into the target branch
+// using the named strategy: merge, squash or rebase
+func (ops *GitOperations) MergeBranchWithStrategy(source, target, strategy string) (*MergeResult, error) {
+	mergeStrategy, err := ParseMergeStrategy(strategy)
+	if err != nil {
+		return nil, err
+	}
+	return ops.MergeBranchWithOptions(source, target, MergeOptions{Strategy: mergeStrategy})
+}
+
+// MergeBranchWithOptions integrates the source branch into the target branch
+// using the strategy and message from opts
+func (ops *GitOperations) MergeBranchWithOptions(source, target string, opts MergeOptions) (*MergeResult, error) {
 	if source == "" || target == "" {
 		return nil, fmt.Errorf("source and target branches must be specified")
 	}
 
+	strategy := opts.Strategy
+	if strategy == "" {
+		strategy = MergeStrategyMerge
+	}
+	if _, err := ParseMergeStrategy(string(strategy)); err != nil {
+		return nil, err
+	}
+
 	// Check if branches exist
 	if !ops.BranchExists(source) {
 		return nil, fmt.Errorf("source branch '%s' does not exist", source)
@@ -242,15 +384,31 @@ func (ops *GitOperations) MergeBranch(source, target string) (*MergeResult, erro
 		return nil, fmt.Errorf("target branch '%s' does not exist", target)
 	}
 
+	// Rebase the source onto the target before checking out the target, so
+	// the source is not left checked out in the target's working directory
+	if strategy == MergeStrategyRebase {
+		if result, err := ops.rebaseSource(source, target, opts.SourceDir); err != nil {
+			return result, err
+		}
+	}
+
 	// Ensure we're on the target branch
-	if ops.repo.CurrentBranch != target {
+	if ops.currentBranch() != target {
 		if err := ops.CheckoutBranch(target); err != nil {
 			return nil, fmt.Errorf("failed to checkout target branch '%s': %w", target, err)
 		}
 	}
 
 	// Perform the merge
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, "merge", source)
+	output, err := ops.gitCmd.Execute(ops.workingDir(), mergeArgs(source, strategy, opts.Message)...)
+	if err == nil && strategy == MergeStrategySquash {
+		// A squash merge only stages the changes; record them as one commit
+		commitArgs := []string{"commit", "--no-edit"}
+		if opts.Message != "" {
+			commitArgs = []string{"commit", "-m", opts.Message}
+		}
+		output, err = ops.gitCmd.Execute(ops.workingDir(), commitArgs...)
+	}
 
 	result := &MergeResult{
 		Success: err == nil,
@@ -258,10 +416,7 @@ func (ops *GitOperations) MergeBranch(source, target string) (*MergeResult, erro
 
 	// Parse merge output
 	if err != nil {
-		// Check if it's a merge conflict
-		if strings.Contains(output, "CONFLICT") {
-			result.Conflicts = ops.parseConflicts(output)
-		}
+		result.Conflicts = ops.mergeConflicts(output, err)
 		return result, fmt.Errorf("merge failed: %w", err)
 	}
 
@@ -269,18 +424,67 @@ func (ops *GitOperations) MergeBranch(source, target string) (*MergeResult, erro
 	result.FilesChanged = ops.parseFilesChanged(output)
 
 	// Get the new commit hash
-	if hash, err := ops.gitCmd.Execute(ops.repo.RootPath, "rev-parse", "HEAD"); err == nil {
+	if hash, err := ops.gitCmd.Execute(ops.workingDir(), "rev-parse", "HEAD"); err == nil {
 		result.CommitHash = hash
 	}
 
 	// Extract merge message
-	if msg, err := ops.gitCmd.Execute(ops.repo.RootPath, "log", "-1", "--pretty=format:%s"); err == nil {
+	if msg, err := ops.gitCmd.Execute(ops.workingDir(), "log", "-1", "--pretty=format:%s"); err == nil {
 		result.Message = msg
 	}
 
 	return result, nil
 }
 
+// ParseMergeStrategy validates a merge strategy name
+func ParseMergeStrategy(name string) (MergeStrategy, error) {
+	switch strategy := MergeStrategy(name); strategy {
+	case MergeStrategyMerge, MergeStrategySquash, MergeStrategyRebase:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("invalid merge strategy '%s' (must be merge, squash, or rebase)", name)
+	}
+}
+
+// mergeArgs builds the git merge arguments for the strategy
+func mergeArgs(source string, strategy MergeStrategy, message string) []string {
+	switch strategy {
+	case MergeStrategySquash:
+		return []string{"merge", "--squash", source}
+	case MergeStrategyRebase:
+		// The source has been rebased onto the target, so only fast-forward
+		return []string{"merge", "--ff-only", source}
+	}
+
+	if message != "" {
+		return []string{"merge", "-m", message, source}
+	}
+	return []string{"merge", source}
+}
+
+// rebaseSource rebases the source branch onto the target. When sourceDir is
+// set the rebase runs there, where the source branch is checked out.
+func (ops *GitOperations) rebaseSource(source, target, sourceDir string) (*MergeResult, error) {
+	var output string
+	var err error
+	if sourceDir != "" {
+		output, err = ops.gitCmd.Execute(sourceDir, "rebase", target)
+	} else {
+		output, err = ops.gitCmd.Execute(ops.workingDir(), "rebase", target, source)
+	}
+	if err != nil {
+		result := &MergeResult{Conflicts: ops.mergeConflicts(output, err)}
+		return result, fmt.Errorf("rebase failed: %w", err)
+	}
+	return nil, nil
+}
+
+// mergeConflicts extracts the conflicted files of a failed merge or rebase.
+// GitCmd reports git's output through the error, so both are inspected.
+func (ops *GitOperations) mergeConflicts(output string, err error) []string {
+	return ops.parseConflicts(output + "\n" + err.Error())
+}
+
 // CheckoutBranch switches to the specified branch
 func (ops *GitOperations) CheckoutBranch(branch string) error {
 	if branch == "" {
@@ -291,13 +495,15 @@ func (ops *GitOperations) CheckoutBranch(branch string) error {
 		return fmt.Errorf("branch '%s' does not exist", branch)
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "checkout", branch)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "checkout", branch)
 	if err != nil {
 		return fmt.Errorf("failed to checkout branch '%s': %w", branch, err)
 	}
 
-	// Update current branch in repo
-	ops.repo.CurrentBranch = branch
+	// Update current branch in repo when operating on the main checkout
+	if ops.workDir == "" {
+		ops.repo.CurrentBranch = branch
+	}
 
 	return nil
 }
@@ -323,7 +529,7 @@ func (ops *GitOperations) PushBranch(branch, remote string, force bool) error {
 	}
 	args = append(args, remote, branch)
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, args...)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), args...)
 	if err != nil {
 		return fmt.Errorf("failed to push branch '%s' to '%s': %w", branch, remote, err)
 	}
@@ -344,7 +550,7 @@ func (ops *GitOperations) PushBranchWithUpstream(branch, remote string) error {
 		return fmt.Errorf("branch '%s' does not exist", branch)
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "push", "-u", remote, branch)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "push", "-u", remote, branch)
 	if err != nil {
 		return fmt.Errorf("failed to push branch '%s' with upstream to '%s': %w", branch, remote, err)
 	}
@@ -370,7 +576,7 @@ func (ops *GitOperations) PullBranch(branch, remote string) error {
 		}
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "pull", remote, branch)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "pull", remote, branch)
 	if err != nil {
 		return fmt.Errorf("failed to pull branch '%s' from '%s': %w", branch, remote, err)
 	}
@@ -380,7 +586,7 @@ func (ops *GitOperations) PullBranch(branch, remote string) error {
 
 // FetchAll fetches all remotes
 func (ops *GitOperations) FetchAll() error {
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "fetch", "--all")
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "fetch", "--all")
 	if err != nil {
 		return fmt.Errorf("failed to fetch all remotes: %w", err)
 	}
@@ -397,7 +603,7 @@ func (ops *GitOperations) StashChanges(message string) error {
 		args = append(args, "-m", message)
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, args...)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), args...)
 	if err != nil {
 		return fmt.Errorf("failed to stash changes: %w", err)
 	}
@@ -407,7 +613,7 @@ func (ops *GitOperations) StashChanges(message string) error {
 
 // PopStash applies and removes the most recent stash
 func (ops *GitOperations) PopStash() error {
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "stash", "pop")
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "stash", "pop")
 	if err != nil {
 		return fmt.Errorf("failed to pop stash: %w", err)
 	}
@@ -421,7 +627,7 @@ func (ops *GitOperations) ApplyStash(stashRef string) error {
 		stashRef = "stash@{0}"
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "stash", "apply", stashRef)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "stash", "apply", stashRef)
 	if err != nil {
 		return fmt.Errorf("failed to apply stash '%s': %w", stashRef, err)
 	}
@@ -431,7 +637,7 @@ func (ops *GitOperations) ApplyStash(stashRef string) error {
 
 // ListStashes lists all stashes
 func (ops *GitOperations) ListStashes() ([]StashInfo, error) {
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, "stash", "list", "--pretty=format:%gd|%gs|%gD|%at")
+	output, err := ops.gitCmd.Execute(ops.workingDir(), "stash", "list", "--pretty=format:%gd|%gs|%gD|%at")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list stashes: %w", err)
 	}
@@ -478,7 +684,7 @@ func (ops *GitOperations) DropStash(stashRef string) error {
 		stashRef = "stash@{0}"
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "stash", "drop", stashRef)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "stash", "drop", stashRef)
 	if err != nil {
 		return fmt.Errorf("failed to drop stash '%s': %w", stashRef, err)
 	}
@@ -497,13 +703,13 @@ func (ops *GitOperations) CreateCommit(message string, files []string) error {
 	// Add files if specified
 	if len(files) > 0 {
 		args := append([]string{"add"}, files...)
-		if _, err := ops.gitCmd.Execute(ops.repo.RootPath, args...); err != nil {
+		if _, err := ops.gitCmd.Execute(ops.workingDir(), args...); err != nil {
 			return fmt.Errorf("failed to add files: %w", err)
 		}
 	}
 
 	// Create commit
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "commit", "-m", message)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "commit", "-m", message)
 	if err != nil {
 		return fmt.Errorf("failed to create commit: %w", err)
 	}
@@ -525,7 +731,7 @@ func (ops *GitOperations) GetCommitHistory(branch string, limit int) ([]CommitIn
 		args = append(args, branch)
 	}
 
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, args...)
+	output, err := ops.gitCmd.Execute(ops.workingDir(), args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get commit history: %w", err)
 	}
@@ -586,7 +792,7 @@ func (ops *GitOperations) CreateTag(name, message string, commit string) error {
 		args = append(args, commit)
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, args...)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), args...)
 	if err != nil {
 		return fmt.Errorf("failed to create tag '%s': %w", name, err)
 	}
@@ -600,7 +806,7 @@ func (ops *GitOperations) DeleteTag(name string) error {
 		return fmt.Errorf("tag name cannot be empty")
 	}
 
-	_, err := ops.gitCmd.Execute(ops.repo.RootPath, "tag", "-d", name)
+	_, err := ops.gitCmd.Execute(ops.workingDir(), "tag", "-d", name)
 	if err != nil {
 		return fmt.Errorf("failed to delete tag '%s': %w", name, err)
 	}
@@ -610,7 +816,7 @@ func (ops *GitOperations) DeleteTag(name string) error {
 
 // ListTags lists all tags
 func (ops *GitOperations) ListTags() ([]TagInfo, error) {
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, "tag", "-l", "--format=%(refname:short)|%(objectname)|%(contents)|%(taggerdate:unix)|%(taggername)")
+	output, err := ops.gitCmd.Execute(ops.workingDir(), "tag", "-l", "--format=%(refname:short)|%(objectname)|%(contents)|%(taggerdate:unix)|%(taggername)")
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tags: %w", err)
 	}
@@ -660,7 +866,9 @@ func (ops *GitOperations) ListTags() ([]TagInfo, error) {
 
 // GetStatus gets the current repository status
 func (ops *GitOperations) GetStatus() (map[string]string, error) {
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, "status", "--porcelain")
+	// The leading space of an unstaged status code is significant, so the
+	// output must not be trimmed
+	output, err := executeRaw(ops.gitCmd, ops.workingDir(), "status", "--porcelain")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get status: %w", err)
 	}
@@ -692,9 +900,30 @@ func (ops *GitOperations) IsClean() (bool, error) {
 
 // Helper functions
 
+// workingDir returns the directory git commands are executed in
+func (ops *GitOperations) workingDir() string {
+	if ops.workDir != "" {
+		return ops.workDir
+	}
+	return ops.repo.RootPath
+}
+
+// currentBranch returns the branch checked out in the working directory
+func (ops *GitOperations) currentBranch() string {
+	if ops.workDir == "" {
+		return ops.repo.CurrentBranch
+	}
+
+	branch, err := ops.gitCmd.Execute(ops.workDir, "branch", "--show-current")
+	if err != nil {
+		return ""
+	}
+	return branch
+}
+
 // getAheadBehindCounts gets the ahead/behind counts between two branches
 func (ops *GitOperations) getAheadBehindCounts(local, remote string) (ahead, behind int, err error) {
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, "rev-list", "--left-right", "--count", local+"..."+remote)
+	output, err := ops.gitCmd.Execute(ops.workingDir(), "rev-list", "--left-right", "--count", local+"..."+remote)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -753,7 +982,7 @@ func (ops *GitOperations) parseStashIndex(stashRef string) int {
 
 // getCommitFiles gets the files changed in a specific commit
 func (ops *GitOperations) getCommitFiles(commitHash string) ([]string, error) {
-	output, err := ops.gitCmd.Execute(ops.repo.RootPath, "show", "--name-only", "--pretty=format:", commitHash)
+	output, err := ops.gitCmd.Execute(ops.workingDir(), "show", "--name-only", "--pretty=format:", commitHash)
 	if err != nil {
 		return nil, err
 	}
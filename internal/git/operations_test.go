@@ -2,6 +2,9 @@ package git
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -81,6 +84,113 @@ func TestCreateBranch_DefaultSource(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// fetchingGitCmd makes the refs in fetched resolvable once "fetch --all" runs
+type fetchingGitCmd struct {
+	*MockGitCmd
+	fetched []string
+}
+
+func (f *fetchingGitCmd) Execute(dir string, args ...string) (string, error) {
+	if strings.Join(args, " ") == "fetch --all" {
+		for _, ref := range f.fetched {
+			f.SetCommand("rev-parse --verify "+ref, "abc123def")
+		}
+	}
+	return f.MockGitCmd.Execute(dir, args...)
+}
+
+func TestCreateTrackingBranch_FetchesMissingRemoteBranch(t *testing.T) {
+	mockGit := &fetchingGitCmd{MockGitCmd: NewMockGitCmd(), fetched: []string{"refs/remotes/origin/feature/login"}}
+	mockGit.SetError("rev-parse --verify login", fmt.Errorf("unknown revision"))
+	mockGit.SetCommand("fetch --all", "")
+	mockGit.SetCommand("branch --track login origin/feature/login", "")
+
+	ops := NewGitOperations(createTestRepository(), mockGit)
+
+	require.NoError(t, ops.CreateTrackingBranch("login", "origin/feature/login"))
+	assert.Equal(t, []string{
+		"rev-parse --verify login",
+		"rev-parse --verify refs/remotes/origin/feature/login",
+		"fetch --all",
+		"rev-parse --verify refs/remotes/origin/feature/login",
+		"branch --track login origin/feature/login",
+	}, mockGit.executed)
+}
+
+func TestCreateTrackingBranch_KnownRemoteBranch(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetError("rev-parse --verify login", fmt.Errorf("unknown revision"))
+	mockGit.SetCommand("rev-parse --verify refs/remotes/origin/feature/login", "abc123def")
+	mockGit.SetCommand("branch --track login origin/feature/login", "")
+
+	ops := NewGitOperations(createTestRepository(), mockGit)
+
+	require.NoError(t, ops.CreateTrackingBranch("login", "origin/feature/login"))
+	assert.False(t, mockGit.WasExecuted("fetch --all"), "a known remote branch is not fetched")
+}
+
+func TestCreateTrackingBranch_MissingAfterFetch(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetError("rev-parse --verify login", fmt.Errorf("unknown revision"))
+	mockGit.SetError("rev-parse --verify refs/remotes/origin/nope", fmt.Errorf("unknown revision"))
+	mockGit.SetCommand("fetch --all", "")
+
+	ops := NewGitOperations(createTestRepository(), mockGit)
+
+	err := ops.CreateTrackingBranch("login", "origin/nope")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "remote branch 'origin/nope' not found after fetching")
+	assert.True(t, mockGit.WasExecuted("fetch --all"))
+	assert.False(t, mockGit.WasExecuted("branch --track login origin/nope"))
+}
+
+func TestCreateTrackingBranch_AlreadyExists(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --verify login", "abc123def")
+
+	ops := NewGitOperations(createTestRepository(), mockGit)
+
+	err := ops.CreateTrackingBranch("login", "origin/feature/login")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+	assert.False(t, mockGit.WasExecuted("fetch --all"))
+}
+
+func TestResolveBaseBranch_CurrentBranch(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/develop")
+
+	repo := createTestRepository()
+	repo.CurrentBranch = "feature/current"
+	ops := NewGitOperations(repo, mockGit)
+
+	assert.Equal(t, "feature/current", ops.ResolveBaseBranch("trunk"))
+	assert.False(t, mockGit.WasExecuted("symbolic-ref refs/remotes/origin/HEAD"))
+}
+
+func TestResolveBaseBranch_RemoteDefaultBranch(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/release/2.x")
+
+	repo := createTestRepository()
+	repo.CurrentBranch = ""
+	ops := NewGitOperations(repo, mockGit)
+
+	assert.Equal(t, "release/2.x", ops.ResolveBaseBranch("trunk"))
+}
+
+func TestResolveBaseBranch_ConfigFallback(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetError("symbolic-ref refs/remotes/origin/HEAD", fmt.Errorf("ref refs/remotes/origin/HEAD is not a symbolic ref"))
+
+	repo := createTestRepository()
+	repo.CurrentBranch = ""
+	ops := NewGitOperations(repo, mockGit)
+
+	assert.Equal(t, "trunk", ops.ResolveBaseBranch("trunk"))
+	assert.True(t, mockGit.WasExecuted("symbolic-ref refs/remotes/origin/HEAD"))
+}
+
 func TestDeleteBranch_Success(t *testing.T) {
 	repo := createTestRepository()
 	repo.CurrentBranch = "main" // Ensure we're not on the branch to delete
@@ -323,6 +433,250 @@ func TestMergeBranch_Conflict(t *testing.T) {
 	assert.Contains(t, result.Conflicts, "file.txt")
 }
 
+// dirRecordingGitCmd records the directory each git command was executed in
+type dirRecordingGitCmd struct {
+	*MockGitCmd
+	dirs map[string]string
+}
+
+func (d *dirRecordingGitCmd) Execute(dir string, args ...string) (string, error) {
+	d.dirs[strings.Join(args, " ")] = dir
+	return d.MockGitCmd.Execute(dir, args...)
+}
+
+func TestMergeBranch_InWorktreeDir(t *testing.T) {
+	repo := createTestRepository()
+	repo.CurrentBranch = "main"
+	mockGit := &dirRecordingGitCmd{MockGitCmd: NewMockGitCmd(), dirs: make(map[string]string)}
+	targetPath := "/test/worktrees/feature-b"
+
+	mockGit.SetCommand("rev-parse --verify feature-a", "def456ghi")
+	mockGit.SetCommand("rev-parse --verify feature-b", "abc123def")
+	mockGit.SetCommand("branch --show-current", "feature-b")
+	mockGit.SetCommand("merge feature-a", "Merge made by the 'ort' strategy.\n 2 files changed, 4 insertions(+)")
+	mockGit.SetCommand("rev-parse HEAD", "newcommithash")
+	mockGit.SetCommand("log -1 --pretty=format:%s", "Merge branch 'feature-a' into feature-b")
+
+	ops := NewGitOperationsInDir(repo, mockGit, targetPath)
+
+	result, err := ops.MergeBranch("feature-a", "feature-b")
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, 2, result.FilesChanged)
+	assert.Equal(t, targetPath, mockGit.dirs["merge feature-a"])
+	assert.Equal(t, targetPath, mockGit.dirs["rev-parse HEAD"])
+	_, checkedOut := mockGit.dirs["checkout feature-b"]
+	assert.False(t, checkedOut, "target branch is already checked out in its worktree")
+	assert.Equal(t, "main", repo.CurrentBranch)
+}
+
+func TestMergeBranchWithOptions_Strategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     MergeOptions
+		commands map[string]string
+		expected []string
+	}{
+		{
+			name:     "merge with message",
+			opts:     MergeOptions{Strategy: MergeStrategyMerge, Message: "Merge auth work"},
+			commands: map[string]string{"merge -m Merge auth work feature": " 2 files changed"},
+			expected: []string{"merge -m Merge auth work feature"},
+		},
+		{
+			name: "squash commits staged changes",
+			opts: MergeOptions{Strategy: MergeStrategySquash},
+			commands: map[string]string{
+				"merge --squash feature": "Squash commit -- not updating HEAD",
+				"commit --no-edit":       "[main abc123] Squashed commit\n 3 files changed",
+			},
+			expected: []string{"merge --squash feature", "commit --no-edit"},
+		},
+		{
+			name: "squash with message",
+			opts: MergeOptions{Strategy: MergeStrategySquash, Message: "Add auth"},
+			commands: map[string]string{
+				"merge --squash feature": "Squash commit -- not updating HEAD",
+				"commit -m Add auth":     "[main abc123] Add auth\n 3 files changed",
+			},
+			expected: []string{"merge --squash feature", "commit -m Add auth"},
+		},
+		{
+			name: "rebase in source worktree then fast-forward",
+			opts: MergeOptions{Strategy: MergeStrategyRebase, SourceDir: "/test/worktrees/feature"},
+			commands: map[string]string{
+				"rebase main":             "Successfully rebased and updated refs/heads/feature.",
+				"merge --ff-only feature": "Fast-forward\n 1 file changed",
+			},
+			expected: []string{"rebase main", "merge --ff-only feature"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := createTestRepository()
+			repo.CurrentBranch = "main"
+			mockGit := &dirRecordingGitCmd{MockGitCmd: NewMockGitCmd(), dirs: make(map[string]string)}
+
+			mockGit.SetCommand("rev-parse --verify feature", "def456ghi")
+			mockGit.SetCommand("rev-parse --verify main", "abc123def")
+			mockGit.SetCommand("rev-parse HEAD", "newcommithash")
+			mockGit.SetCommand("log -1 --pretty=format:%s", "merged")
+			for args, output := range tt.commands {
+				mockGit.SetCommand(args, output)
+			}
+
+			ops := NewGitOperations(repo, mockGit)
+			result, err := ops.MergeBranchWithOptions("feature", "main", tt.opts)
+
+			require.NoError(t, err)
+			assert.True(t, result.Success)
+			assert.Equal(t, "newcommithash", result.CommitHash)
+			for _, args := range tt.expected {
+				assert.True(t, mockGit.WasExecuted(args), "expected %q to be executed", args)
+			}
+			if tt.opts.SourceDir != "" {
+				assert.Equal(t, tt.opts.SourceDir, mockGit.dirs["rebase main"])
+			}
+		})
+	}
+}
+
+func TestMergeBranchWithOptions_RebaseConflict(t *testing.T) {
+	repo := createTestRepository()
+	repo.CurrentBranch = "main"
+	mockGit := NewMockGitCmd()
+
+	mockGit.SetCommand("rev-parse --verify feature", "def456ghi")
+	mockGit.SetCommand("rev-parse --verify main", "abc123def")
+	mockGit.SetError("rebase main", fmt.Errorf("git command failed: CONFLICT (content): Merge conflict in auth.go\n: exit status 1"))
+
+	ops := NewGitOperations(repo, mockGit)
+	result, err := ops.MergeBranchWithOptions("feature", "main", MergeOptions{Strategy: MergeStrategyRebase, SourceDir: "/test/worktrees/feature"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rebase failed")
+	assert.Equal(t, []string{"auth.go"}, result.Conflicts)
+	assert.False(t, mockGit.WasExecuted("merge --ff-only feature"))
+}
+
+func TestMergeBranchWithStrategy(t *testing.T) {
+	conflictErr := fmt.Errorf("git command failed: Auto-merging auth.go\nCONFLICT (content): Merge conflict in auth.go\n: exit status 1")
+
+	tests := []struct {
+		name              string
+		strategy          string
+		commands          map[string]string
+		errors            map[string]error
+		expectError       string
+		expectedConflicts []string
+		expectedFiles     int
+	}{
+		{
+			name:          "merge",
+			strategy:      "merge",
+			commands:      map[string]string{"merge feature": "Merge made by the 'ort' strategy.\n 2 files changed"},
+			expectedFiles: 2,
+		},
+		{
+			name:     "squash",
+			strategy: "squash",
+			commands: map[string]string{
+				"merge --squash feature": "Squash commit -- not updating HEAD",
+				"commit --no-edit":       "[main abc123] Squashed commit\n 3 files changed",
+			},
+			expectedFiles: 3,
+		},
+		{
+			name:     "rebase",
+			strategy: "rebase",
+			commands: map[string]string{
+				"rebase main feature":     "Successfully rebased and updated refs/heads/feature.",
+				"merge --ff-only feature": "Fast-forward\n 1 file changed",
+			},
+			expectedFiles: 1,
+		},
+		{
+			name:              "merge conflict",
+			strategy:          "merge",
+			errors:            map[string]error{"merge feature": conflictErr},
+			expectError:       "merge failed",
+			expectedConflicts: []string{"auth.go"},
+		},
+		{
+			name:              "squash conflict",
+			strategy:          "squash",
+			errors:            map[string]error{"merge --squash feature": conflictErr},
+			expectError:       "merge failed",
+			expectedConflicts: []string{"auth.go"},
+		},
+		{
+			name:              "rebase conflict",
+			strategy:          "rebase",
+			errors:            map[string]error{"rebase main feature": conflictErr},
+			expectError:       "rebase failed",
+			expectedConflicts: []string{"auth.go"},
+		},
+		{
+			name:        "unknown strategy",
+			strategy:    "octopus",
+			expectError: "invalid merge strategy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := createTestRepository()
+			repo.CurrentBranch = "main"
+			mockGit := NewMockGitCmd()
+
+			mockGit.SetCommand("rev-parse --verify feature", "def456ghi")
+			mockGit.SetCommand("rev-parse --verify main", "abc123def")
+			mockGit.SetCommand("rev-parse HEAD", "newcommithash")
+			mockGit.SetCommand("log -1 --pretty=format:%s", "merged")
+			for args, output := range tt.commands {
+				mockGit.SetCommand(args, output)
+			}
+			for args, err := range tt.errors {
+				mockGit.SetError(args, err)
+			}
+
+			ops := NewGitOperations(repo, mockGit)
+			result, err := ops.MergeBranchWithStrategy("feature", "main", tt.strategy)
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				if tt.expectedConflicts != nil {
+					require.NotNil(t, result)
+					assert.False(t, result.Success)
+					assert.Equal(t, tt.expectedConflicts, result.Conflicts)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, result.Success)
+			assert.Equal(t, "newcommithash", result.CommitHash)
+			assert.Equal(t, tt.expectedFiles, result.FilesChanged)
+			assert.Empty(t, result.Conflicts)
+		})
+	}
+}
+
+func TestParseMergeStrategy(t *testing.T) {
+	for _, name := range []string{"merge", "squash", "rebase"} {
+		strategy, err := ParseMergeStrategy(name)
+		require.NoError(t, err)
+		assert.Equal(t, MergeStrategy(name), strategy)
+	}
+
+	_, err := ParseMergeStrategy("octopus")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid merge strategy")
+}
+
 func TestCheckoutBranch_Success(t *testing.T) {
 	repo := createTestRepository()
 	mockGit := NewMockGitCmd()
@@ -761,6 +1115,26 @@ A  file3.txt`
 	assert.Equal(t, "A ", status["file3.txt"])
 }
 
+func TestGetStatus_RealRepository(t *testing.T) {
+	wm, worktreePath := newBackupTestWorktree(t)
+
+	// An unstaged change listed first must keep its leading space
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "README.md"), []byte("# Changed\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "staged.txt"), []byte("new\n"), 0644))
+	_, err := wm.gitCmd.Execute(worktreePath, "add", "staged.txt")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "untracked.txt"), []byte("new\n"), 0644))
+
+	status, err := NewGitOperationsInDir(wm.repo, wm.gitCmd, worktreePath).GetStatus()
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"README.md":     " M",
+		"staged.txt":    "A ",
+		"untracked.txt": "??",
+	}, status)
+}
+
 func TestIsClean_Clean(t *testing.T) {
 	repo := createTestRepository()
 	mockGit := NewMockGitCmd()
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -29,6 +30,51 @@ type PatternContext struct {
 	Suffix    string `json:"suffix"`
 }
 
+// InsideRepositoryError reports a worktree path or base directory that was
+// rejected because it resolves to a location inside the repository
+type InsideRepositoryError struct {
+	Subject          string // "worktree path" or "base directory"
+	Path             string // the rejected path, as resolved
+	BaseDirectory    string // configured base_directory, empty for explicit paths
+	ResolvedBaseDir  string // absolute base directory the path was built from
+	DirectoryPattern string // directory pattern used to name the worktree
+	RepoRoot         string
+}
+
+// Error implements the error interface
+func (e *InsideRepositoryError) Error() string {
+	msg := fmt.Sprintf("%s cannot be inside repository: %s (repository root: %s", e.Subject, e.Path, e.RepoRoot)
+	if e.BaseDirectory != "" {
+		msg += fmt.Sprintf(", base_directory %q resolved to %s", e.BaseDirectory, e.ResolvedBaseDir)
+		if !filepath.IsAbs(e.BaseDirectory) {
+			msg += " relative to the current directory"
+		}
+	}
+	if e.DirectoryPattern != "" {
+		msg += fmt.Sprintf(", directory_pattern %q", e.DirectoryPattern)
+	}
+	return msg + ")"
+}
+
+// PatternErrorSuggestion returns advice for fixing the worktree configuration
+// that caused err: a base directory inside the repository or a directory
+// pattern that does not render. It returns "" for other errors.
+func PatternErrorSuggestion(err error) string {
+	var insideErr *InsideRepositoryError
+	if errors.As(err, &insideErr) {
+		return "Set worktree.base_directory to a sibling of the repository, for example: " +
+			"ccmgr-ultra config set worktree.base_directory '../.worktrees/{{.Project}}'"
+	}
+
+	msg := err.Error()
+	if strings.Contains(msg, "template") ||
+		strings.Contains(msg, "pattern") ||
+		strings.Contains(msg, "variable") {
+		return "Check your directory_pattern in config. Use Go template syntax like {{.Project}}-{{.Branch}}"
+	}
+	return ""
+}
+
 // DirectoryPattern represents a naming pattern configuration
 type DirectoryPattern struct {
 	Template   string
@@ -39,6 +85,13 @@ type DirectoryPattern struct {
 	Suffix     string
 }
 
+func init() {
+	// Let config validation catch patterns PatternManager would reject
+	config.RegisterDirectoryPatternValidator(func(pattern string) error {
+		return NewPatternManager(nil).ValidatePattern(pattern)
+	})
+}
+
 // NewPatternManager creates a new PatternManager
 func NewPatternManager(cfg *config.WorktreeConfig) *PatternManager {
 	if cfg == nil {
@@ -113,9 +166,9 @@ func (pm *PatternManager) ValidatePattern(pattern string) error {
 		"{{.Timestamp}}", "{{.UserName}}", "{{.Prefix}}", "{{.Suffix}}",
 	}
 
-	// Extract variables from pattern
+	// Extract variables from pattern, accepting lowercase variable names
 	varRegex := regexp.MustCompile(`\{\{\.[\w]+\}\}`)
-	foundVars := varRegex.FindAllString(pattern, -1)
+	foundVars := varRegex.FindAllString(config.NormalizeTemplateVariables(pattern), -1)
 
 	for _, foundVar := range foundVars {
 		valid := false
@@ -167,8 +220,21 @@ func (pm *PatternManager) SanitizePath(path string) string {
 	return sanitized
 }
 
-// GenerateWorktreePath generates a full worktree path based on configuration
+// GenerateWorktreePath generates a full worktree path based on configuration,
+// creating the base directory if it does not exist
 func (pm *PatternManager) GenerateWorktreePath(branch, project string) (string, error) {
+	return pm.generateWorktreePath(branch, project, true)
+}
+
+// GenerateWorktreePathDryRun resolves the same path as GenerateWorktreePath
+// without creating the base directory
+func (pm *PatternManager) GenerateWorktreePathDryRun(branch, project string) (string, error) {
+	return pm.generateWorktreePath(branch, project, false)
+}
+
+// generateWorktreePath implements GenerateWorktreePath, creating the base
+// directory only when createBase is set
+func (pm *PatternManager) generateWorktreePath(branch, project string, createBase bool) (string, error) {
 	context := PatternContext{
 		Project:   pm.sanitizeComponent(project),
 		Branch:    pm.sanitizeComponent(branch),
@@ -206,8 +272,10 @@ func (pm *PatternManager) GenerateWorktreePath(branch, project string) (string,
 	}
 
 	// Create base directory if it doesn't exist
-	if err := os.MkdirAll(fullBaseDir, 0755); err != nil {
-		return "", fmt.Errorf("failed to create base directory: %w", err)
+	if createBase {
+		if err := os.MkdirAll(fullBaseDir, 0755); err != nil {
+			return "", fmt.Errorf("failed to create base directory: %w", err)
+		}
 	}
 
 	// Create full path
@@ -219,6 +287,27 @@ func (pm *PatternManager) GenerateWorktreePath(branch, project string) (string,
 	return fullPath, nil
 }
 
+// ResolveBaseDirectory resolves the configured base directory for project to
+// the absolute directory generated worktree paths are placed in
+func (pm *PatternManager) ResolveBaseDirectory(project string) (string, error) {
+	context := PatternContext{
+		Project:  pm.sanitizeComponent(project),
+		UserName: pm.getUserName(),
+		Prefix:   pm.config.DefaultBranch,
+	}
+
+	baseDir, err := pm.ResolvePatternVariables(pm.config.BaseDirectory, context)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve base directory pattern: %w", err)
+	}
+
+	absBaseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve base directory: %w", err)
+	}
+	return absBaseDir, nil
+}
+
 // ResolvePatternVariables resolves template variables in a pattern
 func (pm *PatternManager) ResolvePatternVariables(template string, context PatternContext) (string, error) {
 	// Create a template
@@ -248,7 +337,7 @@ func createPatternTemplate(pattern string) (*template.Template, error) {
 		"truncate": truncateString,
 	}
 
-	return template.New("pattern").Funcs(funcMap).Parse(pattern)
+	return template.New("pattern").Funcs(funcMap).Parse(config.NormalizeTemplateVariables(pattern))
 }
 
 // sanitizeComponent sanitizes individual components like branch or project names
@@ -382,6 +471,30 @@ func (pm *PatternManager) CheckPathAvailable(path string) error {
 	return nil
 }
 
+// maxCollisionSuffix bounds the numeric suffixes NextAvailablePath tries
+const maxCollisionSuffix = 100
+
+// NextAvailablePath returns path if nothing exists there yet, otherwise the
+// first of path-2, path-3, ... that does not exist
+func (pm *PatternManager) NextAvailablePath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("path cannot be empty")
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return path, nil
+	}
+
+	for i := 2; i <= maxCollisionSuffix; i++ {
+		candidate := fmt.Sprintf("%s-%d", path, i)
+		if _, err := os.Stat(candidate); os.IsNotExist(err) {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("no free path found for %s after %d attempts", path, maxCollisionSuffix-1)
+}
+
 // CreateDirectory creates a directory with the appropriate permissions
 func (pm *PatternManager) CreateDirectory(path string) error {
 	if err := pm.CheckPathAvailable(path); err != nil {
@@ -526,7 +639,14 @@ func (pm *PatternManager) ValidateBaseDirectory(baseDir string, repoPath string)
 
 	// Check if base directory is inside repository
 	if strings.HasPrefix(resolvedBaseDir, resolvedRepoPath) {
-		return fmt.Errorf("base directory cannot be inside repository: %s", baseDir)
+		return &InsideRepositoryError{
+			Subject:          "base directory",
+			Path:             absBaseDir,
+			BaseDirectory:    baseDir,
+			ResolvedBaseDir:  absBaseDir,
+			DirectoryPattern: pm.config.DirectoryPattern,
+			RepoRoot:         absRepoPath,
+		}
 	}
 
 	return nil
@@ -566,6 +686,10 @@ func (pm *PatternManager) GenerateExamplePaths(pattern string) ([]string, error)
 
 	var results []string
 	for _, context := range examples {
+		// Sanitize as generateWorktreePath does, so examples match real paths
+		context.Project = pm.sanitizeComponent(context.Project)
+		context.Branch = pm.sanitizeComponent(context.Branch)
+
 		result, err := pm.ApplyPattern(pattern, context)
 		if err != nil {
 			return nil, fmt.Errorf("failed to apply pattern with context %+v: %w", context, err)
@@ -1,6 +1,8 @@
 package git
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -84,6 +86,21 @@ func TestValidatePattern(t *testing.T) {
 			pattern: "{{.Project | lower}}-{{.Branch | sanitize}}",
 			valid:   true,
 		},
+		{
+			name:    "Valid lowercase variables",
+			pattern: "{{.project}}-{{.branch}}",
+			valid:   true,
+		},
+		{
+			name:    "Valid mixed case variables",
+			pattern: "{{.project}}-{{.Branch}}-{{.timestamp}}",
+			valid:   true,
+		},
+		{
+			name:    "Unknown lowercase variable",
+			pattern: "{{.project}}-{{.bogus}}",
+			valid:   false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -303,6 +320,18 @@ func TestResolvePatternVariables(t *testing.T) {
 			expected: "main",
 			hasError: false,
 		},
+		{
+			name:     "Lowercase variables",
+			template: "{{.project}}-{{.branch}}",
+			expected: "test-project-main",
+			hasError: false,
+		},
+		{
+			name:     "Mixed case variables with functions",
+			template: "{{.project | upper}}-{{.Branch}}-{{.user}}",
+			expected: "TEST-PROJECT-main-test-user",
+			hasError: false,
+		},
 		{
 			name:     "Invalid syntax",
 			template: "{{.Project}-{{.Branch}}",
@@ -590,6 +619,44 @@ func TestCheckPathAvailable(t *testing.T) {
 	assert.Contains(t, err.Error(), "does not exist")
 }
 
+func TestNextAvailablePath(t *testing.T) {
+	pm := NewPatternManager(nil)
+	path := filepath.Join(t.TempDir(), "project-feature")
+
+	_, err := pm.NextAvailablePath("")
+	assert.Error(t, err)
+
+	next, err := pm.NextAvailablePath(path)
+	require.NoError(t, err)
+	assert.Equal(t, path, next, "a free path is used as is")
+
+	require.NoError(t, os.MkdirAll(path, 0755))
+	next, err = pm.NextAvailablePath(path)
+	require.NoError(t, err)
+	assert.Equal(t, path+"-2", next)
+
+	require.NoError(t, os.MkdirAll(path+"-2", 0755))
+	next, err = pm.NextAvailablePath(path)
+	require.NoError(t, err)
+	assert.Equal(t, path+"-3", next)
+}
+
+func TestResolveBaseDirectory(t *testing.T) {
+	pm := NewPatternManager(&config.WorktreeConfig{BaseDirectory: "/work/.worktrees/{{.Project}}"})
+
+	baseDir, err := pm.ResolveBaseDirectory("My App")
+	require.NoError(t, err)
+	assert.Equal(t, "/work/.worktrees/my-app", baseDir)
+
+	pm = NewPatternManager(&config.WorktreeConfig{BaseDirectory: "../.worktrees"})
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	baseDir, err = pm.ResolveBaseDirectory("app")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(filepath.Dir(cwd), ".worktrees"), baseDir)
+}
+
 func TestCreateDirectory(t *testing.T) {
 	pm := NewPatternManager(nil)
 
@@ -683,6 +750,7 @@ func TestGenerateExamplePaths(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Len(t, examples, 3) // Should have 3 examples
+	assert.Equal(t, "my-project-feature-user-auth", examples[0], "branch is sanitized as in real paths")
 
 	// Check that all examples are different
 	uniqueExamples := make(map[string]bool)
@@ -1088,3 +1156,70 @@ func TestGenerateWorktreePath_ErrorHandling(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to resolve base directory pattern")
 }
+
+func TestConfigValidate_RejectsInvalidDirectoryPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(cfg *config.Config)
+		wantKey string
+	}{
+		{
+			name:    "unknown worktree variable",
+			mutate:  func(cfg *config.Config) { cfg.Worktree.DirectoryPattern = "{{.Project}}-{{.Bogus}}" },
+			wantKey: "worktree.directory_pattern",
+		},
+		{
+			name:    "git pattern with path separator",
+			mutate:  func(cfg *config.Config) { cfg.Git.DirectoryPattern = "work/{{.Branch}}" },
+			wantKey: "git.directory_pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			tt.mutate(cfg)
+
+			err := cfg.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantKey)
+		})
+	}
+
+	t.Run("lowercase defaults are valid", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.Worktree.DirectoryPattern = "{{.project}}-{{.branch}}"
+		assert.NoError(t, cfg.Validate())
+	})
+}
+
+func TestGenerateWorktreePathDryRun_DoesNotCreateDirectory(t *testing.T) {
+	baseDir := filepath.Join(t.TempDir(), "worktrees")
+	pm := NewPatternManager(&config.WorktreeConfig{
+		BaseDirectory:    filepath.Join(baseDir, "{{.Project}}"),
+		DirectoryPattern: "{{.Branch}}",
+		DefaultBranch:    "main",
+	})
+
+	path, err := pm.GenerateWorktreePathDryRun("feature/auth", "my-project")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(baseDir, "my-project", "feature-auth"), path)
+
+	_, err = os.Stat(baseDir)
+	assert.True(t, os.IsNotExist(err), "dry run must not create the base directory")
+}
+
+func TestPatternErrorSuggestion(t *testing.T) {
+	inside := fmt.Errorf("invalid worktree path: %w", &InsideRepositoryError{
+		Subject:          "worktree path",
+		Path:             "/repo/wt",
+		RepoRoot:         "/repo",
+		DirectoryPattern: "{{.Branch}}",
+	})
+	assert.Contains(t, PatternErrorSuggestion(inside), "worktree.base_directory")
+
+	template := errors.New("failed to generate worktree path: template execution failed")
+	assert.Contains(t, PatternErrorSuggestion(template), "directory_pattern")
+
+	assert.Empty(t, PatternErrorSuggestion(errors.New("git worktree add failed: exit status 128")))
+}
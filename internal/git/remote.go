@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -45,6 +50,7 @@ type PullRequestRequest struct {
 	Draft        bool
 	Labels       []string
 	Assignees    []string
+	Reviewers    []string // user logins, or "org/team" for team reviewers
 }
 
 // PullRequest represents a created PR/MR
@@ -61,6 +67,9 @@ type PullRequest struct {
 	TargetBranch string
 	Draft        bool
 	Labels       []string
+	// Warnings lists follow-up steps that failed after the PR was created,
+	// such as adding labels or requesting reviewers
+	Warnings []string
 }
 
 // GitHub API response structures
@@ -102,12 +111,11 @@ type GitHubLabel struct {
 
 // GitHubClient implements HostingClient for GitHub
 type GitHubClient struct {
+	apiSettings
 	token  string
 	apiURL string
 }
 
-// Removed GitLab and Bitbucket clients per Phase 5.3 spec - focusing on GitHub only
-
 // GenericClient for repositories without PR/MR support
 type GenericClient struct{}
 
@@ -145,25 +153,14 @@ func (rm *RemoteManager) DetectHostingService(remoteURL string) (string, error)
 		return "", fmt.Errorf("remote URL cannot be empty")
 	}
 
-	// Parse the URL to extract the host
-	var host string
-
-	// Handle SSH URLs (git@host:owner/repo.git)
-	sshPattern := regexp.MustCompile(`^git@([^:]+):`)
-	if matches := sshPattern.FindStringSubmatch(remoteURL); len(matches) > 1 {
-		host = matches[1]
-	} else {
-		// Handle HTTPS URLs
-		if parsed, err := url.Parse(remoteURL); err == nil {
-			host = parsed.Host
-		} else {
-			return "", fmt.Errorf("failed to parse remote URL: %w", err)
-		}
+	host, err := remoteHost(remoteURL)
+	if err != nil {
+		return "", err
 	}
 
 	// Determine service based on host
 	switch {
-	case strings.Contains(host, "github.com"):
+	case rm.isGitHubHost(host):
 		return "github", nil
 	case strings.Contains(host, "gitlab.com"):
 		return "gitlab", nil
@@ -174,6 +171,51 @@ func (rm *RemoteManager) DetectHostingService(remoteURL string) (string, error)
 	}
 }
 
+// remoteHost returns the host name of a remote URL, without any port
+func remoteHost(remoteURL string) (string, error) {
+	// Handle SSH URLs (git@host:owner/repo.git)
+	sshPattern := regexp.MustCompile(`^git@([^:]+):`)
+	if matches := sshPattern.FindStringSubmatch(remoteURL); len(matches) > 1 {
+		return matches[1], nil
+	}
+
+	// Handle HTTPS URLs
+	parsed, err := url.Parse(remoteURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse remote URL: %w", err)
+	}
+	return parsed.Hostname(), nil
+}
+
+// isGitHubHost reports whether host serves GitHub: github.com, the host of
+// the configured GitHub API URL, or a GitHub Enterprise host named github.*
+func (rm *RemoteManager) isGitHubHost(host string) bool {
+	if strings.Contains(host, "github.com") || strings.HasPrefix(host, "github.") {
+		return true
+	}
+	if rm.config.GitHubAPIURL != "" {
+		if parsed, err := url.Parse(rm.config.GitHubAPIURL); err == nil {
+			return host != "" && parsed.Hostname() == host
+		}
+	}
+	return false
+}
+
+// githubAPIURL returns the GitHub API base URL: the configured one, or for a
+// GitHub Enterprise origin the /api/v3 endpoint on its host
+func (rm *RemoteManager) githubAPIURL() string {
+	if rm.config.GitHubAPIURL != "" {
+		return strings.TrimSuffix(rm.config.GitHubAPIURL, "/")
+	}
+
+	if rm.repo != nil && rm.repo.Origin != "" {
+		if host, err := remoteHost(rm.repo.Origin); err == nil && host != "" && !strings.Contains(host, "github.com") && rm.isGitHubHost(host) {
+			return fmt.Sprintf("https://%s/api/v3", host)
+		}
+	}
+	return defaultGitHubAPIURL
+}
+
 // CreatePullRequest creates a pull request for the specified worktree
 func (rm *RemoteManager) CreatePullRequest(worktree *WorktreeInfo, req PullRequestRequest) (*PullRequest, error) {
 	if worktree == nil {
@@ -205,23 +247,27 @@ func (rm *RemoteManager) CreatePullRequest(worktree *WorktreeInfo, req PullReque
 		req.TargetBranch = rm.repo.DefaultBranch
 	}
 	if req.Owner == "" || req.Repository == "" {
-		for _, remote := range rm.repo.Remotes {
-			if remote.Name == "origin" {
-				req.Owner = remote.Owner
-				req.Repository = remote.Repo
-				break
-			}
-		}
+		req.Owner, req.Repository = rm.originRepository()
 	}
 
 	// Apply PR template if no description provided
 	if req.Description == "" {
-		// Use GitHub-specific template if available for GitHub service
-		if service == "github" && rm.config.GitHubPRTemplate != "" {
-			req.Description = rm.config.GitHubPRTemplate
-		} else if rm.config.PRTemplate != "" {
-			req.Description = rm.config.PRTemplate
+		dir := worktree.Path
+		if dir == "" {
+			dir = rm.repo.RootPath
+		}
+		template, fromRepository := rm.pullRequestTemplate(service, dir)
+		description, err := rm.renderPullRequestTemplate(template, worktree, req)
+		var renderErr *pullRequestTemplateError
+		if fromRepository && errors.As(err, &renderErr) {
+			// Repository templates are written for the hosting service, not
+			// for ccmgr-ultra, and may contain a literal "{{"; use it as is
+			description, err = template, nil
+		}
+		if err != nil {
+			return nil, err
 		}
+		req.Description = description
 	}
 
 	// Create the pull request
@@ -248,8 +294,38 @@ func (rm *RemoteManager) CreatePullRequest(worktree *WorktreeInfo, req PullReque
 	return pr, nil
 }
 
+// ListPullRequests lists the pull requests of the origin repository
+func (rm *RemoteManager) ListPullRequests() ([]PullRequest, error) {
+	service, err := rm.DetectHostingService(rm.repo.Origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect hosting service: %w", err)
+	}
+
+	client, err := rm.GetHostingClient(service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hosting client: %w", err)
+	}
+
+	owner, repo := rm.originRepository()
+	return client.GetPullRequests(owner, repo)
+}
+
+// originRepository returns the owner and repository name of the origin remote
+func (rm *RemoteManager) originRepository() (string, string) {
+	for _, remote := range rm.repo.Remotes {
+		if remote.Name == "origin" {
+			return remote.Owner, remote.Repo
+		}
+	}
+	return "", ""
+}
+
 // PushAndCreatePR pushes a worktree branch and creates a PR in one operation
 func (rm *RemoteManager) PushAndCreatePR(worktree *WorktreeInfo, prOptions PullRequestRequest) (*PullRequest, error) {
+	if worktree.Detached {
+		return nil, fmt.Errorf("cannot push %s: %w", worktree.Path, ErrDetachedHead)
+	}
+
 	// Push the branch first
 	pushErr := rm.ensureBranchPushed(worktree.Branch)
 
@@ -274,6 +350,10 @@ func (rm *RemoteManager) PushAndCreatePR(worktree *WorktreeInfo, prOptions PullR
 
 // PushBranch pushes a branch to remote without creating a PR
 func (rm *RemoteManager) PushBranch(branch string) error {
+	if branch == "" {
+		return fmt.Errorf("no branch to push: %w", ErrDetachedHead)
+	}
+
 	err := rm.ensureBranchPushed(branch)
 
 	// Emit analytics event for push operation
@@ -306,13 +386,15 @@ func (rm *RemoteManager) ValidateAuthentication(service string) error {
 		return err
 	}
 
-	// Get the appropriate token - currently only GitHub is supported
+	// Get the appropriate token for the service
 	var token string
 	switch service {
 	case "github":
 		token = rm.config.GitHubToken
+	case "gitlab":
+		token = rm.config.GitLabToken
 	default:
-		return fmt.Errorf("authentication not supported for service: %s (only GitHub is currently supported)", service)
+		return fmt.Errorf("authentication not supported for service: %s (only GitHub and GitLab are currently supported)", service)
 	}
 
 	if token == "" {
@@ -322,14 +404,38 @@ func (rm *RemoteManager) ValidateAuthentication(service string) error {
 	return client.AuthenticateToken(token)
 }
 
+// ValidatePullRequestScopes checks that the configured token for the service
+// has the scopes needed to create pull requests
+func (rm *RemoteManager) ValidatePullRequestScopes(service string) error {
+	client, err := rm.GetHostingClient(service)
+	if err != nil {
+		return err
+	}
+
+	githubClient, ok := client.(*GitHubClient)
+	if !ok {
+		return fmt.Errorf("scope checks not supported for service: %s (only GitHub is currently supported)", service)
+	}
+
+	return githubClient.CheckPullRequestScopes()
+}
+
 // initializeClients sets up hosting service clients
 func (rm *RemoteManager) initializeClients() {
 	// GitHub client - primary focus for Phase 5.3
 	if rm.config.GitHubToken != "" {
-		rm.clients["github"] = &GitHubClient{
-			token:  rm.config.GitHubToken,
-			apiURL: "https://api.github.com",
-		}
+		client := NewGitHubClient(rm.config.GitHubToken)
+		client.apiURL = rm.githubAPIURL()
+		client.SetTimeout(rm.config.APITimeout)
+		client.SetMaxAttempts(rm.config.APIMaxAttempts)
+		rm.clients["github"] = client
+	}
+
+	if rm.config.GitLabToken != "" {
+		client := NewGitLabClient(rm.config.GitLabToken)
+		client.SetTimeout(rm.config.APITimeout)
+		client.SetMaxAttempts(rm.config.APIMaxAttempts)
+		rm.clients["gitlab"] = client
 	}
 
 	// Generic client (always available for non-GitHub repos)
@@ -360,11 +466,15 @@ func (rm *RemoteManager) ensureBranchPushed(branch string) error {
 
 // GitHub Client Implementation
 
+// defaultGitHubAPIURL is the API base URL for github.com
+const defaultGitHubAPIURL = "https://api.github.com"
+
 // NewGitHubClient creates a new GitHub client
 func NewGitHubClient(token string) *GitHubClient {
 	return &GitHubClient{
-		token:  token,
-		apiURL: "https://api.github.com",
+		apiSettings: defaultAPISettings(),
+		token:       token,
+		apiURL:      defaultGitHubAPIURL,
 	}
 }
 
@@ -395,7 +505,7 @@ func (gc *GitHubClient) CreatePullRequest(req PullRequestRequest) (*PullRequest,
 
 	// Create HTTP request
 	headers := buildAuthHeaders("github", gc.token)
-	resp, err := makeHTTPRequest("POST", apiURL, headers, payloadBytes)
+	resp, err := gc.request("POST", apiURL, headers, payloadBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pull request: %w", err)
 	}
@@ -413,33 +523,185 @@ func (gc *GitHubClient) CreatePullRequest(req PullRequestRequest) (*PullRequest,
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	// Convert to our PR format
-	pr := &PullRequest{
-		ID:           githubPR.ID,
-		Number:       githubPR.Number,
-		Title:        githubPR.Title,
-		URL:          githubPR.HTMLURL,
-		State:        githubPR.State,
-		CreatedAt:    githubPR.CreatedAt,
-		UpdatedAt:    githubPR.UpdatedAt,
-		Author:       githubPR.User.Login,
-		SourceBranch: githubPR.Head.Ref,
-		TargetBranch: githubPR.Base.Ref,
-		Draft:        githubPR.Draft,
-	}
+	pr := githubPR.toPullRequest()
 
-	// Extract labels
-	for _, label := range githubPR.Labels {
-		pr.Labels = append(pr.Labels, label.Name)
+	// Labels, assignees and reviewers can only be added once the pull request exists
+	if len(req.Labels) > 0 {
+		labels, err := gc.AddLabels(req.Owner, req.Repository, pr.Number, req.Labels)
+		if err != nil {
+			pr.Warnings = append(pr.Warnings, fmt.Sprintf("failed to add labels: %v", err))
+		} else {
+			pr.Labels = labels
+		}
+	}
+	if len(req.Assignees) > 0 {
+		if err := gc.AddAssignees(req.Owner, req.Repository, pr.Number, req.Assignees); err != nil {
+			pr.Warnings = append(pr.Warnings, fmt.Sprintf("failed to add assignees: %v", err))
+		}
+	}
+	if len(req.Reviewers) > 0 {
+		if err := gc.RequestReviewers(req.Owner, req.Repository, pr.Number, req.Reviewers); err != nil {
+			pr.Warnings = append(pr.Warnings, fmt.Sprintf("failed to request reviewers: %v", err))
+		}
 	}
 
 	return pr, nil
 }
 
-// GetPullRequests gets GitHub pull requests
+// RequestReviewers requests reviews on a pull request. Reviewers of the form
+// "org/team" are requested as team reviewers.
+func (gc *GitHubClient) RequestReviewers(owner, repo string, number int, reviewers []string) error {
+	users := []string{}
+	teams := []string{}
+	for _, reviewer := range reviewers {
+		if _, team, ok := strings.Cut(reviewer, "/"); ok {
+			teams = append(teams, team)
+		} else {
+			users = append(users, reviewer)
+		}
+	}
+
+	payload := map[string]interface{}{
+		"reviewers":      users,
+		"team_reviewers": teams,
+	}
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/pulls/%d/requested_reviewers", gc.apiURL, owner, repo, number)
+	return gc.postJSON(apiURL, payload, nil)
+}
+
+// AddLabels adds labels to a pull request through the issues API, since the
+// pulls endpoint does not accept them, and returns the resulting labels
+func (gc *GitHubClient) AddLabels(owner, repo string, number int, labels []string) ([]string, error) {
+	var applied []GitHubLabel
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/issues/%d/labels", gc.apiURL, owner, repo, number)
+	if err := gc.postJSON(apiURL, map[string]interface{}{"labels": labels}, &applied); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(applied))
+	for _, label := range applied {
+		names = append(names, label.Name)
+	}
+	return names, nil
+}
+
+// AddAssignees assigns users to a pull request through the issues API
+func (gc *GitHubClient) AddAssignees(owner, repo string, number int, assignees []string) error {
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/issues/%d/assignees", gc.apiURL, owner, repo, number)
+	return gc.postJSON(apiURL, map[string]interface{}{"assignees": assignees}, nil)
+}
+
+// postJSON sends payload to a GitHub API endpoint, decoding the response into
+// target when it is not nil
+func (gc *GitHubClient) postJSON(apiURL string, payload interface{}, target interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	headers := buildAuthHeaders("github", gc.token)
+	resp, err := gc.request("POST", apiURL, headers, payloadBytes)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("GitHub API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	if target != nil {
+		return parseJSONResponse(resp, target)
+	}
+	return nil
+}
+
+// GetPullRequests lists all GitHub pull requests of the repository, following
+// the Link header across pages
 func (gc *GitHubClient) GetPullRequests(owner, repo string) ([]PullRequest, error) {
-	// Simplified implementation - would normally make HTTP request
-	return []PullRequest{}, nil
+	if owner == "" || repo == "" {
+		return nil, fmt.Errorf("owner and repository name are required")
+	}
+
+	headers := buildAuthHeaders("github", gc.token)
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/pulls?state=all&per_page=100", gc.apiURL, owner, repo)
+
+	var prs []PullRequest
+	for apiURL != "" {
+		resp, err := gc.request("GET", apiURL, headers, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests: %w", err)
+		}
+
+		if resp.StatusCode == 404 {
+			resp.Body.Close()
+			return nil, fmt.Errorf("repository %s/%s not found or not accessible", owner, repo)
+		}
+		if resp.StatusCode != 200 {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("GitHub API error (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		var page []GitHubPullRequestResponse
+		err = parseJSONResponse(resp, &page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for i := range page {
+			prs = append(prs, *page[i].toPullRequest())
+		}
+
+		apiURL = nextPageURL(resp.Header.Get("Link"))
+	}
+
+	return prs, nil
+}
+
+// toPullRequest converts a GitHub pull request to our PR format
+func (pr *GitHubPullRequestResponse) toPullRequest() *PullRequest {
+	result := &PullRequest{
+		ID:           pr.ID,
+		Number:       pr.Number,
+		Title:        pr.Title,
+		URL:          pr.HTMLURL,
+		State:        pr.State,
+		CreatedAt:    pr.CreatedAt,
+		UpdatedAt:    pr.UpdatedAt,
+		Author:       pr.User.Login,
+		SourceBranch: pr.Head.Ref,
+		TargetBranch: pr.Base.Ref,
+		Draft:        pr.Draft,
+	}
+
+	// Extract labels
+	for _, label := range pr.Labels {
+		result.Labels = append(result.Labels, label.Name)
+	}
+
+	return result
+}
+
+// nextPageURL returns the rel="next" URL of a GitHub Link header, or an empty
+// string on the last page
+func nextPageURL(linkHeader string) string {
+	for _, link := range strings.Split(linkHeader, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+		for _, param := range parts[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return target
+			}
+		}
+	}
+	return ""
 }
 
 // AuthenticateToken validates GitHub token
@@ -452,7 +714,7 @@ func (gc *GitHubClient) AuthenticateToken(token string) error {
 	apiURL := fmt.Sprintf("%s/user", gc.apiURL)
 	headers := buildAuthHeaders("github", token)
 
-	resp, err := makeHTTPRequest("GET", apiURL, headers, nil)
+	resp, err := gc.request("GET", apiURL, headers, nil)
 	if err != nil {
 		return fmt.Errorf("failed to authenticate token: %w", err)
 	}
@@ -469,6 +731,79 @@ func (gc *GitHubClient) AuthenticateToken(token string) error {
 	return nil
 }
 
+// ErrTokenMissingScope is returned when a GitHub token lacks a scope needed for an operation
+var ErrTokenMissingScope = errors.New("GitHub token missing required scope")
+
+// ErrAPITimeout is returned when a hosting service API request does not
+// complete within git.api_timeout
+var ErrAPITimeout = errors.New("API request timed out")
+
+// pullRequestScopes lists the classic OAuth scopes that allow creating pull requests
+var pullRequestScopes = []string{"repo", "public_repo"}
+
+// TokenScopes returns the OAuth scopes granted to the client's token, as reported
+// by the X-OAuth-Scopes header on /user. The boolean is false when GitHub does not
+// report scopes, which is the case for fine-grained personal access tokens.
+func (gc *GitHubClient) TokenScopes() ([]string, bool, error) {
+	apiURL := fmt.Sprintf("%s/user", gc.apiURL)
+	headers := buildAuthHeaders("github", gc.token)
+
+	resp, err := gc.request("GET", apiURL, headers, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to check token scopes: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return nil, false, fmt.Errorf("invalid GitHub token")
+	}
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, false, fmt.Errorf("GitHub API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	values, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok {
+		return nil, false, nil
+	}
+
+	var scopes []string
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes, true, nil
+}
+
+// CheckPullRequestScopes verifies the token can create pull requests. Tokens that
+// do not report scopes are assumed to be fine-grained and are not rejected here.
+func (gc *GitHubClient) CheckPullRequestScopes() error {
+	scopes, reported, err := gc.TokenScopes()
+	if err != nil {
+		return err
+	}
+	if !reported {
+		return nil
+	}
+
+	for _, scope := range scopes {
+		for _, required := range pullRequestScopes {
+			if scope == required {
+				return nil
+			}
+		}
+	}
+
+	granted := "none"
+	if len(scopes) > 0 {
+		granted = strings.Join(scopes, ", ")
+	}
+	return fmt.Errorf("%w: token missing 'repo' scope (granted scopes: %s)", ErrTokenMissingScope, granted)
+}
+
 // ValidateRepository validates GitHub repository access
 func (gc *GitHubClient) ValidateRepository(owner, repo string) error {
 	if owner == "" || repo == "" {
@@ -479,8 +814,6 @@ func (gc *GitHubClient) ValidateRepository(owner, repo string) error {
 	return nil
 }
 
-// GitLab and Bitbucket clients removed per Phase 5.3 spec - focusing on GitHub only
-
 // Generic Client Implementation
 
 // GetHostingService returns the service name
@@ -510,15 +843,164 @@ func (genClient *GenericClient) ValidateRepository(owner, repo string) error {
 
 // Utility functions for making HTTP requests (simplified)
 
-// makeHTTPRequest is a helper function for making HTTP requests
-func makeHTTPRequest(method, apiURL string, headers map[string]string, body []byte) (*http.Response, error) {
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 30 * time.Second,
+// apiSettings holds the request settings shared by the hosting API clients
+type apiSettings struct {
+	timeout     time.Duration
+	maxAttempts int
+}
+
+// defaultAPISettings returns the settings used when the config sets none
+func defaultAPISettings() apiSettings {
+	return apiSettings{
+		timeout:     config.DefaultAPITimeout,
+		maxAttempts: config.DefaultAPIMaxAttempts,
 	}
+}
+
+// SetTimeout sets how long each API request may take, including reading
+// the response. Non-positive values keep the current timeout.
+func (a *apiSettings) SetTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		a.timeout = timeout
+	}
+}
+
+// SetMaxAttempts sets how many times a failing API request is tried.
+// Values below 1 keep the current number.
+func (a *apiSettings) SetMaxAttempts(attempts int) {
+	if attempts > 0 {
+		a.maxAttempts = attempts
+	}
+}
+
+const (
+	// retryBaseDelay is the wait before the first retry, doubled for each
+	// further retry up to retryMaxDelay
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 8 * time.Second
+
+	// maxRetryAfter is the longest Retry-After wait honored; a request asked
+	// to wait longer fails instead
+	maxRetryAfter = time.Minute
+)
+
+// retrySleep waits between attempts; replaced in tests
+var retrySleep = time.Sleep
+
+// request sends an API request, retrying with backoff while it fails in a
+// way that is safe to repeat. Server errors and secondary rate limits are
+// only retried for idempotent methods, since a POST that got a 5xx may
+// still have created the pull request; connection failures are retried for
+// every method because the request never reached the server.
+func (a *apiSettings) request(method, apiURL string, headers map[string]string, body []byte) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := makeHTTPRequest(a.timeout, method, apiURL, headers, body)
+		if attempt >= a.maxAttempts {
+			return resp, err
+		}
 
-	// Create request context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Second)
+		delay, retry := retryDelay(method, attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+		retrySleep(delay)
+	}
+}
+
+// retryDelay reports whether a failed attempt should be retried and how
+// long to wait first
+func retryDelay(method string, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	backoff := retryBaseDelay << (attempt - 1)
+	if backoff > retryMaxDelay {
+		backoff = retryMaxDelay
+	}
+
+	if err != nil {
+		return backoff, isConnectionError(err)
+	}
+	if !isIdempotent(method) {
+		return 0, false
+	}
+
+	switch {
+	case resp.StatusCode >= 500:
+		return backoff, true
+	case isSecondaryRateLimit(resp):
+		if wait, ok := retryAfter(resp); ok {
+			return wait, wait <= maxRetryAfter
+		}
+		return backoff, true
+	}
+	return 0, false
+}
+
+// isIdempotent reports whether repeating a request with method has the
+// same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// isConnectionError reports whether err means the request could not be
+// sent at all, such as a refused connection or a failed DNS lookup
+func isConnectionError(err error) bool {
+	if errors.Is(err, ErrAPITimeout) {
+		return false
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isSecondaryRateLimit reports whether resp is GitHub's secondary rate
+// limit, which asks clients to slow down rather than wait for a quota reset
+func isSecondaryRateLimit(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+	if resp.Header.Get("Retry-After") != "" {
+		return true
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return err == nil && strings.Contains(strings.ToLower(string(data)), "secondary rate limit")
+}
+
+// retryAfter returns the wait requested by the Retry-After header, given
+// either in seconds or as an HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return max(time.Until(when), 0), true
+	}
+	return 0, false
+}
+
+// makeHTTPRequest sends an API request and returns the response with its
+// body already read, so that timeout bounds the whole exchange
+func makeHTTPRequest(timeout time.Duration, method, apiURL string, headers map[string]string, body []byte) (*http.Response, error) {
+	if timeout <= 0 {
+		timeout = config.DefaultAPITimeout
+	}
+
+	// The client and the request context share the timeout; the context
+	// also covers reading the body below
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	// Create request
@@ -552,9 +1034,23 @@ func makeHTTPRequest(method, apiURL string, headers map[string]string, body []by
 	// Make the request
 	resp, err := client.Do(req)
 	if err != nil {
+		if isTimeout(err) {
+			return nil, fmt.Errorf("%w after %s: %w", ErrAPITimeout, timeout, err)
+		}
 		return nil, fmt.Errorf("HTTP request failed: %w", err)
 	}
 
+	// Read the body before the context is cancelled
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		if isTimeout(err) {
+			return nil, fmt.Errorf("%w after %s: %w", ErrAPITimeout, timeout, err)
+		}
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
 	// Handle rate limiting
 	if resp.StatusCode == 403 {
 		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
@@ -565,6 +1061,15 @@ func makeHTTPRequest(method, apiURL string, headers map[string]string, body []by
 	return resp, nil
 }
 
+// isTimeout reports whether err comes from a request deadline passing
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // parseJSONResponse is a helper function for parsing JSON responses
 func parseJSONResponse(resp *http.Response, target interface{}) error {
 	// Read response body
@@ -581,7 +1086,7 @@ func parseJSONResponse(resp *http.Response, target interface{}) error {
 	return nil
 }
 
-// buildAuthHeaders creates authentication headers for GitHub
+// buildAuthHeaders creates authentication headers for the hosting service
 func buildAuthHeaders(service, token string) map[string]string {
 	headers := make(map[string]string)
 
@@ -589,6 +1094,9 @@ func buildAuthHeaders(service, token string) map[string]string {
 	case "github":
 		headers["Authorization"] = fmt.Sprintf("token %s", token)
 		headers["Accept"] = "application/vnd.github.v3+json"
+	case "gitlab":
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", token)
+		headers["Content-Type"] = "application/json"
 	default:
 		// Only GitHub is supported in Phase 5.3
 		headers["Authorization"] = fmt.Sprintf("token %s", token)
@@ -652,6 +1160,153 @@ func (rm *RemoteManager) GetPullRequestTemplate() string {
 	return rm.config.PRTemplate
 }
 
+// pullRequestTemplateFiles are the conventional pull request template files,
+// relative to the repository root, in order of preference
+var pullRequestTemplateFiles = []string{
+	".github/PULL_REQUEST_TEMPLATE.md",
+	".github/pull_request_template.md",
+	"PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"docs/PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md",
+}
+
+// PullRequestTemplate resolves the pull request description template for the
+// checkout at dir. The configured template, GitHubPRTemplate for GitHub and
+// PRTemplate otherwise, is read from disk when it names an existing file,
+// relative to dir, and used literally when it does not. When no template is
+// configured, or only the built-in default, the checkout's conventional
+// template file is preferred.
+func (rm *RemoteManager) PullRequestTemplate(service, dir string) string {
+	template, _ := rm.pullRequestTemplate(service, dir)
+	return template
+}
+
+// pullRequestTemplate implements PullRequestTemplate, also reporting whether
+// the template is one of the checkout's conventional template files
+func (rm *RemoteManager) pullRequestTemplate(service, dir string) (string, bool) {
+	template := rm.config.PRTemplate
+	if service == "github" && rm.config.GitHubPRTemplate != "" {
+		template = rm.config.GitHubPRTemplate
+	}
+
+	if template != "" && template != config.DefaultPRTemplate && template != config.DefaultGitHubPRTemplate {
+		if content, ok := readTemplateFile(template, dir); ok {
+			return content, false
+		}
+		return template, false
+	}
+
+	for _, name := range pullRequestTemplateFiles {
+		if content, ok := readTemplateFile(name, dir); ok {
+			return content, true
+		}
+	}
+	return template, false
+}
+
+// pullRequestCommitLogLimit caps the commits listed by {{.CommitLog}}
+const pullRequestCommitLogLimit = 50
+
+// PullRequestTemplateContext provides the variables of pull request templates
+type PullRequestTemplateContext struct {
+	Branch       string // source branch
+	BaseBranch   string // target branch
+	CommitLog    string // "- subject (hash)" line per commit on Branch but not BaseBranch
+	WorktreePath string
+}
+
+// pullRequestTemplateError reports a pull request template that is not a
+// valid template or that failed to render
+type pullRequestTemplateError struct {
+	action string
+	err    error
+}
+
+func (e *pullRequestTemplateError) Error() string {
+	return fmt.Sprintf("failed to %s PR template: %v", e.action, e.err)
+}
+
+func (e *pullRequestTemplateError) Unwrap() error {
+	return e.err
+}
+
+// RenderPullRequestTemplate renders a pull request template with the template
+// engine and functions of directory patterns
+func RenderPullRequestTemplate(template string, context PullRequestTemplateContext) (string, error) {
+	tmpl, err := createPatternTemplate(template)
+	if err != nil {
+		return "", &pullRequestTemplateError{action: "parse", err: err}
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, context); err != nil {
+		return "", &pullRequestTemplateError{action: "render", err: err}
+	}
+	return buf.String(), nil
+}
+
+// renderPullRequestTemplate renders template for a pull request from
+// worktree, reading the commit log only when the template uses it
+func (rm *RemoteManager) renderPullRequestTemplate(template string, worktree *WorktreeInfo, req PullRequestRequest) (string, error) {
+	if !strings.Contains(template, "{{") {
+		return template, nil
+	}
+
+	context := PullRequestTemplateContext{
+		Branch:       req.SourceBranch,
+		BaseBranch:   req.TargetBranch,
+		WorktreePath: worktree.Path,
+	}
+
+	if strings.Contains(strings.ToLower(template), "commitlog") {
+		ops := NewGitOperationsInDir(rm.repo, rm.gitCmd, worktree.Path)
+		commits, err := ops.GetCommitHistory(req.TargetBranch+".."+req.SourceBranch, pullRequestCommitLogLimit)
+		if err != nil {
+			return "", fmt.Errorf("failed to read commit log for PR template: %w", err)
+		}
+		context.CommitLog = formatCommitLog(commits)
+	}
+
+	return RenderPullRequestTemplate(template, context)
+}
+
+// formatCommitLog formats commits as a markdown list
+func formatCommitLog(commits []CommitInfo) string {
+	lines := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		hash := commit.Hash
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		lines = append(lines, fmt.Sprintf("- %s (%s)", commit.Message, hash))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// readTemplateFile reads the file at path, resolved against dir when
+// relative, and reports whether path named a readable file
+func readTemplateFile(path, dir string) (string, bool) {
+	if strings.Contains(path, "\n") {
+		return "", false
+	}
+
+	path = config.ExpandPath(path)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
 // SetPullRequestTemplate sets the PR template
 func (rm *RemoteManager) SetPullRequestTemplate(template string) {
 	rm.config.PRTemplate = template
@@ -690,17 +1345,40 @@ func getPRURL(pr *PullRequest) string {
 	return pr.URL
 }
 
-// GitLabClient - stub implementation for tests
+// GitLabClient implements HostingClient for GitLab merge requests
 type GitLabClient struct {
+	apiSettings
 	token  string
 	apiURL string
 }
 
-// NewGitLabClient creates a new GitLab client (stub implementation)
+// GitLab API response structures
+type GitLabMergeRequestResponse struct {
+	ID           int        `json:"id"`
+	IID          int        `json:"iid"`
+	Title        string     `json:"title"`
+	WebURL       string     `json:"web_url"`
+	State        string     `json:"state"`
+	Draft        bool       `json:"draft"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Author       GitLabUser `json:"author"`
+	SourceBranch string     `json:"source_branch"`
+	TargetBranch string     `json:"target_branch"`
+	Labels       []string   `json:"labels"`
+}
+
+type GitLabUser struct {
+	Username string `json:"username"`
+	ID       int    `json:"id"`
+}
+
+// NewGitLabClient creates a new GitLab client
 func NewGitLabClient(token string) *GitLabClient {
 	return &GitLabClient{
-		token:  token,
-		apiURL: "https://gitlab.com/api/v4",
+		apiSettings: defaultAPISettings(),
+		token:       token,
+		apiURL:      "https://gitlab.com/api/v4",
 	}
 }
 
@@ -709,24 +1387,141 @@ func (gc *GitLabClient) GetHostingService() string {
 	return "gitlab"
 }
 
-// CreatePullRequest creates a GitLab merge request (stub implementation)
+// CreatePullRequest creates a GitLab merge request
 func (gc *GitLabClient) CreatePullRequest(req PullRequestRequest) (*PullRequest, error) {
-	return nil, fmt.Errorf("GitLab client not fully implemented")
+	title := req.Title
+	if req.Draft && !strings.HasPrefix(title, "Draft:") {
+		// GitLab marks merge requests as drafts by title prefix
+		title = "Draft: " + title
+	}
+
+	// GitLab API payload
+	payload := map[string]interface{}{
+		"title":         title,
+		"description":   req.Description,
+		"source_branch": req.SourceBranch,
+		"target_branch": req.TargetBranch,
+	}
+	if len(req.Labels) > 0 {
+		payload["labels"] = strings.Join(req.Labels, ",")
+	}
+
+	apiURL := fmt.Sprintf("%s/projects/%s/merge_requests", gc.apiURL, gitLabProjectID(req.Owner, req.Repository))
+
+	// Marshal payload to JSON
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	headers := buildAuthHeaders("gitlab", gc.token)
+	resp, err := gc.request("POST", apiURL, headers, payloadBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check response status
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("GitLab API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var gitlabMR GitLabMergeRequestResponse
+	if err := parseJSONResponse(resp, &gitlabMR); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return gitlabMR.toPullRequest(), nil
 }
 
-// GetPullRequests lists GitLab merge requests (stub implementation)
+// GetPullRequests lists open GitLab merge requests
 func (gc *GitLabClient) GetPullRequests(owner, repo string) ([]PullRequest, error) {
-	return nil, fmt.Errorf("GitLab client not fully implemented")
+	apiURL := fmt.Sprintf("%s/projects/%s/merge_requests?state=opened", gc.apiURL, gitLabProjectID(owner, repo))
+	headers := buildAuthHeaders("gitlab", gc.token)
+
+	resp, err := gc.request("GET", apiURL, headers, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merge requests: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("GitLab API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var gitlabMRs []GitLabMergeRequestResponse
+	if err := parseJSONResponse(resp, &gitlabMRs); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	prs := make([]PullRequest, 0, len(gitlabMRs))
+	for _, mr := range gitlabMRs {
+		prs = append(prs, *mr.toPullRequest())
+	}
+	return prs, nil
 }
 
-// AuthenticateToken validates GitLab token (stub implementation)
+// AuthenticateToken validates GitLab token
 func (gc *GitLabClient) AuthenticateToken(token string) error {
-	return fmt.Errorf("GitLab client not fully implemented")
+	if token == "" {
+		return fmt.Errorf("GitLab token is empty")
+	}
+
+	// Call /user endpoint to validate token
+	apiURL := fmt.Sprintf("%s/user", gc.apiURL)
+	headers := buildAuthHeaders("gitlab", token)
+
+	resp, err := gc.request("GET", apiURL, headers, nil)
+	if err != nil {
+		return fmt.Errorf("failed to authenticate token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 401 {
+		return fmt.Errorf("invalid GitLab token")
+	}
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("GitLab API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
 }
 
-// ValidateRepository validates GitLab repository (stub implementation)
+// ValidateRepository validates GitLab repository access
 func (gc *GitLabClient) ValidateRepository(owner, repo string) error {
-	return fmt.Errorf("GitLab client not fully implemented")
+	if owner == "" || repo == "" {
+		return fmt.Errorf("owner and repository name are required")
+	}
+
+	// Simplified validation - would normally make API call
+	return nil
+}
+
+// toPullRequest converts a GitLab merge request to our PR format
+func (mr *GitLabMergeRequestResponse) toPullRequest() *PullRequest {
+	return &PullRequest{
+		ID:           mr.ID,
+		Number:       mr.IID,
+		Title:        mr.Title,
+		URL:          mr.WebURL,
+		State:        mr.State,
+		CreatedAt:    mr.CreatedAt,
+		UpdatedAt:    mr.UpdatedAt,
+		Author:       mr.Author.Username,
+		SourceBranch: mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		Draft:        mr.Draft,
+		Labels:       mr.Labels,
+	}
+}
+
+// gitLabProjectID returns the URL-encoded "owner/repo" path GitLab accepts in
+// place of a numeric project id. Owner may include subgroups.
+func gitLabProjectID(owner, repo string) string {
+	return url.PathEscape(owner + "/" + repo)
 }
 
 // BitbucketClient - stub implementation for tests
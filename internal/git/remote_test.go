@@ -1,7 +1,15 @@
 package git
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -94,6 +102,16 @@ func TestDetectHostingService(t *testing.T) {
 			remoteURL: "https://bitbucket.org/user/repo.git",
 			expected:  "bitbucket",
 		},
+		{
+			name:      "GitHub Enterprise HTTPS URL",
+			remoteURL: "https://github.example.com/team/repo.git",
+			expected:  "github",
+		},
+		{
+			name:      "GitHub Enterprise SSH URL",
+			remoteURL: "git@github.example.com:team/repo.git",
+			expected:  "github",
+		},
 		{
 			name:      "Generic Git URL",
 			remoteURL: "https://custom-git.example.com/user/repo.git",
@@ -120,6 +138,77 @@ func TestDetectHostingService(t *testing.T) {
 	}
 }
 
+func TestDetectHostingService_ConfiguredGitHubAPIHost(t *testing.T) {
+	gitConfig := createTestGitConfig()
+	gitConfig.GitHubAPIURL = "https://git.corp.example/api/v3"
+	rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+
+	service, err := rm.DetectHostingService("git@git.corp.example:team/repo.git")
+	require.NoError(t, err)
+	assert.Equal(t, "github", service)
+
+	service, err = rm.DetectHostingService("https://git.other.example/team/repo.git")
+	require.NoError(t, err)
+	assert.Equal(t, "generic", service)
+}
+
+func TestInitializeClients_GitHubAPIURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		origin   string
+		apiURL   string
+		expected string
+	}{
+		{name: "github.com", origin: "git@github.com:user/repo.git", expected: "https://api.github.com"},
+		{name: "enterprise remote", origin: "https://github.example.com/team/repo.git", expected: "https://github.example.com/api/v3"},
+		{name: "enterprise SSH remote", origin: "git@github.example.com:team/repo.git", expected: "https://github.example.com/api/v3"},
+		{name: "configured URL", origin: "git@git.corp.example:team/repo.git", apiURL: "https://git.corp.example/api/v3/", expected: "https://git.corp.example/api/v3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := createTestRepository()
+			repo.Origin = tt.origin
+			gitConfig := createTestGitConfig()
+			gitConfig.GitHubAPIURL = tt.apiURL
+
+			rm := NewRemoteManager(repo, gitConfig, NewMockGitCmd())
+			client, err := rm.GetHostingClient("github")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, client.(*GitHubClient).apiURL)
+		})
+	}
+}
+
+func TestCreatePullRequest_GitHubEnterprise(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 7, "number": 7, "title": "Add login", "state": "open",
+			"html_url": "https://github.example.com/team/app/pull/7", "head": {"ref": "feature"}, "base": {"ref": "main"}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --verify feature", "abc123def")
+	mockGit.SetCommand("push -u origin feature", "")
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	repo := createTestRepository()
+	repo.Origin = fmt.Sprintf("https://%s/team/app.git", host)
+	repo.Remotes[0].URL, repo.Remotes[0].Owner, repo.Remotes[0].Repo = repo.Origin, "team", "app"
+
+	gitConfig := createTestGitConfig()
+	gitConfig.GitHubAPIURL = server.URL + "/api/v3"
+	rm := NewRemoteManager(repo, gitConfig, mockGit)
+
+	pr, err := rm.CreatePullRequest(&WorktreeInfo{Branch: "feature"}, PullRequestRequest{Title: "Add login", Description: "Adds login"})
+	require.NoError(t, err)
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, []string{"/api/v3/repos/team/app/pulls"}, paths)
+}
+
 func TestCreatePullRequest_Success(t *testing.T) {
 	repo := createTestRepository()
 	gitConfig := createTestGitConfig()
@@ -190,6 +279,19 @@ func TestPushAndCreatePR(t *testing.T) {
 	assert.Equal(t, "Test PR", pr.Title)
 }
 
+func TestPushAndCreatePR_DetachedHead(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	rm := NewRemoteManager(createTestRepository(), createTestGitConfig(), mockGit)
+
+	worktree := &WorktreeInfo{Path: "/test/bisect", Head: "def456ghi", Detached: true}
+
+	_, err := rm.PushAndCreatePR(worktree, PullRequestRequest{Title: "Test PR"})
+	assert.ErrorIs(t, err, ErrDetachedHead)
+
+	assert.ErrorIs(t, rm.PushBranch(""), ErrDetachedHead)
+	assert.Empty(t, mockGit.executed, "nothing should be pushed")
+}
+
 func TestGetHostingClient_Success(t *testing.T) {
 	rm := NewRemoteManager(createTestRepository(), createTestGitConfig(), NewMockGitCmd())
 
@@ -385,6 +487,221 @@ func TestGitHubClient_AuthenticateToken(t *testing.T) {
 	assert.Contains(t, err.Error(), "GitHub token is empty")
 }
 
+func newScopeTestServer(t *testing.T, scopes *string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user", r.URL.Path)
+		assert.Equal(t, "token test_token", r.Header.Get("Authorization"))
+		if scopes != nil {
+			w.Header().Set("X-OAuth-Scopes", *scopes)
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"login":"octocat"}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitHubClient_CheckPullRequestScopes(t *testing.T) {
+	missing := "read:user, gist"
+	repoScope := "repo, read:org"
+	publicRepo := "public_repo"
+	empty := ""
+
+	tests := []struct {
+		name      string
+		scopes    *string
+		wantError bool
+	}{
+		{"missing repo scope", &missing, true},
+		{"no scopes granted", &empty, true},
+		{"repo scope", &repoScope, false},
+		{"public_repo scope", &publicRepo, false},
+		{"fine-grained token without scope header", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newScopeTestServer(t, tt.scopes)
+			client := NewGitHubClient("test_token")
+			client.apiURL = server.URL
+
+			err := client.CheckPullRequestScopes()
+			if tt.wantError {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, ErrTokenMissingScope))
+				assert.Contains(t, err.Error(), "token missing 'repo' scope")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGitHubClient_TokenScopes(t *testing.T) {
+	scopes := "repo, workflow"
+	server := newScopeTestServer(t, &scopes)
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	got, reported, err := client.TokenScopes()
+	require.NoError(t, err)
+	assert.True(t, reported)
+	assert.Equal(t, []string{"repo", "workflow"}, got)
+}
+
+func TestGitHubClient_APITimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	gitConfig := createTestGitConfig()
+	gitConfig.APITimeout = 20 * time.Millisecond
+	rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+	client := rm.clients["github"].(*GitHubClient)
+	assert.Equal(t, 20*time.Millisecond, client.timeout)
+	client.apiURL = server.URL
+
+	start := time.Now()
+	_, _, err := client.TokenScopes()
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.True(t, errors.Is(err, ErrAPITimeout))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "API request timed out after 20ms")
+}
+
+func TestNewGitHubClient_DefaultTimeout(t *testing.T) {
+	client := NewGitHubClient("test_token")
+	assert.Equal(t, config.DefaultAPITimeout, client.timeout)
+
+	// Unset config values keep the default
+	client.SetTimeout(0)
+	assert.Equal(t, config.DefaultAPITimeout, client.timeout)
+}
+
+// recordRetrySleeps replaces the wait between attempts, returning the
+// waits requested
+func recordRetrySleeps(t *testing.T) *[]time.Duration {
+	var sleeps []time.Duration
+	original := retrySleep
+	retrySleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	t.Cleanup(func() { retrySleep = original })
+	return &sleeps
+}
+
+func TestGitHubClient_RetriesServerErrorOnGet(t *testing.T) {
+	sleeps := recordRetrySleeps(t)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("X-OAuth-Scopes", "repo")
+		fmt.Fprint(w, `{"login":"octocat"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	scopes, reported, err := client.TokenScopes()
+	require.NoError(t, err)
+	assert.True(t, reported)
+	assert.Equal(t, []string{"repo"}, scopes)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []time.Duration{retryBaseDelay}, *sleeps)
+}
+
+func TestGitHubClient_RetryGivesUpAfterMaxAttempts(t *testing.T) {
+	sleeps := recordRetrySleeps(t)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+	client.SetMaxAttempts(3)
+
+	_, _, err := client.TokenScopes()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 502")
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []time.Duration{retryBaseDelay, 2 * retryBaseDelay}, *sleeps)
+}
+
+func TestGitHubClient_RetriesSecondaryRateLimit(t *testing.T) {
+	sleeps := recordRetrySleeps(t)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit."}`)
+			return
+		}
+		fmt.Fprint(w, `{"login":"octocat"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	_, _, err := client.TokenScopes()
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []time.Duration{2 * time.Second}, *sleeps)
+}
+
+func TestGitHubClient_CreatePullRequest_NoRetryOnServerError(t *testing.T) {
+	sleeps := recordRetrySleeps(t)
+	posts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		posts++
+		// The pull request may have been created before the gateway failed
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	_, err := client.CreatePullRequest(PullRequestRequest{
+		Title: "Add login", SourceBranch: "feature", TargetBranch: "main", Owner: "octo", Repository: "app",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 502")
+	assert.Equal(t, 1, posts)
+	assert.Empty(t, *sleeps)
+}
+
+func TestGitHubClient_CreatePullRequest_RetriesConnectionError(t *testing.T) {
+	sleeps := recordRetrySleeps(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	_, err := client.CreatePullRequest(PullRequestRequest{
+		Title: "Add login", SourceBranch: "feature", TargetBranch: "main", Owner: "octo", Repository: "app",
+	})
+	require.Error(t, err)
+	assert.Len(t, *sleeps, config.DefaultAPIMaxAttempts-1)
+}
+
 func TestGitHubClient_ValidateRepository(t *testing.T) {
 	client := NewGitHubClient("test_token")
 
@@ -398,6 +715,221 @@ func TestGitHubClient_ValidateRepository(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// newPullRequestTestServer serves pull request creation and records the
+// payloads of follow-up requests by path
+func newPullRequestTestServer(t *testing.T, followUpStatus int) (*httptest.Server, map[string]map[string]interface{}) {
+	followUps := make(map[string]map[string]interface{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		if r.URL.Path == "/repos/user/repo/pulls" {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": 500, "number": 5, "title": %q, "state": "open", "draft": %t,
+				"head": {"ref": "feature"}, "base": {"ref": "main"}}`, payload["title"], payload["draft"])
+			return
+		}
+
+		followUps[r.URL.Path] = payload
+		w.WriteHeader(followUpStatus)
+		if labels, ok := payload["labels"].([]interface{}); ok && followUpStatus < 300 {
+			applied := []GitHubLabel{}
+			for _, label := range labels {
+				applied = append(applied, GitHubLabel{Name: label.(string)})
+			}
+			json.NewEncoder(w).Encode(applied)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, followUps
+}
+
+func TestGitHubClient_CreatePullRequest_RequestsReviewers(t *testing.T) {
+	server, followUps := newPullRequestTestServer(t, http.StatusCreated)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	pr, err := client.CreatePullRequest(PullRequestRequest{
+		Title:        "Add auth",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+		Owner:        "user",
+		Repository:   "repo",
+		Reviewers:    []string{"alice", "bob", "user/backend"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, pr.Number)
+	assert.Empty(t, pr.Warnings)
+
+	payload, requested := followUps["/repos/user/repo/pulls/5/requested_reviewers"]
+	require.True(t, requested, "expected reviewers to be requested")
+	assert.Equal(t, []interface{}{"alice", "bob"}, payload["reviewers"])
+	assert.Equal(t, []interface{}{"backend"}, payload["team_reviewers"])
+}
+
+func TestGitHubClient_CreatePullRequest_ReviewerFailureIsWarning(t *testing.T) {
+	server, followUps := newPullRequestTestServer(t, http.StatusUnprocessableEntity)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	pr, err := client.CreatePullRequest(PullRequestRequest{
+		Title:        "Add auth",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+		Owner:        "user",
+		Repository:   "repo",
+		Reviewers:    []string{"not-a-collaborator"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, pr.Number)
+	assert.Contains(t, followUps, "/repos/user/repo/pulls/5/requested_reviewers")
+	require.Len(t, pr.Warnings, 1)
+	assert.Contains(t, pr.Warnings[0], "failed to request reviewers")
+}
+
+func TestGitHubClient_CreatePullRequest_LabelsAndAssignees(t *testing.T) {
+	server, followUps := newPullRequestTestServer(t, http.StatusOK)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	pr, err := client.CreatePullRequest(PullRequestRequest{
+		Title:        "Add auth",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+		Owner:        "user",
+		Repository:   "repo",
+		Draft:        true,
+		Labels:       []string{"backend", "security"},
+		Assignees:    []string{"alice"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, pr.Draft)
+	assert.Empty(t, pr.Warnings)
+	assert.Equal(t, []string{"backend", "security"}, pr.Labels)
+
+	assert.Equal(t, []interface{}{"backend", "security"}, followUps["/repos/user/repo/issues/5/labels"]["labels"])
+	assert.Equal(t, []interface{}{"alice"}, followUps["/repos/user/repo/issues/5/assignees"]["assignees"])
+	assert.NotContains(t, followUps, "/repos/user/repo/pulls/5/requested_reviewers")
+}
+
+func TestGitHubClient_CreatePullRequest_LabelFailureIsWarning(t *testing.T) {
+	server, _ := newPullRequestTestServer(t, http.StatusForbidden)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	pr, err := client.CreatePullRequest(PullRequestRequest{
+		Title:        "Add auth",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+		Owner:        "user",
+		Repository:   "repo",
+		Labels:       []string{"backend"},
+		Assignees:    []string{"alice"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, pr.Warnings, 2)
+	assert.Contains(t, pr.Warnings[0], "failed to add labels")
+	assert.Contains(t, pr.Warnings[1], "failed to add assignees")
+}
+
+func TestGitHubClient_CreatePullRequest_NoReviewers(t *testing.T) {
+	server, followUps := newPullRequestTestServer(t, http.StatusCreated)
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	_, err := client.CreatePullRequest(PullRequestRequest{Title: "Add auth", SourceBranch: "feature", TargetBranch: "main", Owner: "user", Repository: "repo"})
+
+	require.NoError(t, err)
+	assert.Empty(t, followUps)
+}
+
+func TestGitHubClient_GetPullRequests_Paginates(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/user/repo/pulls", r.URL.Path)
+		assert.Equal(t, "all", r.URL.Query().Get("state"))
+		assert.Equal(t, "token test_token", r.Header.Get("Authorization"))
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/user/repo/pulls?state=all&page=2>; rel="next", <%s/repos/user/repo/pulls?state=all&page=2>; rel="last"`, server.URL, server.URL))
+			fmt.Fprint(w, `[{"id": 1, "number": 11, "title": "Add auth", "state": "open",
+				"user": {"login": "dev"}, "head": {"ref": "feature/auth"}, "base": {"ref": "main"},
+				"labels": [{"name": "backend"}, {"name": "security"}]}]`)
+		case "2":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/user/repo/pulls?state=all&page=1>; rel="prev"`, server.URL))
+			fmt.Fprint(w, `[{"id": 2, "number": 12, "title": "Fix docs", "state": "closed", "draft": true,
+				"head": {"ref": "docs/fix"}, "base": {"ref": "main"}}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	prs, err := client.GetPullRequests("user", "repo")
+
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, 11, prs[0].Number)
+	assert.Equal(t, "feature/auth", prs[0].SourceBranch)
+	assert.Equal(t, "main", prs[0].TargetBranch)
+	assert.Equal(t, "dev", prs[0].Author)
+	assert.Equal(t, []string{"backend", "security"}, prs[0].Labels)
+	assert.Equal(t, 12, prs[1].Number)
+	assert.Equal(t, "closed", prs[1].State)
+	assert.True(t, prs[1].Draft)
+}
+
+func TestGitHubClient_GetPullRequests_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not Found"}`)
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient("test_token")
+	client.apiURL = server.URL
+
+	_, err := client.GetPullRequests("user", "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository user/missing not found")
+}
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"next and last", `<https://api.github.com/x?page=2>; rel="next", <https://api.github.com/x?page=5>; rel="last"`, "https://api.github.com/x?page=2"},
+		{"last page", `<https://api.github.com/x?page=1>; rel="first", <https://api.github.com/x?page=4>; rel="prev"`, ""},
+		{"no header", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, nextPageURL(tt.header))
+		})
+	}
+}
+
 // Test GitLab Client
 
 func TestNewGitLabClient(t *testing.T) {
@@ -410,7 +942,25 @@ func TestNewGitLabClient(t *testing.T) {
 }
 
 func TestGitLabClient_CreatePullRequest(t *testing.T) {
+	var payload map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/projects/user%2Frepo/merge_requests", r.URL.EscapedPath())
+		assert.Equal(t, "Bearer test_token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 101, "iid": 7, "title": "Draft: Test MR", "state": "opened", "draft": true,
+			"web_url": "https://gitlab.com/user/repo/-/merge_requests/7",
+			"author": {"username": "dev"},
+			"source_branch": "feature", "target_branch": "main", "labels": ["backend"]
+		}`)
+	}))
+	defer server.Close()
+
 	client := NewGitLabClient("test_token")
+	client.apiURL = server.URL
 
 	req := PullRequestRequest{
 		Title:        "Test MR",
@@ -419,18 +969,72 @@ func TestGitLabClient_CreatePullRequest(t *testing.T) {
 		TargetBranch: "main",
 		Owner:        "user",
 		Repository:   "repo",
+		Draft:        true,
+		Labels:       []string{"backend"},
 	}
 
 	pr, err := client.CreatePullRequest(req)
 
 	require.NoError(t, err)
-	assert.NotNil(t, pr)
-	assert.Equal(t, "Test MR", pr.Title)
+	assert.Equal(t, "feature", payload["source_branch"])
+	assert.Equal(t, "main", payload["target_branch"])
+	assert.Equal(t, "Draft: Test MR", payload["title"])
+	assert.Equal(t, "Test description", payload["description"])
+	assert.Equal(t, "backend", payload["labels"])
+
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, "Draft: Test MR", pr.Title)
 	assert.Equal(t, "feature", pr.SourceBranch)
 	assert.Equal(t, "main", pr.TargetBranch)
+	assert.Equal(t, "dev", pr.Author)
+	assert.True(t, pr.Draft)
 	assert.Contains(t, pr.URL, "gitlab.com")
 }
 
+func TestGitLabClient_CreatePullRequest_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":["Another open merge request already exists for this source branch"]}`)
+	}))
+	defer server.Close()
+
+	client := NewGitLabClient("test_token")
+	client.apiURL = server.URL
+
+	_, err := client.CreatePullRequest(PullRequestRequest{Title: "Test MR", SourceBranch: "feature", TargetBranch: "main", Owner: "user", Repository: "repo"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 409")
+}
+
+func TestGitLabClient_AuthenticateToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer good_token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"username":"dev"}`)
+	}))
+	defer server.Close()
+
+	client := NewGitLabClient("good_token")
+	client.apiURL = server.URL
+
+	assert.NoError(t, client.AuthenticateToken("good_token"))
+
+	err := client.AuthenticateToken("bad_token")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid GitLab token")
+
+	assert.Error(t, client.AuthenticateToken(""))
+}
+
+func TestGitLabProjectID(t *testing.T) {
+	assert.Equal(t, "user%2Frepo", gitLabProjectID("user", "repo"))
+	assert.Equal(t, "group%2Fsubgroup%2Frepo", gitLabProjectID("group/subgroup", "repo"))
+}
+
 // Test Bitbucket Client
 
 func TestNewBitbucketClient(t *testing.T) {
@@ -614,3 +1218,194 @@ func TestPullRequestRequest_Fields(t *testing.T) {
 	assert.Len(t, req.Labels, 1)
 	assert.Len(t, req.Assignees, 1)
 }
+
+// recordingClient is a hosting client that records the pull requests it is
+// asked to create
+type recordingClient struct {
+	requests []PullRequestRequest
+}
+
+func (c *recordingClient) CreatePullRequest(req PullRequestRequest) (*PullRequest, error) {
+	c.requests = append(c.requests, req)
+	return &PullRequest{Number: 1, Title: req.Title}, nil
+}
+
+func (c *recordingClient) GetPullRequests(owner, repo string) ([]PullRequest, error) {
+	return nil, nil
+}
+
+func (c *recordingClient) AuthenticateToken(token string) error {
+	return nil
+}
+
+func (c *recordingClient) ValidateRepository(owner, repo string) error {
+	return nil
+}
+
+func (c *recordingClient) GetHostingService() string {
+	return "github"
+}
+
+// writeTemplateFile writes a template file at name below dir
+func writeTemplateFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestPullRequestTemplate(t *testing.T) {
+	t.Run("literal template", func(t *testing.T) {
+		gitConfig := &config.GitConfig{PRTemplate: "## Summary\n"}
+		rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+
+		assert.Equal(t, "## Summary\n", rm.PullRequestTemplate("gitlab", t.TempDir()))
+	})
+
+	t.Run("relative file path is read from the checkout", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTemplateFile(t, dir, "docs/pr.md", "## From file\n")
+		gitConfig := &config.GitConfig{PRTemplate: "docs/pr.md"}
+		rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+
+		assert.Equal(t, "## From file\n", rm.PullRequestTemplate("gitlab", dir))
+	})
+
+	t.Run("GitHub template file wins on GitHub", func(t *testing.T) {
+		dir := t.TempDir()
+		githubTemplate := writeTemplateFile(t, t.TempDir(), "github.md", "## GitHub\n")
+		gitConfig := &config.GitConfig{PRTemplate: "generic", GitHubPRTemplate: githubTemplate}
+		rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+
+		assert.Equal(t, "## GitHub\n", rm.PullRequestTemplate("github", dir))
+		assert.Equal(t, "generic", rm.PullRequestTemplate("gitlab", dir))
+	})
+
+	t.Run("missing file is used literally", func(t *testing.T) {
+		gitConfig := &config.GitConfig{PRTemplate: "docs/missing.md"}
+		rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+
+		assert.Equal(t, "docs/missing.md", rm.PullRequestTemplate("github", t.TempDir()))
+	})
+
+	t.Run("conventional template is discovered", func(t *testing.T) {
+		dir := t.TempDir()
+		rm := NewRemoteManager(createTestRepository(), &config.GitConfig{}, NewMockGitCmd())
+		assert.Empty(t, rm.PullRequestTemplate("github", dir))
+
+		writeTemplateFile(t, dir, "docs/pull_request_template.md", "## Docs\n")
+		assert.Equal(t, "## Docs\n", rm.PullRequestTemplate("github", dir))
+
+		writeTemplateFile(t, dir, ".github/PULL_REQUEST_TEMPLATE.md", "## Checklist\n")
+		assert.Equal(t, "## Checklist\n", rm.PullRequestTemplate("github", dir))
+	})
+
+	t.Run("repository template wins over the built-in default", func(t *testing.T) {
+		gitConfig := &config.GitConfig{}
+		gitConfig.SetDefaults()
+		rm := NewRemoteManager(createTestRepository(), gitConfig, NewMockGitCmd())
+
+		dir := t.TempDir()
+		assert.Equal(t, config.DefaultGitHubPRTemplate, rm.PullRequestTemplate("github", dir))
+		assert.Equal(t, config.DefaultPRTemplate, rm.PullRequestTemplate("gitlab", dir))
+
+		writeTemplateFile(t, dir, ".github/pull_request_template.md", "## Repository\n")
+		assert.Equal(t, "## Repository\n", rm.PullRequestTemplate("github", dir))
+	})
+}
+
+func TestCreatePullRequest_UsesTemplateFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplateFile(t, dir, ".github/PULL_REQUEST_TEMPLATE.md", "## Checklist\n- [ ] Tests\n")
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --verify feature-branch", "abc123def")
+	mockGit.SetCommand("push -u origin feature-branch", "")
+
+	rm := NewRemoteManager(createTestRepository(), &config.GitConfig{DefaultRemote: "origin"}, mockGit)
+	client := &recordingClient{}
+	rm.clients["github"] = client
+
+	_, err := rm.CreatePullRequest(&WorktreeInfo{Branch: "feature-branch", Path: dir}, PullRequestRequest{Title: "Add feature"})
+	require.NoError(t, err)
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "## Checklist\n- [ ] Tests\n", client.requests[0].Description)
+
+	// An explicit description is kept
+	_, err = rm.CreatePullRequest(&WorktreeInfo{Branch: "feature-branch", Path: dir}, PullRequestRequest{Title: "Add feature", Description: "Body"})
+	require.NoError(t, err)
+	assert.Equal(t, "Body", client.requests[1].Description)
+}
+
+func TestCreatePullRequest_RepositoryTemplateWithLiteralBraces(t *testing.T) {
+	dir := t.TempDir()
+	writeTemplateFile(t, dir, ".github/PULL_REQUEST_TEMPLATE.md", "Wrap values in {{ and }} like {{.Name\n")
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --verify feature-branch", "abc123def")
+	mockGit.SetCommand("push -u origin feature-branch", "")
+
+	rm := NewRemoteManager(createTestRepository(), &config.GitConfig{DefaultRemote: "origin"}, mockGit)
+	client := &recordingClient{}
+	rm.clients["github"] = client
+
+	_, err := rm.CreatePullRequest(&WorktreeInfo{Branch: "feature-branch", Path: dir}, PullRequestRequest{Title: "Add feature"})
+	require.NoError(t, err)
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "Wrap values in {{ and }} like {{.Name\n", client.requests[0].Description)
+
+	// A configured template that cannot be parsed is still an error
+	rm.config.GitHubPRTemplate = "{{.Branch"
+	_, err = rm.CreatePullRequest(&WorktreeInfo{Branch: "feature-branch", Path: dir}, PullRequestRequest{Title: "Add feature"})
+	assert.Error(t, err)
+}
+
+func TestRenderPullRequestTemplate(t *testing.T) {
+	body, err := RenderPullRequestTemplate("Merges {{.Branch}} into {{.BaseBranch}} from {{.WorktreePath}}\n\n{{.CommitLog}}", PullRequestTemplateContext{
+		Branch:       "feature/auth",
+		BaseBranch:   "main",
+		CommitLog:    "- Add login (abc1234)",
+		WorktreePath: "/work/app-auth",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Merges feature/auth into main from /work/app-auth\n\n- Add login (abc1234)", body)
+
+	_, err = RenderPullRequestTemplate("{{.Branch", PullRequestTemplateContext{})
+	assert.Error(t, err)
+}
+
+func TestCreatePullRequest_RendersTemplateWithCommitLog(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --verify feature-branch", "abc123def")
+	mockGit.SetCommand("push -u origin feature-branch", "")
+	mockGit.SetCommand("log --pretty=format:%H|%an|%at|%s -n 50 main..feature-branch",
+		"1111111aaaaaaa|Ada|1700000100|Add login form\n2222222bbbbbbb|Ada|1700000000|Add session store")
+
+	gitConfig := &config.GitConfig{
+		DefaultRemote:    "origin",
+		GitHubPRTemplate: "## {{.Branch}} -> {{.BaseBranch}}\n\n### Commits\n{{.CommitLog}}\n",
+	}
+	rm := NewRemoteManager(createTestRepository(), gitConfig, mockGit)
+	client := &recordingClient{}
+	rm.clients["github"] = client
+
+	_, err := rm.CreatePullRequest(&WorktreeInfo{Branch: "feature-branch", Path: t.TempDir()}, PullRequestRequest{Title: "Add login"})
+	require.NoError(t, err)
+	require.Len(t, client.requests, 1)
+	assert.Equal(t, "## feature-branch -> main\n\n### Commits\n- Add login form (1111111)\n- Add session store (2222222)\n", client.requests[0].Description)
+}
+
+func TestCreatePullRequest_TemplateWithoutCommitLogSkipsGitLog(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --verify feature-branch", "abc123def")
+	mockGit.SetCommand("push -u origin feature-branch", "")
+
+	gitConfig := &config.GitConfig{DefaultRemote: "origin", PRTemplate: "Branch: {{.Branch}}"}
+	rm := NewRemoteManager(createTestRepository(), gitConfig, mockGit)
+	client := &recordingClient{}
+	rm.clients["github"] = client
+
+	_, err := rm.CreatePullRequest(&WorktreeInfo{Branch: "feature-branch"}, PullRequestRequest{Title: "Add login"})
+	require.NoError(t, err)
+	assert.Equal(t, "Branch: feature-branch", client.requests[0].Description)
+}
@@ -2,11 +2,13 @@ package git
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"os"
 	"os/exec"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -59,6 +61,38 @@ func (g *GitCmd) ExecuteWithInput(dir, input string, args ...string) (string, er
 	return strings.TrimSpace(string(output)), nil
 }
 
+// ExecuteRaw runs a git command and returns its standard output untrimmed,
+// for output whose leading or trailing whitespace is significant
+func (g *GitCmd) ExecuteRaw(dir string, args ...string) (string, error) {
+	cmd := exec.Command(g.gitPath, args...)
+	if dir != "" {
+		cmd.Dir = dir
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git command failed: %s: %w", stderr.String(), err)
+	}
+	return string(output), nil
+}
+
+// rawExecutor is implemented by git interfaces that can return command
+// output without trimming it
+type rawExecutor interface {
+	ExecuteRaw(dir string, args ...string) (string, error)
+}
+
+// executeRaw runs a git command with its output untrimmed when gitCmd
+// supports it, falling back to Execute otherwise
+func executeRaw(gitCmd GitInterface, dir string, args ...string) (string, error) {
+	if raw, ok := gitCmd.(rawExecutor); ok {
+		return raw.ExecuteRaw(dir, args...)
+	}
+	return gitCmd.Execute(dir, args...)
+}
+
 // Repository represents a git repository
 type Repository struct {
 	Path          string
@@ -86,6 +120,7 @@ type WorktreeInfo struct {
 	Path           string
 	Branch         string
 	Head           string
+	Detached       bool // HEAD is not on a branch, so Branch is empty
 	IsClean        bool
 	HasUncommitted bool
 	LastCommit     CommitInfo
@@ -150,6 +185,54 @@ func (rm *RepositoryManager) DetectRepository(path string) (*Repository, error)
 	return repo, nil
 }
 
+// RepositoryCache detects the repository containing a directory once and
+// reuses it until asked about a different directory
+type RepositoryCache struct {
+	mu      sync.Mutex
+	repoMgr *RepositoryManager
+	dir     string
+	repo    *Repository
+}
+
+// NewRepositoryCache creates a RepositoryCache that runs git through gitCmd
+func NewRepositoryCache(gitCmd GitInterface) *RepositoryCache {
+	return &RepositoryCache{repoMgr: NewRepositoryManager(gitCmd)}
+}
+
+// GitCmd returns the git command shared by everything using the cache
+func (c *RepositoryCache) GitCmd() GitInterface {
+	return c.repoMgr.gitCmd
+}
+
+// Detect returns the repository containing dir. Detection only runs again
+// when dir differs from the last successful call; failures are not cached.
+func (c *RepositoryCache) Detect(dir string) (*Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.repo != nil && c.dir == dir {
+		return c.repo, nil
+	}
+
+	repo, err := c.repoMgr.DetectRepository(dir)
+	if err != nil {
+		c.dir, c.repo = "", nil
+		return nil, err
+	}
+
+	c.dir, c.repo = dir, repo
+	return repo, nil
+}
+
+// Invalidate forgets the cached repository, so the next Detect reads the
+// repository's branch, status and remotes again
+func (c *RepositoryCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dir, c.repo = "", nil
+}
+
 // IsGitRepository checks if the given path is within a git repository
 func (rm *RepositoryManager) IsGitRepository(path string) bool {
 	_, err := rm.gitCmd.Execute(path, "rev-parse", "--git-dir")
@@ -422,6 +505,8 @@ func (rm *RepositoryManager) getWorktrees(repo *Repository) ([]WorktreeInfo, err
 			current.Head = strings.TrimPrefix(line, "HEAD ")
 		} else if strings.HasPrefix(line, "branch ") {
 			current.Branch = strings.TrimPrefix(line, "branch refs/heads/")
+		} else if line == "detached" {
+			current.Detached = true
 		}
 	}
 
@@ -17,6 +17,7 @@ type MockGitCmd struct {
 	mock.Mock
 	commands map[string]string
 	errors   map[string]error
+	executed []string
 }
 
 func NewMockGitCmd() *MockGitCmd {
@@ -28,6 +29,7 @@ func NewMockGitCmd() *MockGitCmd {
 
 func (m *MockGitCmd) Execute(dir string, args ...string) (string, error) {
 	key := strings.Join(args, " ")
+	m.executed = append(m.executed, key)
 
 	if err, exists := m.errors[key]; exists {
 		return "", err
@@ -52,6 +54,16 @@ func (m *MockGitCmd) SetError(args string, err error) {
 	m.errors[args] = err
 }
 
+// WasExecuted reports whether the command with the given args was run
+func (m *MockGitCmd) WasExecuted(args string) bool {
+	for _, key := range m.executed {
+		if key == args {
+			return true
+		}
+	}
+	return false
+}
+
 func TestNewRepositoryManager(t *testing.T) {
 	// Test with nil git command
 	rm := NewRepositoryManager(nil)
@@ -103,6 +115,56 @@ func TestDetectRepository(t *testing.T) {
 	assert.Equal(t, "origin", repo.Remotes[0].Name)
 }
 
+func TestRepositoryCache_Detect(t *testing.T) {
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("rev-parse --show-toplevel", "/home/user/repo")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "")
+	mockGit.SetCommand("worktree list --porcelain", "")
+
+	detections := func() int {
+		count := 0
+		for _, key := range mockGit.executed {
+			if key == "rev-parse --git-dir" {
+				count++
+			}
+		}
+		return count
+	}
+
+	cache := NewRepositoryCache(mockGit)
+	assert.Same(t, mockGit, cache.GitCmd())
+
+	first, err := cache.Detect("/home/user/repo")
+	require.NoError(t, err)
+	second, err := cache.Detect("/home/user/repo")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, detections(), "the same directory is detected once")
+
+	third, err := cache.Detect("/home/user/repo/sub")
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+	assert.Equal(t, 2, detections(), "a new directory is detected again")
+
+	mockGit.SetError("rev-parse --git-dir", fmt.Errorf("not a git repository"))
+	_, err = cache.Detect("/tmp/elsewhere")
+	assert.Error(t, err)
+	_, err = cache.Detect("/tmp/elsewhere")
+	assert.Error(t, err)
+	assert.Equal(t, 4, detections(), "failures are not cached")
+
+	delete(mockGit.errors, "rev-parse --git-dir")
+	_, err = cache.Detect("/home/user/repo")
+	require.NoError(t, err)
+	cache.Invalidate()
+	_, err = cache.Detect("/home/user/repo")
+	require.NoError(t, err)
+	assert.Equal(t, 6, detections(), "an invalidated repository is detected again")
+}
+
 func TestDetectRepository_NotGitRepo(t *testing.T) {
 	mockGit := NewMockGitCmd()
 	rm := NewRepositoryManager(mockGit)
@@ -0,0 +1,84 @@
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+var (
+	// invalidSessionChars matches characters tmux does not allow, or that make
+	// session names awkward to target, such as '.' and ':'
+	invalidSessionChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+	// invalidSessionComponentChars additionally matches '-', which separates
+	// the components of a session name
+	invalidSessionComponentChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+)
+
+// SessionPatternManager generates tmux session names from the configured
+// naming pattern, using the same template engine as PatternManager
+type SessionPatternManager struct {
+	config *config.TmuxConfig
+}
+
+// NewSessionPatternManager creates a new SessionPatternManager
+func NewSessionPatternManager(cfg *config.TmuxConfig) *SessionPatternManager {
+	if cfg == nil {
+		cfg = &config.TmuxConfig{}
+	}
+	defaults := *cfg
+	defaults.SetDefaults()
+	return &SessionPatternManager{config: &defaults}
+}
+
+// GenerateSessionName renders the naming pattern for a worktree. SessionPrefix
+// is available as {{.Prefix}}; every component is sanitized for tmux and the
+// result is truncated to MaxSessionName.
+func (sm *SessionPatternManager) GenerateSessionName(project, worktree, branch string) (string, error) {
+	tmpl, err := createPatternTemplate(sm.config.NamingPattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid tmux naming pattern: %w", err)
+	}
+
+	context := PatternContext{
+		Prefix:   sanitizeSessionComponent(sm.config.SessionPrefix),
+		Project:  sanitizeSessionComponent(project),
+		Worktree: sanitizeSessionComponent(worktree),
+		Branch:   sanitizeSessionComponent(branch),
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, context); err != nil {
+		return "", fmt.Errorf("failed to execute tmux naming pattern: %w", err)
+	}
+
+	name := invalidSessionChars.ReplaceAllString(strings.TrimSpace(buf.String()), "_")
+	name = truncateSessionName(strings.Trim(name, "_-"), sm.config.MaxSessionName)
+	if name == "" {
+		return "", fmt.Errorf("tmux naming pattern %q produced an empty session name", sm.config.NamingPattern)
+	}
+	return name, nil
+}
+
+// sanitizeSessionComponent replaces characters that are not valid in a
+// session name component with underscores, matching tmux.SanitizeNameComponent
+// so generated names can be parsed back
+func sanitizeSessionComponent(component string) string {
+	sanitized := invalidSessionComponentChars.ReplaceAllString(component, "_")
+	sanitized = strings.Trim(sanitized, "_")
+	if sanitized == "" {
+		return "unnamed"
+	}
+	return sanitized
+}
+
+// truncateSessionName cuts name to maxLength without leaving a trailing
+// separator. A maxLength of zero or less disables truncation.
+func truncateSessionName(name string, maxLength int) string {
+	if maxLength <= 0 || len(name) <= maxLength {
+		return name
+	}
+	return strings.TrimRight(name[:maxLength], "-_")
+}
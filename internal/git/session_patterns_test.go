@@ -0,0 +1,60 @@
+package git
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+)
+
+func TestSessionPatternManager_DefaultPattern(t *testing.T) {
+	sm := NewSessionPatternManager(nil)
+
+	name, err := sm.GenerateSessionName("myproject", "myproject-feature", "feature")
+	require.NoError(t, err)
+	assert.Equal(t, "ccmgr-myproject-myproject_feature-feature", name)
+
+	name, err = sm.GenerateSessionName("api", "wt", "feature/login:v1.2")
+	require.NoError(t, err)
+	assert.Equal(t, "ccmgr-api-wt-feature_login_v1_2", name)
+
+	project, worktree, branch, err := tmux.ParseSessionName(name)
+	require.NoError(t, err, "generated names can be parsed back")
+	assert.Equal(t, "api", project)
+	assert.Equal(t, "wt", worktree)
+	assert.Equal(t, "feature_login_v1_2", branch)
+}
+
+func TestSessionPatternManager_PrefixAndPattern(t *testing.T) {
+	sm := NewSessionPatternManager(&config.TmuxConfig{
+		SessionPrefix: "work",
+		NamingPattern: "{{.prefix}}-{{.branch | lower}}",
+	})
+
+	name, err := sm.GenerateSessionName("api", "wt", "Fix.Bug")
+	require.NoError(t, err)
+	assert.Equal(t, "work-fix_bug", name)
+
+	_, err = NewSessionPatternManager(&config.TmuxConfig{NamingPattern: "{{.Prefix"}).GenerateSessionName("api", "wt", "main")
+	assert.Error(t, err)
+}
+
+func TestSessionPatternManager_MaxSessionName(t *testing.T) {
+	branch := strings.Repeat("long-branch-", 10)
+
+	name, err := NewSessionPatternManager(nil).GenerateSessionName("project", "worktree", branch)
+	require.NoError(t, err)
+	assert.Len(t, name, 50, "default maximum applies")
+	assert.True(t, strings.HasPrefix(name, "ccmgr-project-worktree-long_branch"))
+
+	name, err = NewSessionPatternManager(&config.TmuxConfig{MaxSessionName: 18}).GenerateSessionName("project", "worktree", "main")
+	require.NoError(t, err)
+	assert.Equal(t, "ccmgr-project-work", name)
+
+	name, err = NewSessionPatternManager(&config.TmuxConfig{MaxSessionName: 14}).GenerateSessionName("project", "worktree", "main")
+	require.NoError(t, err)
+	assert.Equal(t, "ccmgr-project", name, "no trailing separator after truncation")
+}
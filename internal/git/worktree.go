@@ -1,22 +1,35 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/hooks"
 )
 
+// ErrUncommittedChanges is returned when deleting a dirty worktree while
+// git.require_clean_workdir is set and the deletion is not forced
+var ErrUncommittedChanges = errors.New("worktree has uncommitted changes, use force to delete anyway")
+
+// ErrDetachedHead is returned when an operation needs the branch of a
+// worktree whose HEAD is detached
+var ErrDetachedHead = errors.New("worktree has a detached HEAD")
+
 // WorktreeManager handles git worktree operations
 type WorktreeManager struct {
-	repo       *Repository
-	patternMgr *PatternManager
-	gitCmd     GitInterface
-	config     *config.Config
-	repoMgr    *RepositoryManager
+	repo        *Repository
+	patternMgr  *PatternManager
+	gitCmd      GitInterface
+	config      *config.Config
+	repoMgr     *RepositoryManager
+	lockTimeout time.Duration
+	hooks       *hooks.WorktreeHookManager
 }
 
 // WorktreeOptions for worktree creation
@@ -28,7 +41,12 @@ type WorktreeOptions struct {
 	Checkout     bool
 	Remote       string
 	TrackRemote  bool
-	AutoName     bool // Use pattern manager for naming
+	Track        string // Remote branch, such as origin/feature, a new branch tracks
+	AutoName     bool   // Use pattern manager for naming
+	// Append -2, -3, ... to a generated path that already exists
+	SuffixOnCollision bool
+	// Use Path as given, without consulting the base directory or pattern
+	NoAutoDirectory bool
 }
 
 // NewWorktreeManager creates a new WorktreeManager
@@ -51,74 +69,136 @@ func NewWorktreeManager(repo *Repository, config *config.Config, gitCmd GitInter
 	patternMgr := NewPatternManager(&worktreeConfig)
 
 	return &WorktreeManager{
-		repo:       repo,
-		patternMgr: patternMgr,
-		gitCmd:     gitCmd,
-		config:     config,
-		repoMgr:    repoMgr,
+		repo:        repo,
+		patternMgr:  patternMgr,
+		gitCmd:      gitCmd,
+		config:      config,
+		repoMgr:     repoMgr,
+		lockTimeout: DefaultLockTimeout,
+		hooks:       hooks.NewWorktreeHookManager(hooks.NewDefaultExecutor(config)),
 	}
 }
 
-// CreateWorktree creates a new git worktree
+// Repository returns the repository the manager creates worktrees in
+func (wm *WorktreeManager) Repository() *Repository {
+	return wm.repo
+}
+
+// BaseDirectory returns the absolute directory generated worktree paths of
+// this repository are placed in
+func (wm *WorktreeManager) BaseDirectory() (string, error) {
+	return wm.patternMgr.ResolveBaseDirectory(wm.getProjectName())
+}
+
+// ListBranches lists the local branches a worktree can be created for
+func (wm *WorktreeManager) ListBranches() ([]BranchInfo, error) {
+	return NewGitOperations(wm.repo, wm.gitCmd).ListBranches(false)
+}
+
+// SetLockTimeout sets how long mutating operations wait for the repository lock
+func (wm *WorktreeManager) SetLockTimeout(timeout time.Duration) {
+	wm.lockTimeout = timeout
+}
+
+// CreateWorktree creates a new git worktree, resuming an earlier creation of
+// the same branch that was interrupted after the worktree was added
 func (wm *WorktreeManager) CreateWorktree(branch string, opts WorktreeOptions) (*WorktreeInfo, error) {
+	creation, err := wm.BeginWorktreeCreation(branch, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := creation.Commit(); err != nil {
+		return nil, err
+	}
+
+	return creation.Info, nil
+}
+
+// createWorktree adds the worktree and marks its creation as pending. It
+// reports whether the branch was created so a rollback can delete it.
+func (wm *WorktreeManager) createWorktree(branch string, opts WorktreeOptions) (*WorktreeInfo, bool, error) {
 	if branch == "" {
-		return nil, fmt.Errorf("branch name cannot be empty")
+		return nil, false, fmt.Errorf("branch name cannot be empty")
+	}
+
+	unlock, err := wm.lockRepository()
+	if err != nil {
+		return nil, false, err
 	}
+	defer unlock()
 
 	// Validate repository state
 	if err := wm.repoMgr.ValidateRepositoryState(wm.repo); err != nil {
-		return nil, fmt.Errorf("repository validation failed: %w", err)
+		return nil, false, fmt.Errorf("repository validation failed: %w", err)
 	}
 
-	// Validate base directory configuration
-	if err := wm.patternMgr.ValidateBaseDirectory(wm.patternMgr.config.BaseDirectory, wm.repo.RootPath); err != nil {
-		return nil, fmt.Errorf("invalid base directory configuration: %w", err)
+	// Validate base directory configuration, which an explicit path bypasses
+	if opts.NoAutoDirectory {
+		if opts.Path == "" {
+			return nil, false, fmt.Errorf("an explicit worktree path is required when auto directory is disabled")
+		}
+	} else if err := wm.patternMgr.ValidateBaseDirectory(wm.patternMgr.config.BaseDirectory, wm.repo.RootPath); err != nil {
+		return nil, false, fmt.Errorf("invalid base directory configuration: %w", err)
 	}
 
 	// Determine target path
 	targetPath := opts.Path
-	if targetPath == "" || opts.AutoName {
+	if !opts.NoAutoDirectory && (targetPath == "" || opts.AutoName) {
 		projectName := wm.getProjectName()
 		generatedPath, err := wm.patternMgr.GenerateWorktreePath(branch, projectName)
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate worktree path: %w", err)
+			return nil, false, fmt.Errorf("failed to generate worktree path: %w", err)
 		}
 		if targetPath == "" {
-			targetPath = generatedPath
+			targetPath, err = wm.suffixGeneratedPath(generatedPath, opts)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to generate worktree path: %w", err)
+			}
 		}
 	}
 
 	// Validate target path
 	if err := wm.validateWorktreePath(targetPath); err != nil {
-		return nil, fmt.Errorf("invalid worktree path: %w", err)
+		return nil, false, fmt.Errorf("invalid worktree path: %w", wm.describeGeneratedPath(err, opts.Path == ""))
 	}
 
 	// Check if path is available
 	if err := wm.patternMgr.CheckPathAvailable(targetPath); err != nil && !opts.Force {
-		return nil, fmt.Errorf("path not available: %w", err)
+		return nil, false, fmt.Errorf("path not available: %w", err)
 	}
 
 	// Check if branch already has a worktree
 	if err := wm.checkBranchWorktreeConflict(branch); err != nil && !opts.Force {
-		return nil, fmt.Errorf("branch conflict: %w", err)
+		return nil, false, fmt.Errorf("branch conflict: %w", err)
 	}
 
 	// Create branch if needed
+	createdBranch := false
 	if opts.CreateBranch {
-		if err := wm.createBranchForWorktree(branch, opts); err != nil {
-			return nil, fmt.Errorf("failed to create branch: %w", err)
+		created, err := wm.createBranchForWorktree(branch, opts)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create branch: %w", err)
 		}
+		createdBranch = created
 	}
 
 	// Create the worktree
 	if err := wm.executeWorktreeCreate(targetPath, branch, opts); err != nil {
-		return nil, fmt.Errorf("failed to create worktree: %w", err)
+		if createdBranch {
+			wm.gitCmd.Execute(wm.repo.RootPath, "branch", "-D", branch)
+		}
+		return nil, false, fmt.Errorf("failed to create worktree: %w", err)
 	}
+	markPendingCreation(targetPath, createdBranch)
 
 	// Get worktree information
 	worktreeInfo, err := wm.GetWorktreeInfo(targetPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get worktree info: %w", err)
+		if rollbackErr := wm.removeCreatedWorktree(targetPath, branch, createdBranch); rollbackErr != nil {
+			return nil, false, fmt.Errorf("failed to get worktree info: %w (rollback failed: %v)", err, rollbackErr)
+		}
+		return nil, false, fmt.Errorf("failed to get worktree info: %w", err)
 	}
 
 	// Create tmux session if configured
@@ -129,7 +209,7 @@ func (wm *WorktreeManager) CreateWorktree(branch string, opts WorktreeOptions) (
 		}
 	}
 
-	return worktreeInfo, nil
+	return worktreeInfo, createdBranch, nil
 }
 
 // ListWorktrees lists all worktrees in the repository
@@ -158,28 +238,67 @@ func (wm *WorktreeManager) ListWorktrees() ([]WorktreeInfo, error) {
 
 // DeleteWorktree deletes a git worktree
 func (wm *WorktreeManager) DeleteWorktree(path string, force bool) error {
+	_, err := wm.DeleteWorktreeWithBackup(path, force)
+	return err
+}
+
+// DeleteOptions configures DeleteWorktreeWithOptions
+type DeleteOptions struct {
+	Force          bool // remove the worktree even with uncommitted changes
+	AllowProtected bool // remove a protected branch's worktree when git.allow_force_delete permits
+}
+
+// DeleteWorktreeWithBackup removes a worktree and returns the path of the
+// archive holding its uncommitted changes, or "" when no backup was made.
+// force overrides both the clean workdir requirement and branch protection.
+func (wm *WorktreeManager) DeleteWorktreeWithBackup(path string, force bool) (string, error) {
+	return wm.DeleteWorktreeWithOptions(path, DeleteOptions{Force: force, AllowProtected: force})
+}
+
+// DeleteWorktreeWithOptions removes a worktree and returns the path of the
+// archive holding its uncommitted changes, or "" when no backup was made.
+// With git.require_clean_workdir a dirty worktree is only removed with
+// opts.Force; with git.backup_on_delete its changes are archived first.
+func (wm *WorktreeManager) DeleteWorktreeWithOptions(path string, opts DeleteOptions) (string, error) {
+	force := opts.Force
 	if path == "" {
-		return fmt.Errorf("worktree path cannot be empty")
+		return "", fmt.Errorf("worktree path cannot be empty")
+	}
+
+	unlock, err := wm.lockRepository()
+	if err != nil {
+		return "", err
 	}
+	defer unlock()
 
 	// Get worktree info before deletion
 	worktreeInfo, err := wm.GetWorktreeInfo(path)
 	if err != nil {
 		if !force {
-			return fmt.Errorf("failed to get worktree info: %w", err)
+			return "", fmt.Errorf("failed to get worktree info: %w", err)
 		}
 		// Continue with deletion even if we can't get info
 	}
 
+	// Refuse to delete worktrees on protected branches
+	if worktreeInfo != nil {
+		if err := wm.CheckProtectedBranch(worktreeInfo.Branch, opts.AllowProtected); err != nil {
+			return "", err
+		}
+	}
+
 	// Check if worktree has uncommitted changes
-	if worktreeInfo != nil && worktreeInfo.HasUncommitted && !force {
-		return fmt.Errorf("worktree has uncommitted changes, use force to delete anyway")
+	dirty := worktreeInfo != nil && worktreeInfo.HasUncommitted
+	if dirty && wm.config.Git.RequireCleanWorkdir && !force {
+		return "", ErrUncommittedChanges
 	}
 
-	// Backup worktree if configured
-	if wm.config.Worktree.CleanupOnMerge {
-		if err := wm.backupWorktree(path); err != nil {
-			fmt.Printf("Warning: failed to backup worktree: %v\n", err)
+	// Archive uncommitted changes so nothing is lost
+	var backupPath string
+	if dirty && wm.config.Git.BackupOnDelete {
+		backupPath, err = wm.backupWorktree(worktreeInfo)
+		if err != nil {
+			return "", fmt.Errorf("failed to back up uncommitted changes: %w", err)
 		}
 	}
 
@@ -190,15 +309,15 @@ func (wm *WorktreeManager) DeleteWorktree(path string, force bool) error {
 		}
 	}
 
-	// Execute worktree removal
+	// Execute worktree removal; git refuses dirty worktrees without --force
 	args := []string{"worktree", "remove"}
-	if force {
+	if force || dirty {
 		args = append(args, "--force")
 	}
 	args = append(args, path)
 
 	if _, err := wm.gitCmd.Execute(wm.repo.RootPath, args...); err != nil {
-		return fmt.Errorf("failed to remove worktree: %w", err)
+		return backupPath, fmt.Errorf("failed to remove worktree: %w", err)
 	}
 
 	// Clean up any remaining directory if it exists
@@ -208,7 +327,35 @@ func (wm *WorktreeManager) DeleteWorktree(path string, force bool) error {
 		}
 	}
 
-	return nil
+	return backupPath, nil
+}
+
+// ProtectedBranchError is returned when a destructive operation targets a
+// protected branch
+type ProtectedBranchError struct {
+	Branch       string
+	ForceAllowed bool // git.allow_force_delete lets force override the protection
+}
+
+// Error implements the error interface
+func (e *ProtectedBranchError) Error() string {
+	return fmt.Sprintf("branch '%s' is protected", e.Branch)
+}
+
+// IsProtectedBranch reports whether branch is one of the configured protected
+// branches
+func (wm *WorktreeManager) IsProtectedBranch(branch string) bool {
+	return branch != "" && slices.Contains(wm.config.Git.ProtectedBranches, branch)
+}
+
+// CheckProtectedBranch returns a *ProtectedBranchError when branch is
+// protected, unless force is set and git.allow_force_delete permits
+// overriding the protection
+func (wm *WorktreeManager) CheckProtectedBranch(branch string, force bool) error {
+	if !wm.IsProtectedBranch(branch) || (force && wm.config.Git.AllowForceDelete) {
+		return nil
+	}
+	return &ProtectedBranchError{Branch: branch, ForceAllowed: wm.config.Git.AllowForceDelete}
 }
 
 // GetWorktreeInfo gets detailed information about a specific worktree
@@ -232,17 +379,16 @@ func (wm *WorktreeManager) GetWorktreeInfo(path string) (*WorktreeInfo, error) {
 		Path: path,
 	}
 
-	// Get current branch
-	branch, err := wm.gitCmd.Execute(path, "branch", "--show-current")
+	// Get current branch; symbolic-ref fails when HEAD is detached
+	branch, err := wm.gitCmd.Execute(path, "symbolic-ref", "--quiet", "--short", "HEAD")
 	if err != nil {
-		// Try alternative method for detached HEAD
-		head, err2 := wm.gitCmd.Execute(path, "rev-parse", "--abbrev-ref", "HEAD")
-		if err2 != nil {
+		if _, err2 := wm.gitCmd.Execute(path, "rev-parse", "--verify", "--quiet", "HEAD"); err2 != nil {
 			return nil, fmt.Errorf("failed to get current branch: %w", err)
 		}
-		branch = head
+		worktreeInfo.Detached = true
+	} else {
+		worktreeInfo.Branch = branch
 	}
-	worktreeInfo.Branch = branch
 
 	// Get HEAD commit
 	head, err := wm.gitCmd.Execute(path, "rev-parse", "HEAD")
@@ -266,7 +412,13 @@ func (wm *WorktreeManager) RefreshWorktreeStatus(path string) (*WorktreeInfo, er
 
 // PruneWorktrees removes stale worktree references
 func (wm *WorktreeManager) PruneWorktrees() error {
-	_, err := wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "prune")
+	unlock, err := wm.lockRepository()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	_, err = wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "prune")
 	if err != nil {
 		return fmt.Errorf("failed to prune worktrees: %w", err)
 	}
@@ -280,13 +432,19 @@ func (wm *WorktreeManager) MoveWorktree(oldPath, newPath string) error {
 		return fmt.Errorf("both old and new paths must be specified")
 	}
 
+	unlock, err := wm.lockRepository()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	// Check if new path is available
 	if err := wm.patternMgr.CheckPathAvailable(newPath); err != nil {
 		return fmt.Errorf("new path not available: %w", err)
 	}
 
 	// Execute worktree move
-	_, err := wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "move", oldPath, newPath)
+	_, err = wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "move", oldPath, newPath)
 	if err != nil {
 		return fmt.Errorf("failed to move worktree: %w", err)
 	}
@@ -296,6 +454,81 @@ func (wm *WorktreeManager) MoveWorktree(oldPath, newPath string) error {
 
 // Internal helper methods
 
+// lockRepository acquires the repository lock for an operation that modifies
+// worktree state and returns the function that releases it. Read operations do
+// not take the lock. When the repository has no git directory on disk there is
+// no shared state to protect and locking is skipped.
+func (wm *WorktreeManager) lockRepository() (func(), error) {
+	gitDir, err := resolveGitCommonDir(wm.repo.RootPath)
+	if err != nil {
+		return func() {}, nil
+	}
+
+	lock, err := AcquireRepoLock(gitDir, wm.lockTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := lock.Release(); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+	}, nil
+}
+
+// ResolveWorktreePath returns the path a worktree for branch would be created
+// at with opts and checks that it could be created, without changing the
+// repository or the filesystem
+func (wm *WorktreeManager) ResolveWorktreePath(branch string, opts WorktreeOptions) (string, error) {
+	if branch == "" {
+		return "", fmt.Errorf("branch name cannot be empty")
+	}
+
+	if opts.NoAutoDirectory {
+		if opts.Path == "" {
+			return "", fmt.Errorf("an explicit worktree path is required when auto directory is disabled")
+		}
+	} else if err := wm.patternMgr.ValidateBaseDirectory(wm.patternMgr.config.BaseDirectory, wm.repo.RootPath); err != nil {
+		return "", fmt.Errorf("invalid base directory configuration: %w", err)
+	}
+
+	targetPath := opts.Path
+	if targetPath == "" {
+		generatedPath, err := wm.patternMgr.GenerateWorktreePathDryRun(branch, wm.getProjectName())
+		if err != nil {
+			return "", fmt.Errorf("failed to generate worktree path: %w", err)
+		}
+		targetPath, err = wm.suffixGeneratedPath(generatedPath, opts)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate worktree path: %w", err)
+		}
+	}
+
+	if err := wm.validateWorktreePath(targetPath); err != nil {
+		return "", fmt.Errorf("invalid worktree path: %w", wm.describeGeneratedPath(err, opts.Path == ""))
+	}
+
+	if !opts.Force {
+		if _, err := os.Stat(targetPath); err == nil {
+			return "", fmt.Errorf("path not available: path already exists: %s", targetPath)
+		}
+		if err := wm.checkBranchWorktreeConflict(branch); err != nil {
+			return "", fmt.Errorf("branch conflict: %w", err)
+		}
+	}
+
+	return targetPath, nil
+}
+
+// suffixGeneratedPath moves a generated path that already exists to the next
+// free suffixed path when collision suffixing is enabled
+func (wm *WorktreeManager) suffixGeneratedPath(path string, opts WorktreeOptions) (string, error) {
+	if !opts.SuffixOnCollision && !wm.patternMgr.config.AutoSuffixOnCollision {
+		return path, nil
+	}
+	return wm.patternMgr.NextAvailablePath(path)
+}
+
 // getProjectName extracts the project name from the repository
 func (wm *WorktreeManager) getProjectName() string {
 	if wm.repo.Origin != "" {
@@ -334,12 +567,24 @@ func (wm *WorktreeManager) validateWorktreePath(path string) error {
 	}
 
 	if strings.HasPrefix(absPath, repoPath) {
-		return fmt.Errorf("worktree path cannot be inside repository: %s", path)
+		return &InsideRepositoryError{Subject: "worktree path", Path: absPath, RepoRoot: repoPath}
 	}
 
 	return nil
 }
 
+// describeGeneratedPath adds the base directory and pattern a generated
+// worktree path was built from to an InsideRepositoryError
+func (wm *WorktreeManager) describeGeneratedPath(err error, generated bool) error {
+	var insideErr *InsideRepositoryError
+	if generated && errors.As(err, &insideErr) {
+		insideErr.BaseDirectory = wm.patternMgr.config.BaseDirectory
+		insideErr.ResolvedBaseDir = filepath.Dir(insideErr.Path)
+		insideErr.DirectoryPattern = wm.patternMgr.config.DirectoryPattern
+	}
+	return err
+}
+
 // checkBranchWorktreeConflict checks if a branch is already used by another worktree
 func (wm *WorktreeManager) checkBranchWorktreeConflict(branch string) error {
 	worktrees, err := wm.repoMgr.getWorktrees(wm.repo)
@@ -356,13 +601,21 @@ func (wm *WorktreeManager) checkBranchWorktreeConflict(branch string) error {
 	return nil
 }
 
-// createBranchForWorktree creates a new branch for the worktree
-func (wm *WorktreeManager) createBranchForWorktree(branch string, opts WorktreeOptions) error {
+// createBranchForWorktree creates a new branch for the worktree, reporting
+// whether the branch had to be created
+func (wm *WorktreeManager) createBranchForWorktree(branch string, opts WorktreeOptions) (bool, error) {
+	if opts.Track != "" {
+		if err := NewGitOperations(wm.repo, wm.gitCmd).CreateTrackingBranch(branch, opts.Track); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
 	// Check if branch already exists
 	_, err := wm.gitCmd.Execute(wm.repo.RootPath, "rev-parse", "--verify", branch)
 	if err == nil {
 		// Branch exists, don't create
-		return nil
+		return false, nil
 	}
 
 	// Determine source branch
@@ -374,10 +627,10 @@ func (wm *WorktreeManager) createBranchForWorktree(branch string, opts WorktreeO
 	// Create branch
 	_, err = wm.gitCmd.Execute(wm.repo.RootPath, "branch", branch, sourceBranch)
 	if err != nil {
-		return fmt.Errorf("failed to create branch %s from %s: %w", branch, sourceBranch, err)
+		return false, fmt.Errorf("failed to create branch %s from %s: %w", branch, sourceBranch, err)
 	}
 
-	return nil
+	return true, nil
 }
 
 // executeWorktreeCreate executes the git worktree add command
@@ -442,31 +695,26 @@ func (wm *WorktreeManager) getTmuxSessionName(wt *WorktreeInfo) string {
 		return ""
 	}
 
-	// Generate session name based on tmux naming pattern
-	context := map[string]string{
-		"prefix":   wm.config.Tmux.SessionPrefix,
-		"project":  wm.getProjectName(),
-		"worktree": filepath.Base(wt.Path),
-		"branch":   wt.Branch,
-	}
-
-	// Simple pattern substitution for tmux session names
-	sessionName := wm.config.Tmux.NamingPattern
-	for key, value := range context {
-		placeholder := fmt.Sprintf("{{.%s}}", key)
-		sessionName = strings.ReplaceAll(sessionName, placeholder, value)
+	sessionName, err := wm.SessionName(wt.Path, wt.Branch)
+	if err != nil {
+		return ""
 	}
+	return sessionName
+}
 
-	// Sanitize session name
-	sessionName = strings.ReplaceAll(sessionName, " ", "-")
-	sessionName = strings.ReplaceAll(sessionName, "/", "-")
-
-	// Truncate if too long
-	if len(sessionName) > wm.config.Tmux.MaxSessionName {
-		sessionName = sessionName[:wm.config.Tmux.MaxSessionName]
-	}
+// ProjectName returns the project name used in worktree paths and session
+// names: the origin repository name, or the repository directory name
+func (wm *WorktreeManager) ProjectName() string {
+	return wm.getProjectName()
+}
 
-	return sessionName
+// SessionName generates the tmux session name for the worktree at
+// worktreePath from tmux.naming_pattern, with the project name, the worktree
+// directory name and branch. Every command naming a worktree session uses it,
+// so the names agree.
+func (wm *WorktreeManager) SessionName(worktreePath, branch string) (string, error) {
+	return NewSessionPatternManager(&wm.config.Tmux).GenerateSessionName(
+		wm.getProjectName(), filepath.Base(worktreePath), branch)
 }
 
 // createTmuxSession creates a tmux session for the worktree
@@ -490,25 +738,6 @@ func (wm *WorktreeManager) removeTmuxSession(sessionName string) error {
 	return nil
 }
 
-// backupWorktree creates a backup of the worktree before deletion
-func (wm *WorktreeManager) backupWorktree(path string) error {
-	// Create backup directory
-	backupDir := filepath.Join(os.TempDir(), "ccmgr-backups")
-	if err := os.MkdirAll(backupDir, 0755); err != nil {
-		return fmt.Errorf("failed to create backup directory: %w", err)
-	}
-
-	// Create timestamped backup name
-	timestamp := time.Now().Format("20060102-150405")
-	worktreeName := filepath.Base(path)
-	backupPath := filepath.Join(backupDir, fmt.Sprintf("%s-%s.tar.gz", worktreeName, timestamp))
-
-	// For now, just log the backup action
-	fmt.Printf("Backing up worktree %s to %s\n", path, backupPath)
-
-	return nil
-}
-
 // GetWorktreeStats returns statistics about worktrees
 func (wm *WorktreeManager) GetWorktreeStats() (*WorktreeStats, error) {
 	worktrees, err := wm.ListWorktrees()
@@ -0,0 +1,175 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PendingCreationMarker is written to a worktree's git directory while its
+// creation is in progress, so an interrupted create can be resumed
+const PendingCreationMarker = "ccmgr-create-pending"
+
+// createdBranchMarker is recorded in the pending marker when the branch was
+// created along with the worktree and should be deleted on rollback
+const createdBranchMarker = "created-branch"
+
+// WorktreeCreation is a worktree that has been added with `git worktree add`
+// but whose remaining creation steps (such as starting a session) have not all
+// completed. Callers finish it with Commit or undo it with Rollback.
+type WorktreeCreation struct {
+	Info    *WorktreeInfo
+	Resumed bool // true when an earlier, interrupted creation was picked up
+
+	wm            *WorktreeManager
+	createdBranch bool
+	finished      bool
+}
+
+// BeginWorktreeCreation adds a worktree for branch, runs the worktree creation
+// hook and returns it as a pending creation. If an earlier creation of the same
+// branch was interrupted after the worktree was added, that worktree is resumed
+// instead of failing on the conflict. A failing synchronous creation hook
+// removes the new worktree again.
+func (wm *WorktreeManager) BeginWorktreeCreation(branch string, opts WorktreeOptions) (*WorktreeCreation, error) {
+	if branch == "" {
+		return nil, fmt.Errorf("branch name cannot be empty")
+	}
+
+	if pending, createdBranch := wm.findPendingWorktree(branch); pending != nil {
+		return &WorktreeCreation{
+			Info:          pending,
+			Resumed:       true,
+			wm:            wm,
+			createdBranch: createdBranch,
+		}, nil
+	}
+
+	info, createdBranch, err := wm.createWorktree(branch, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	creation := &WorktreeCreation{Info: info, wm: wm, createdBranch: createdBranch}
+
+	if err := wm.hooks.OnWorktreeCreated(info.Path, branch, wm.repo.RootPath, wm.getProjectName()); err != nil {
+		hookErr := fmt.Errorf("worktree creation hook failed: %w", err)
+		if rollbackErr := creation.Rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf("%w (rollback failed: %v)", hookErr, rollbackErr)
+		}
+		return nil, hookErr
+	}
+
+	return creation, nil
+}
+
+// Commit marks the creation as complete
+func (c *WorktreeCreation) Commit() error {
+	if c.finished {
+		return nil
+	}
+	c.finished = true
+
+	clearPendingCreation(c.Info.Path)
+	return nil
+}
+
+// Rollback removes the partially created worktree, along with its branch if
+// the branch was created for it
+func (c *WorktreeCreation) Rollback() error {
+	if c.finished {
+		return nil
+	}
+	c.finished = true
+
+	unlock, err := c.wm.lockRepository()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return c.wm.removeCreatedWorktree(c.Info.Path, c.Info.Branch, c.createdBranch)
+}
+
+// removeCreatedWorktree removes a worktree added by createWorktree
+func (wm *WorktreeManager) removeCreatedWorktree(path, branch string, deleteBranch bool) error {
+	if _, err := wm.gitCmd.Execute(wm.repo.RootPath, "worktree", "remove", "--force", path); err != nil {
+		return fmt.Errorf("failed to remove partially created worktree %s: %w", path, err)
+	}
+
+	if deleteBranch {
+		if _, err := wm.gitCmd.Execute(wm.repo.RootPath, "branch", "-D", branch); err != nil {
+			return fmt.Errorf("failed to delete branch %s: %w", branch, err)
+		}
+	}
+
+	return nil
+}
+
+// findPendingWorktree returns the worktree of branch if its creation was left
+// pending, along with whether the branch was created for it
+func (wm *WorktreeManager) findPendingWorktree(branch string) (*WorktreeInfo, bool) {
+	worktrees, err := wm.repoMgr.getWorktrees(wm.repo)
+	if err != nil {
+		return nil, false
+	}
+
+	for i := range worktrees {
+		if worktrees[i].Branch != branch {
+			continue
+		}
+		if pending, createdBranch := readPendingCreation(worktrees[i].Path); pending {
+			return &worktrees[i], createdBranch
+		}
+	}
+
+	return nil, false
+}
+
+// markPendingCreation records that the worktree at path is being created.
+// Failing to write the marker only disables resuming, so errors are ignored.
+func markPendingCreation(path string, createdBranch bool) {
+	markerPath, err := pendingCreationPath(path)
+	if err != nil {
+		return
+	}
+
+	content := ""
+	if createdBranch {
+		content = createdBranchMarker + "\n"
+	}
+	os.WriteFile(markerPath, []byte(content), 0644)
+}
+
+// clearPendingCreation removes the pending marker of the worktree at path
+func clearPendingCreation(path string) {
+	if markerPath, err := pendingCreationPath(path); err == nil {
+		os.Remove(markerPath)
+	}
+}
+
+// readPendingCreation reports whether the worktree at path has a pending
+// marker and whether the marker records a created branch
+func readPendingCreation(path string) (bool, bool) {
+	markerPath, err := pendingCreationPath(path)
+	if err != nil {
+		return false, false
+	}
+
+	data, err := os.ReadFile(markerPath)
+	if err != nil {
+		return false, false
+	}
+
+	return true, strings.TrimSpace(string(data)) == createdBranchMarker
+}
+
+// pendingCreationPath returns the marker location inside the worktree's git directory
+func pendingCreationPath(path string) (string, error) {
+	gitDir, err := resolveWorktreeGitDir(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(gitDir, PendingCreationMarker), nil
+}
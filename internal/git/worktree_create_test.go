@@ -0,0 +1,293 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// createPendingWorktree lays out a linked worktree at a temp path whose git
+// directory carries a pending creation marker
+func createPendingWorktree(t *testing.T, createdBranch bool) string {
+	gitDir := filepath.Join(t.TempDir(), "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(gitDir, 0755))
+
+	worktreePath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, ".git"), []byte("gitdir: "+gitDir+"\n"), 0644))
+
+	markPendingCreation(worktreePath, createdBranch)
+	require.FileExists(t, filepath.Join(gitDir, PendingCreationMarker))
+	return worktreePath
+}
+
+func TestCreateWorktree_RollsBackWhenFailingAfterAdd(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+	worktreePath := filepath.Join(t.TempDir(), "new-feature")
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/main")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "origin\tgit@github.com:user/test-repo.git (fetch)")
+	mockGit.SetCommand("worktree list --porcelain", "")
+	mockGit.SetError("rev-parse --verify new-feature", fmt.Errorf("unknown revision"))
+	mockGit.SetCommand("branch new-feature main", "")
+	// The mocked add succeeds without creating the directory, so the
+	// follow-up worktree inspection fails
+	mockGit.SetCommand("worktree add "+worktreePath+" new-feature", "")
+	mockGit.SetCommand("worktree remove --force "+worktreePath, "")
+	mockGit.SetCommand("branch -D new-feature", "")
+
+	wm := NewWorktreeManager(repo, createTestConfig(), mockGit)
+	_, err := wm.CreateWorktree("new-feature", WorktreeOptions{Path: worktreePath, CreateBranch: true, Checkout: true})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get worktree info")
+	assert.True(t, mockGit.WasExecuted("worktree add "+worktreePath+" new-feature"))
+	assert.True(t, mockGit.WasExecuted("worktree remove --force "+worktreePath), "expected the partial worktree to be removed")
+	assert.True(t, mockGit.WasExecuted("branch -D new-feature"), "expected the new branch to be deleted")
+}
+
+func TestBeginWorktreeCreation_ResumesPendingWorktree(t *testing.T) {
+	worktreePath := createPendingWorktree(t, true)
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("worktree list --porcelain", fmt.Sprintf("worktree %s\nHEAD abc123\nbranch refs/heads/feature\n", worktreePath))
+	mockGit.SetCommand("worktree remove --force "+worktreePath, "")
+	mockGit.SetCommand("branch -D feature", "")
+
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+	wm := NewWorktreeManager(repo, createTestConfig(), mockGit)
+
+	creation, err := wm.BeginWorktreeCreation("feature", WorktreeOptions{CreateBranch: true})
+	require.NoError(t, err)
+	assert.True(t, creation.Resumed)
+	assert.Equal(t, worktreePath, creation.Info.Path)
+	assert.False(t, mockGit.WasExecuted("worktree add "+worktreePath+" feature"), "resuming must not add the worktree again")
+
+	// The branch was created by the interrupted run, so rollback deletes it
+	require.NoError(t, creation.Rollback())
+	assert.True(t, mockGit.WasExecuted("worktree remove --force "+worktreePath))
+	assert.True(t, mockGit.WasExecuted("branch -D feature"))
+}
+
+func TestBeginWorktreeCreation_IgnoresCompletedWorktree(t *testing.T) {
+	worktreePath := createPendingWorktree(t, false)
+	clearPendingCreation(worktreePath)
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("worktree list --porcelain", fmt.Sprintf("worktree %s\nHEAD abc123\nbranch refs/heads/feature\n", worktreePath))
+
+	wm := NewWorktreeManager(createTestRepository(), createTestConfig(), mockGit)
+	pending, _ := wm.findPendingWorktree("feature")
+	assert.Nil(t, pending)
+}
+
+func TestWorktreeCreation_CommitClearsMarker(t *testing.T) {
+	worktreePath := createPendingWorktree(t, false)
+
+	creation := &WorktreeCreation{
+		Info: &WorktreeInfo{Path: worktreePath, Branch: "feature"},
+		wm:   NewWorktreeManager(createTestRepository(), createTestConfig(), NewMockGitCmd()),
+	}
+	require.NoError(t, creation.Commit())
+
+	pending, _ := readPendingCreation(worktreePath)
+	assert.False(t, pending)
+
+	// Rollback after commit is a no-op
+	require.NoError(t, creation.Rollback())
+}
+
+// newHookTestManager returns a manager whose mocked `git worktree add` of
+// feature succeeds, with the creation hook running script synchronously
+func newHookTestManager(t *testing.T, script string) (*WorktreeManager, *MockGitCmd, string) {
+	worktreePath := t.TempDir()
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
+	mockGit.SetCommand("branch --show-current", "feature")
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/main")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "origin\tgit@github.com:user/test-repo.git (fetch)")
+	mockGit.SetCommand("worktree list --porcelain", "")
+	mockGit.SetCommand("rev-parse HEAD", "abc123")
+	mockGit.SetCommand("worktree add --force "+worktreePath+" feature", "")
+	mockGit.SetCommand("worktree remove --force "+worktreePath, "")
+
+	scriptPath := filepath.Join(t.TempDir(), "creation.sh")
+	require.NoError(t, os.WriteFile(scriptPath, []byte(script), 0755))
+
+	cfg := createTestConfig()
+	cfg.WorktreeHooks.Enabled = true
+	cfg.WorktreeHooks.CreationHook = config.HookConfig{Enabled: true, Script: scriptPath, Timeout: 10}
+
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+	return NewWorktreeManager(repo, cfg, mockGit), mockGit, worktreePath
+}
+
+func TestCreateWorktree_RunsCreationHook(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "env")
+	wm, _, worktreePath := newHookTestManager(t, "#!/bin/sh\nenv > "+envFile+"\n")
+
+	_, err := wm.CreateWorktree("feature", WorktreeOptions{Path: worktreePath, Force: true, Checkout: true})
+	require.NoError(t, err)
+
+	env, err := os.ReadFile(envFile)
+	require.NoError(t, err, "the creation hook did not run")
+	assert.Contains(t, string(env), "CCMGR_WORKTREE_PATH="+worktreePath+"\n")
+	assert.Contains(t, string(env), "CCMGR_BRANCH=feature\n")
+	assert.Contains(t, string(env), "CCMGR_PROJECT=test-repo\n")
+}
+
+func TestCreateWorktree_FailingCreationHookRollsBack(t *testing.T) {
+	wm, mockGit, worktreePath := newHookTestManager(t, "#!/bin/sh\necho 'npm install failed' >&2\nexit 3\n")
+
+	_, err := wm.CreateWorktree("feature", WorktreeOptions{Path: worktreePath, Force: true, Checkout: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worktree creation hook failed")
+	assert.Contains(t, err.Error(), "npm install failed")
+	assert.True(t, mockGit.WasExecuted("worktree remove --force "+worktreePath), "expected the worktree to be removed")
+}
+
+// addingGitCmd creates the worktree directory when `git worktree add` runs,
+// like git does
+type addingGitCmd struct {
+	*MockGitCmd
+}
+
+func (a *addingGitCmd) Execute(dir string, args ...string) (string, error) {
+	if len(args) > 2 && args[0] == "worktree" && args[1] == "add" {
+		path := args[len(args)-2]
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(filepath.Join(path, ".git"), []byte("gitdir: "+path+"\n"), 0644); err != nil {
+			return "", err
+		}
+	}
+	return a.MockGitCmd.Execute(dir, args...)
+}
+
+func TestCreateWorktree_SuffixesCollidingPath(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+
+	cfg := createTestConfig()
+	baseDir := filepath.Join(t.TempDir(), "worktrees")
+	cfg.Worktree.BaseDirectory = baseDir
+	cfg.Worktree.AutoSuffixOnCollision = true
+	firstPath := filepath.Join(baseDir, "test-repo-feature")
+	secondPath := firstPath + "-2"
+
+	mockGit := &addingGitCmd{MockGitCmd: NewMockGitCmd()}
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/main")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
+	mockGit.SetCommand("rev-parse HEAD", "abc123")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "origin\tgit@github.com:user/test-repo.git (fetch)")
+	mockGit.SetCommand("worktree list --porcelain", "")
+	mockGit.SetCommand("worktree add "+firstPath+" feature", "")
+	mockGit.SetCommand("worktree add "+secondPath+" feature", "")
+
+	wm := NewWorktreeManager(repo, cfg, mockGit)
+
+	first, err := wm.CreateWorktree("feature", WorktreeOptions{Checkout: true, AutoName: true})
+	require.NoError(t, err)
+	second, err := wm.CreateWorktree("feature", WorktreeOptions{Checkout: true, AutoName: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, firstPath, first.Path)
+	assert.Equal(t, secondPath, second.Path)
+}
+
+func TestCreateWorktree_CollidingPathFailsByDefault(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+
+	cfg := createTestConfig()
+	baseDir := filepath.Join(t.TempDir(), "worktrees")
+	cfg.Worktree.BaseDirectory = baseDir
+	require.NoError(t, os.MkdirAll(filepath.Join(baseDir, "test-repo-feature"), 0755))
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/main")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "origin\tgit@github.com:user/test-repo.git (fetch)")
+	mockGit.SetCommand("worktree list --porcelain", "")
+
+	wm := NewWorktreeManager(repo, cfg, mockGit)
+
+	_, err := wm.CreateWorktree("feature", WorktreeOptions{Checkout: true, AutoName: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "path already exists")
+}
+
+func TestCreateWorktree_NoAutoDirectorySkipsPattern(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+
+	// A base directory inside the repository fails validation
+	cfg := createTestConfig()
+	baseDir := filepath.Join(repo.RootPath, ".worktrees")
+	cfg.Worktree.BaseDirectory = baseDir
+	worktreePath := filepath.Join(t.TempDir(), "hotfix")
+
+	mockGit := &addingGitCmd{MockGitCmd: NewMockGitCmd()}
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/main")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "hotfix")
+	mockGit.SetCommand("rev-parse HEAD", "abc123")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "origin\tgit@github.com:user/test-repo.git (fetch)")
+	mockGit.SetCommand("worktree list --porcelain", "")
+	mockGit.SetCommand("worktree add "+worktreePath+" hotfix", "")
+
+	wm := NewWorktreeManager(repo, cfg, mockGit)
+
+	_, err := wm.CreateWorktree("hotfix", WorktreeOptions{Path: worktreePath, Checkout: true, AutoName: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid base directory configuration")
+
+	info, err := wm.CreateWorktree("hotfix", WorktreeOptions{Path: worktreePath, Checkout: true, AutoName: true, NoAutoDirectory: true})
+	require.NoError(t, err)
+	assert.Equal(t, worktreePath, info.Path)
+	assert.NoDirExists(t, baseDir, "the pattern path must not be generated")
+}
+
+func TestCreateWorktree_NoAutoDirectoryRequiresPath(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = createLockTestRepo(t)
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "")
+	mockGit.SetCommand("worktree list --porcelain", "")
+
+	wm := NewWorktreeManager(repo, createTestConfig(), mockGit)
+
+	_, err := wm.CreateWorktree("hotfix", WorktreeOptions{NoAutoDirectory: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "explicit worktree path is required")
+
+	_, err = wm.CreateWorktree("hotfix", WorktreeOptions{Path: filepath.Join(repo.RootPath, "hotfix"), NoAutoDirectory: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be inside repository")
+}
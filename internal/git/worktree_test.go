@@ -1,7 +1,11 @@
 package git
 
 import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -18,6 +22,7 @@ func createTestConfig() *config.Config {
 	cfg.Tmux.SessionPrefix = "test"
 	cfg.Tmux.NamingPattern = "{{.prefix}}-{{.project}}-{{.branch}}"
 	cfg.Tmux.MaxSessionName = 50
+	cfg.Git.RequireCleanWorkdir = true
 	return cfg
 }
 
@@ -216,6 +221,40 @@ branch refs/heads/feature`
 	assert.Equal(t, "feature", worktrees[1].Branch)
 }
 
+func TestListWorktrees_DetachedHead(t *testing.T) {
+	repo := createTestRepository()
+	repo.RootPath = t.TempDir()
+	cfg := createTestConfig()
+	mockGit := NewMockGitCmd()
+
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("branch --show-current", "main")
+	mockGit.SetCommand("symbolic-ref refs/remotes/origin/HEAD", "refs/remotes/origin/main")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("remote -v", "origin\tgit@github.com:user/test-repo.git (fetch)")
+
+	worktreeOutput := "worktree " + repo.RootPath + `
+HEAD abc123def
+branch refs/heads/main
+
+worktree /test/bisect
+HEAD def456ghi
+detached`
+
+	mockGit.SetCommand("worktree list --porcelain", worktreeOutput)
+
+	wm := NewWorktreeManager(repo, cfg, mockGit)
+
+	worktrees, err := wm.ListWorktrees()
+
+	require.NoError(t, err)
+	require.Len(t, worktrees, 2)
+	assert.False(t, worktrees[0].Detached)
+	assert.True(t, worktrees[1].Detached)
+	assert.Empty(t, worktrees[1].Branch)
+	assert.Equal(t, "def456ghi", worktrees[1].Head)
+}
+
 func TestDeleteWorktree_Success(t *testing.T) {
 	repo := createTestRepository()
 	cfg := createTestConfig()
@@ -223,7 +262,7 @@ func TestDeleteWorktree_Success(t *testing.T) {
 
 	// Setup mock responses
 	mockGit.SetCommand("rev-parse --git-dir", ".git")
-	mockGit.SetCommand("branch --show-current", "feature")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
 	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
 	mockGit.SetCommand("status --porcelain", "")
 	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nFeature commit")
@@ -261,7 +300,7 @@ func TestDeleteWorktree_UncommittedChanges(t *testing.T) {
 
 	// Setup mock responses for worktree with uncommitted changes
 	mockGit.SetCommand("rev-parse --git-dir", ".git")
-	mockGit.SetCommand("branch --show-current", "feature")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
 	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
 	mockGit.SetCommand("status --porcelain", " M modified.txt\n?? untracked.txt")
 	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nFeature commit")
@@ -287,7 +326,7 @@ func TestDeleteWorktree_Force(t *testing.T) {
 
 	// Setup mock responses
 	mockGit.SetCommand("rev-parse --git-dir", ".git")
-	mockGit.SetCommand("branch --show-current", "feature")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
 	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
 	mockGit.SetCommand("status --porcelain", " M modified.txt")
 	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nFeature commit")
@@ -313,7 +352,7 @@ func TestGetWorktreeInfo_Success(t *testing.T) {
 
 	// Setup mock responses
 	mockGit.SetCommand("rev-parse --git-dir", ".git")
-	mockGit.SetCommand("branch --show-current", "feature")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
 	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
 	mockGit.SetCommand("status --porcelain", "")
 	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nFeature commit")
@@ -337,6 +376,28 @@ func TestGetWorktreeInfo_Success(t *testing.T) {
 	assert.False(t, wt.HasUncommitted)
 }
 
+func TestGetWorktreeInfo_DetachedHead(t *testing.T) {
+	repo := createTestRepository()
+	cfg := createTestConfig()
+	mockGit := NewMockGitCmd()
+
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetError("symbolic-ref --quiet --short HEAD", fmt.Errorf("exit status 1"))
+	mockGit.SetCommand("rev-parse --verify --quiet HEAD", "def456ghi")
+	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nFeature commit")
+
+	wm := NewWorktreeManager(repo, cfg, mockGit)
+
+	wt, err := wm.GetWorktreeInfo(t.TempDir())
+
+	require.NoError(t, err)
+	assert.True(t, wt.Detached)
+	assert.Empty(t, wt.Branch)
+	assert.Equal(t, "def456ghi", wt.Head)
+}
+
 func TestGetWorktreeInfo_EmptyPath(t *testing.T) {
 	repo := createTestRepository()
 	cfg := createTestConfig()
@@ -526,7 +587,7 @@ func TestGetTmuxSessionName(t *testing.T) {
 
 	sessionName := wm.getTmuxSessionName(wt)
 
-	assert.Equal(t, "ccmgr-test-repo-feature-auth", sessionName)
+	assert.Equal(t, "ccmgr-test_repo-feature_auth", sessionName)
 }
 
 func TestGetTmuxSessionName_NoPrefix(t *testing.T) {
@@ -621,3 +682,285 @@ func TestCleanupOldWorktrees_Disabled(t *testing.T) {
 
 	assert.NoError(t, err) // Should return immediately when disabled
 }
+
+func TestResolveWorktreePath_DryRun(t *testing.T) {
+	tempDir := t.TempDir()
+	repo := createTestRepository()
+	repo.RootPath = filepath.Join(tempDir, "test-repo")
+	require.NoError(t, os.MkdirAll(repo.RootPath, 0755))
+
+	cfg := createTestConfig()
+	baseDir := filepath.Join(tempDir, "worktrees")
+	cfg.Worktree.BaseDirectory = filepath.Join(baseDir, "{{.Project}}")
+
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("worktree list --porcelain", "worktree "+repo.RootPath+"\nHEAD abc123\nbranch refs/heads/main\n")
+
+	wm := NewWorktreeManager(repo, cfg, mockGit)
+
+	path, err := wm.ResolveWorktreePath("feature", WorktreeOptions{AutoName: true})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(baseDir, "test-repo", "test-repo-feature"), path)
+
+	_, err = os.Stat(baseDir)
+	assert.True(t, os.IsNotExist(err), "resolving a path must not create directories")
+	assert.False(t, mockGit.WasExecuted("worktree add "+path+" feature"))
+
+	_, err = wm.ResolveWorktreePath("main", WorktreeOptions{AutoName: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "branch conflict")
+}
+
+func TestResolveWorktreePath_InsideRepositoryNamesRepoRoot(t *testing.T) {
+	repoDir := t.TempDir()
+	repo := createTestRepository()
+	repo.RootPath = repoDir
+
+	cfg := createTestConfig()
+	cfg.Worktree.BaseDirectory = filepath.Join(repoDir, ".worktrees")
+
+	wm := NewWorktreeManager(repo, cfg, NewMockGitCmd())
+
+	_, err := wm.ResolveWorktreePath("feature", WorktreeOptions{AutoName: true})
+	require.Error(t, err)
+
+	var insideErr *InsideRepositoryError
+	require.True(t, errors.As(err, &insideErr))
+	assert.Equal(t, "base directory", insideErr.Subject)
+	assert.Contains(t, err.Error(), "repository root: "+repoDir)
+	assert.Contains(t, err.Error(), `base_directory "`+cfg.Worktree.BaseDirectory+`"`)
+}
+
+func TestDescribeGeneratedPath(t *testing.T) {
+	repo := createTestRepository()
+	cfg := createTestConfig()
+	cfg.Worktree.BaseDirectory = ".worktrees"
+	cfg.Git.DirectoryPattern = "{{.Branch}}"
+	wm := NewWorktreeManager(repo, cfg, NewMockGitCmd())
+
+	err := wm.validateWorktreePath("/test/repo/.worktrees/feature")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository root: /test/repo")
+
+	err = wm.describeGeneratedPath(err, true)
+	assert.Contains(t, err.Error(), "worktree path cannot be inside repository: /test/repo/.worktrees/feature")
+	assert.Contains(t, err.Error(), `base_directory ".worktrees" resolved to /test/repo/.worktrees relative to the current directory`)
+	assert.Contains(t, err.Error(), `directory_pattern "{{.Branch}}"`)
+}
+
+// newProtectedDeleteTest returns a worktree manager for a clean worktree on
+// main in a temp directory, along with that directory
+func newProtectedDeleteTest(t *testing.T, allowForceDelete bool) (*WorktreeManager, *MockGitCmd, string) {
+	cfg := createTestConfig()
+	cfg.Git.ProtectedBranches = []string{"main", "release"}
+	cfg.Git.AllowForceDelete = allowForceDelete
+
+	dir := t.TempDir()
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "main")
+	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
+	mockGit.SetCommand("status --porcelain", "")
+	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nMain commit")
+	mockGit.SetCommand("worktree remove --force "+dir, "")
+
+	return NewWorktreeManager(createTestRepository(), cfg, mockGit), mockGit, dir
+}
+
+func TestDeleteWorktree_ProtectedBranch(t *testing.T) {
+	t.Run("refused without force", func(t *testing.T) {
+		wm, mockGit, dir := newProtectedDeleteTest(t, true)
+
+		err := wm.DeleteWorktree(dir, false)
+
+		var protectedErr *ProtectedBranchError
+		require.ErrorAs(t, err, &protectedErr)
+		assert.Equal(t, "main", protectedErr.Branch)
+		assert.True(t, protectedErr.ForceAllowed)
+		assert.False(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("force refused when force delete is not allowed", func(t *testing.T) {
+		wm, mockGit, dir := newProtectedDeleteTest(t, false)
+
+		err := wm.DeleteWorktree(dir, true)
+
+		var protectedErr *ProtectedBranchError
+		require.ErrorAs(t, err, &protectedErr)
+		assert.False(t, protectedErr.ForceAllowed)
+		assert.False(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("force overrides when force delete is allowed", func(t *testing.T) {
+		wm, mockGit, dir := newProtectedDeleteTest(t, true)
+
+		require.NoError(t, wm.DeleteWorktree(dir, true))
+		assert.True(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+}
+
+func TestCheckProtectedBranch(t *testing.T) {
+	cfg := createTestConfig()
+	cfg.Git.ProtectedBranches = []string{"main"}
+	wm := NewWorktreeManager(createTestRepository(), cfg, NewMockGitCmd())
+
+	assert.NoError(t, wm.CheckProtectedBranch("feature", false))
+	assert.NoError(t, wm.CheckProtectedBranch("", false))
+	assert.Error(t, wm.CheckProtectedBranch("main", false))
+	assert.Error(t, wm.CheckProtectedBranch("main", true), "allow_force_delete is off")
+
+	cfg.Git.AllowForceDelete = true
+	assert.NoError(t, wm.CheckProtectedBranch("main", true))
+}
+
+func newDirtyDeleteTest(t *testing.T) (*WorktreeManager, *MockGitCmd, string) {
+	cfg := createTestConfig()
+	cfg.Git.BackupOnDelete = false
+
+	dir := t.TempDir()
+	mockGit := NewMockGitCmd()
+	mockGit.SetCommand("rev-parse --git-dir", ".git")
+	mockGit.SetCommand("symbolic-ref --quiet --short HEAD", "feature")
+	mockGit.SetCommand("rev-parse HEAD", "def456ghi")
+	mockGit.SetCommand("status --porcelain", " M modified.txt\n?? notes.txt")
+	mockGit.SetCommand("show --no-patch --pretty=format:%H%n%an%n%at%n%s def456ghi", "def456ghi\nTest User\n1640995300\nFeature commit")
+	mockGit.SetCommand("worktree remove --force "+dir, "")
+
+	return NewWorktreeManager(createTestRepository(), cfg, mockGit), mockGit, dir
+}
+
+func TestDeleteWorktree_RequireCleanWorkdir(t *testing.T) {
+	t.Run("dirty worktree refused", func(t *testing.T) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+
+		err := wm.DeleteWorktree(dir, false)
+
+		assert.ErrorIs(t, err, ErrUncommittedChanges)
+		assert.False(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("dirty worktree removed when forced", func(t *testing.T) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+
+		require.NoError(t, wm.DeleteWorktree(dir, true))
+		assert.True(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("dirty worktree removed when not required clean", func(t *testing.T) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+		wm.config.Git.RequireCleanWorkdir = false
+
+		require.NoError(t, wm.DeleteWorktree(dir, false))
+		assert.True(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+}
+
+func TestDeleteWorktreeWithOptions_AllowProtected(t *testing.T) {
+	newTest := func(t *testing.T) (*WorktreeManager, *MockGitCmd, string) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+		wm.config.Git.ProtectedBranches = []string{"feature"}
+		wm.config.Git.AllowForceDelete = true
+		return wm, mockGit, dir
+	}
+
+	t.Run("does not override uncommitted changes", func(t *testing.T) {
+		wm, mockGit, dir := newTest(t)
+
+		_, err := wm.DeleteWorktreeWithOptions(dir, DeleteOptions{AllowProtected: true})
+
+		assert.ErrorIs(t, err, ErrUncommittedChanges)
+		assert.False(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("force does not override branch protection", func(t *testing.T) {
+		wm, mockGit, dir := newTest(t)
+
+		_, err := wm.DeleteWorktreeWithOptions(dir, DeleteOptions{Force: true})
+
+		var protectedErr *ProtectedBranchError
+		require.ErrorAs(t, err, &protectedErr)
+		assert.False(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("both overrides remove the worktree", func(t *testing.T) {
+		wm, mockGit, dir := newTest(t)
+
+		_, err := wm.DeleteWorktreeWithOptions(dir, DeleteOptions{Force: true, AllowProtected: true})
+
+		require.NoError(t, err)
+		assert.True(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+}
+
+func TestDeleteWorktree_BackupOnDelete(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	t.Run("archives uncommitted changes", func(t *testing.T) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+		wm.config.Git.BackupOnDelete = true
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("draft"), 0644))
+		mockGit.SetCommand("diff HEAD --binary", "diff --git a/modified.txt b/modified.txt\n")
+		mockGit.SetCommand("ls-files --others --exclude-standard -z", "notes.txt\x00")
+
+		backupPath, err := wm.DeleteWorktreeWithBackup(dir, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, WorktreeBackupDir(), filepath.Dir(backupPath))
+		assert.True(t, mockGit.WasExecuted("worktree remove --force "+dir))
+
+		entries := readTarGz(t, backupPath)
+		assert.Equal(t, "diff --git a/modified.txt b/modified.txt\n", entries["changes.patch"])
+		assert.Equal(t, "draft", entries["untracked/notes.txt"])
+	})
+
+	t.Run("backup failure keeps the worktree", func(t *testing.T) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+		wm.config.Git.BackupOnDelete = true
+		mockGit.SetError("diff HEAD --binary", errors.New("diff failed"))
+
+		backupPath, err := wm.DeleteWorktreeWithBackup(dir, true)
+
+		assert.Error(t, err)
+		assert.Empty(t, backupPath)
+		assert.False(t, mockGit.WasExecuted("worktree remove --force "+dir))
+	})
+
+	t.Run("clean worktree is not backed up", func(t *testing.T) {
+		wm, mockGit, dir := newDirtyDeleteTest(t)
+		wm.config.Git.BackupOnDelete = true
+		mockGit.SetCommand("status --porcelain", "")
+		mockGit.SetCommand("worktree remove "+dir, "")
+
+		backupPath, err := wm.DeleteWorktreeWithBackup(dir, false)
+
+		require.NoError(t, err)
+		assert.Empty(t, backupPath)
+		assert.False(t, mockGit.WasExecuted("diff HEAD --binary"))
+	})
+}
+
+// readTarGz returns the regular file contents of a tar.gz archive by name
+func readTarGz(t *testing.T, path string) map[string]string {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	entries := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		entries[header.Name] = string(data)
+	}
+	return entries
+}
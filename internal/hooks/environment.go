@@ -26,9 +26,11 @@ func (eb *EnvironmentBuilder) WithContext(ctx HookContext) *EnvironmentBuilder {
 	}
 	if ctx.WorktreeBranch != "" {
 		eb.variables["CCMGR_WORKTREE_BRANCH"] = ctx.WorktreeBranch
+		eb.variables["CCMGR_BRANCH"] = ctx.WorktreeBranch
 	}
 	if ctx.ProjectName != "" {
 		eb.variables["CCMGR_PROJECT_NAME"] = ctx.ProjectName
+		eb.variables["CCMGR_PROJECT"] = ctx.ProjectName
 	}
 	if ctx.SessionID != "" {
 		eb.variables["CCMGR_SESSION_ID"] = ctx.SessionID
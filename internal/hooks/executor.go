@@ -4,11 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"os/exec"
-	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
@@ -64,7 +62,12 @@ func (e *DefaultExecutor) ExecuteAsync(hookType HookType, hookCtx HookContext) <
 	go func() {
 		defer close(errChan)
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		timeout := 5 * time.Minute
+		if hook, err := e.getHookConfig(hookType); err == nil && hook.Timeout > 0 {
+			timeout = hook.Timeout
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		err := e.Execute(ctx, hookType, hookCtx)
@@ -94,43 +97,39 @@ func (e *DefaultExecutor) ExecuteStatusHook(hookType HookType, hookCtx HookConte
 		return fmt.Errorf("unsupported status hook type: %s", hookType.String())
 	}
 
-	if !hookConfig.Enabled {
-		return nil
-	}
+	return e.executeConfiguredHook(hookType, hookConfig, hookCtx)
+}
 
-	hook := Hook{
-		Type:    hookType,
-		Enabled: hookConfig.Enabled,
-		Script:  hookConfig.Script,
-		Timeout: time.Duration(hookConfig.Timeout) * time.Second,
-		Async:   hookConfig.Async,
+// ExecuteWorktreeCreationHook executes a worktree creation hook
+func (e *DefaultExecutor) ExecuteWorktreeCreationHook(hookCtx HookContext) error {
+	if !e.config.WorktreeHooks.Enabled {
+		return nil
 	}
+	return e.executeConfiguredHook(HookTypeWorktreeCreation, e.config.WorktreeHooks.CreationHook, hookCtx)
+}
 
-	if hook.Async {
-		errChan := e.ExecuteAsync(hookType, hookCtx)
-		go func() {
-			if err := <-errChan; err != nil {
-				log.Printf("Status hook %s failed: %v", hookType.String(), err)
-			}
-		}()
+// ExecuteWorktreeActivationHook executes a worktree activation hook
+func (e *DefaultExecutor) ExecuteWorktreeActivationHook(hookCtx HookContext) error {
+	if !e.config.WorktreeHooks.Enabled {
 		return nil
 	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), hook.Timeout)
-	defer cancel()
-
-	return e.executeHook(ctx, hook, hookCtx)
+	return e.executeConfiguredHook(HookTypeWorktreeActivation, e.config.WorktreeHooks.ActivationHook, hookCtx)
 }
 
-// ExecuteWorktreeCreationHook executes a worktree creation hook
-func (e *DefaultExecutor) ExecuteWorktreeCreationHook(hookCtx HookContext) error {
-	if !e.config.WorktreeHooks.Enabled || !e.config.WorktreeHooks.CreationHook.Enabled {
+// executeConfiguredHook runs a status or worktree hook from its configuration.
+// A synchronous hook returns the script's error, so a non-zero exit fails the
+// operation; an async hook only logs it. The hook scripts are optional, so one
+// whose script does not exist is skipped (doctor reports it).
+func (e *DefaultExecutor) executeConfiguredHook(hookType HookType, hookConfig config.HookConfig, hookCtx HookContext) error {
+	if !hookConfig.Enabled || hookConfig.Script == "" {
+		return nil
+	}
+	if _, err := os.Stat(expandPath(hookConfig.Script)); os.IsNotExist(err) {
 		return nil
 	}
 
-	hookConfig := e.config.WorktreeHooks.CreationHook
 	hook := Hook{
-		Type:    HookTypeWorktreeCreation,
+		Type:    hookType,
 		Enabled: hookConfig.Enabled,
 		Script:  hookConfig.Script,
 		Timeout: time.Duration(hookConfig.Timeout) * time.Second,
@@ -138,10 +137,10 @@ func (e *DefaultExecutor) ExecuteWorktreeCreationHook(hookCtx HookContext) error
 	}
 
 	if hook.Async {
-		errChan := e.ExecuteAsync(HookTypeWorktreeCreation, hookCtx)
+		errChan := e.ExecuteAsync(hookType, hookCtx)
 		go func() {
 			if err := <-errChan; err != nil {
-				log.Printf("Worktree creation hook failed: %v", err)
+				slog.Error("hook failed", "hook", hookType.String(), "error", err)
 			}
 		}()
 		return nil
@@ -153,45 +152,36 @@ func (e *DefaultExecutor) ExecuteWorktreeCreationHook(hookCtx HookContext) error
 	return e.executeHook(ctx, hook, hookCtx)
 }
 
-// ExecuteWorktreeActivationHook executes a worktree activation hook
-func (e *DefaultExecutor) ExecuteWorktreeActivationHook(hookCtx HookContext) error {
-	if !e.config.WorktreeHooks.Enabled || !e.config.WorktreeHooks.ActivationHook.Enabled {
-		return nil
-	}
-
-	hookConfig := e.config.WorktreeHooks.ActivationHook
-	hook := Hook{
-		Type:    HookTypeWorktreeActivation,
-		Enabled: hookConfig.Enabled,
-		Script:  hookConfig.Script,
-		Timeout: time.Duration(hookConfig.Timeout) * time.Second,
-		Async:   hookConfig.Async,
-	}
+// executeHook executes a single hook
+func (e *DefaultExecutor) executeHook(ctx context.Context, hook Hook, hookCtx HookContext) error {
+	_, err := e.runHook(ctx, hook, hookCtx)
+	return err
+}
 
-	if hook.Async {
-		errChan := e.ExecuteAsync(HookTypeWorktreeActivation, hookCtx)
-		go func() {
-			if err := <-errChan; err != nil {
-				log.Printf("Worktree activation hook failed: %v", err)
-			}
-		}()
-		return nil
+// RunHook runs the hook of hookType with hookCtx synchronously, ignoring its
+// Async setting and whether it is enabled, and returns the result with the
+// script's output. It is meant for trying out hook scripts.
+func (e *DefaultExecutor) RunHook(hookType HookType, hookCtx HookContext) (*HookResult, error) {
+	hook, err := e.getHookConfig(hookType)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), hook.Timeout)
 	defer cancel()
 
-	return e.executeHook(ctx, hook, hookCtx)
+	return e.runHook(ctx, hook, hookCtx)
 }
 
-// executeHook executes a single hook
-func (e *DefaultExecutor) executeHook(ctx context.Context, hook Hook, hookCtx HookContext) error {
+// runHook runs a single hook and returns its result. The error is nil only
+// when the script ran and exited with status zero.
+func (e *DefaultExecutor) runHook(ctx context.Context, hook Hook, hookCtx HookContext) (*HookResult, error) {
 	// Expand script path
 	scriptPath := expandPath(hook.Script)
 
 	// Validate script exists and is executable
 	if err := e.validateScript(scriptPath); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Build environment
@@ -216,6 +206,10 @@ func (e *DefaultExecutor) executeHook(ctx context.Context, hook Hook, hookCtx Ho
 	// Set environment
 	cmd.Env = env
 
+	// Children of a timed out script, such as a sleep, would otherwise keep
+	// the output pipes open and the hook running past its timeout
+	cmd.WaitDelay = time.Second
+
 	// Capture output
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -227,11 +221,12 @@ func (e *DefaultExecutor) executeHook(ctx context.Context, hook Hook, hookCtx Ho
 	duration := time.Since(startTime)
 
 	// Handle result
-	result := HookResult{
+	result := &HookResult{
 		HookType:  hook.Type,
 		Success:   err == nil,
 		Duration:  duration,
 		Output:    stdout.String(),
+		Stderr:    stderr.String(),
 		Timestamp: startTime,
 	}
 
@@ -240,33 +235,32 @@ func (e *DefaultExecutor) executeHook(ctx context.Context, hook Hook, hookCtx Ho
 			result.ExitCode = exitError.ExitCode()
 		}
 
-		hookErr := &HookError{
-			HookType: hook.Type,
-			Script:   scriptPath,
-			Err:      err,
-		}
-
 		// Check for specific error types
 		if ctx.Err() == context.DeadlineExceeded {
-			return &TimeoutError{
+			result.TimedOut = true
+			result.Error = &TimeoutError{
 				Hook:    scriptPath,
 				Timeout: hook.Timeout,
 			}
-		}
-
-		if result.ExitCode != 0 {
-			return &ScriptExecutionError{
+		} else if result.ExitCode != 0 {
+			result.Error = &ScriptExecutionError{
 				Script:   scriptPath,
 				ExitCode: result.ExitCode,
 				Stderr:   stderr.String(),
 				Err:      err,
 			}
+		} else {
+			result.Error = &HookError{
+				HookType: hook.Type,
+				Script:   scriptPath,
+				Err:      err,
+			}
 		}
 
-		return hookErr
+		return result, result.Error
 	}
 
-	return nil
+	return result, nil
 }
 
 // getHookConfig gets the hook configuration for a given hook type
@@ -366,14 +360,9 @@ func (e *DefaultExecutor) getShell() string {
 	return "/bin/bash"
 }
 
-// expandPath expands ~ and environment variables in path
+// expandPath expands ~, ~user and environment variables in path
 func expandPath(path string) string {
-	if strings.HasPrefix(path, "~/") {
-		if home, err := os.UserHomeDir(); err == nil {
-			path = filepath.Join(home, path[2:])
-		}
-	}
-	return os.ExpandEnv(path)
+	return config.ExpandPath(path)
 }
 
 // isValidPath checks if a path exists and is a directory
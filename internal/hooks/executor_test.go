@@ -322,3 +322,14 @@ func createTestScript(t *testing.T, content string) string {
 
 	return scriptPath
 }
+
+func TestDefaultExecutor_WorktreeHookMissingScriptSkipped(t *testing.T) {
+	cfg := createTestConfig()
+	executor := NewDefaultExecutor(cfg)
+
+	cfg.WorktreeHooks.CreationHook.Script = filepath.Join(t.TempDir(), "creation.sh")
+	cfg.WorktreeHooks.ActivationHook.Script = filepath.Join(t.TempDir(), "activation.sh")
+
+	assert.NoError(t, executor.ExecuteWorktreeCreationHook(HookContext{}))
+	assert.NoError(t, executor.ExecuteWorktreeActivationHook(HookContext{}))
+}
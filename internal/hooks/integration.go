@@ -2,7 +2,7 @@ package hooks
 
 import (
 	"context"
-	"log"
+	"log/slog"
 
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
 )
@@ -211,7 +211,7 @@ func HandleSessionLifecycle(event SessionLifecycleEvent) error {
 		}
 		return GlobalHookManager.OnSessionResumed(sessionInfo, previousState)
 	default:
-		log.Printf("Unknown session lifecycle event type: %s", event.Type)
+		slog.Warn("unknown session lifecycle event type", "type", event.Type)
 		return nil
 	}
 }
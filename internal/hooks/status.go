@@ -2,9 +2,11 @@ package hooks
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
 )
 
 // StatusHookManager manages status hook execution
@@ -13,9 +15,19 @@ type StatusHookManager struct {
 	enabled          bool
 	debounceInterval time.Duration
 	lastStateChange  map[string]time.Time
+	pending          map[string]*pendingStateChange
+	lastHook         map[string]HookType // last hook run for each process/session
 	mu               sync.RWMutex
 }
 
+// pendingStateChange is a state change waiting for the state to settle
+type pendingStateChange struct {
+	timer    *time.Timer
+	oldState string
+	newState string
+	context  HookContext
+}
+
 // NewStatusHookManager creates a new status hook manager
 func NewStatusHookManager(executor HookExecutor) *StatusHookManager {
 	return &StatusHookManager{
@@ -23,9 +35,18 @@ func NewStatusHookManager(executor HookExecutor) *StatusHookManager {
 		enabled:          true,
 		debounceInterval: 1 * time.Second, // Debounce rapid state changes
 		lastStateChange:  make(map[string]time.Time),
+		pending:          make(map[string]*pendingStateChange),
+		lastHook:         make(map[string]HookType),
 	}
 }
 
+// SetDebounceInterval sets how long a state has to hold before its hook runs
+func (shm *StatusHookManager) SetDebounceInterval(interval time.Duration) {
+	shm.mu.Lock()
+	defer shm.mu.Unlock()
+	shm.debounceInterval = interval
+}
+
 // SetEnabled enables or disables status hook execution
 func (shm *StatusHookManager) SetEnabled(enabled bool) {
 	shm.mu.Lock()
@@ -40,42 +61,76 @@ func (shm *StatusHookManager) IsEnabled() bool {
 	return shm.enabled
 }
 
-// OnStateChange handles a state change event and triggers appropriate hooks
+// OnStateChange handles a state change event and triggers appropriate hooks.
+// The hook runs once the state has held for the debounce interval, so a
+// process flapping between states runs a single hook for the state it
+// settles in, and none when it settles back in the state it started from.
 func (shm *StatusHookManager) OnStateChange(oldState, newState string, context HookContext) {
 	if !shm.IsEnabled() {
 		return
 	}
 
-	// Debounce rapid state changes for the same process/session
+	// Unknown states have no hook
+	if _, ok := mapStateToHookType(newState); !ok {
+		return
+	}
+
 	key := context.SessionID
 	if key == "" {
 		key = context.WorktreePath
 	}
 
 	shm.mu.Lock()
-	now := time.Now()
-	if lastChange, exists := shm.lastStateChange[key]; exists {
-		if now.Sub(lastChange) < shm.debounceInterval {
-			shm.mu.Unlock()
-			return // Skip this state change due to debouncing
+	defer shm.mu.Unlock()
+
+	shm.lastStateChange[key] = time.Now()
+
+	if change, exists := shm.pending[key]; exists {
+		change.newState = newState
+		change.context = context
+		change.timer.Reset(shm.debounceInterval)
+		return
+	}
+
+	// Without an earlier hook, the state the process changed from is the
+	// baseline a settled state is compared against
+	if _, exists := shm.lastHook[key]; !exists {
+		if hookType, ok := mapStateToHookType(oldState); ok {
+			shm.lastHook[key] = hookType
 		}
 	}
-	shm.lastStateChange[key] = now
-	shm.mu.Unlock()
 
-	// Map state to hook type
-	hookType, ok := mapStateToHookType(newState)
-	if !ok {
-		return // Unknown state, skip
+	change := &pendingStateChange{oldState: oldState, newState: newState, context: context}
+	change.timer = time.AfterFunc(shm.debounceInterval, func() {
+		shm.runPendingHook(key, change)
+	})
+	shm.pending[key] = change
+}
+
+// runPendingHook runs the status hook of a settled state change unless the
+// same hook already ran for the last state change of key
+func (shm *StatusHookManager) runPendingHook(key string, change *pendingStateChange) {
+	shm.mu.Lock()
+	if shm.pending[key] != change {
+		shm.mu.Unlock()
+		return
+	}
+	delete(shm.pending, key)
+
+	hookType, _ := mapStateToHookType(change.newState)
+	if last, exists := shm.lastHook[key]; exists && last == hookType {
+		shm.mu.Unlock()
+		return
 	}
+	shm.lastHook[key] = hookType
 
-	// Update context with state information
-	context.OldState = oldState
-	context.NewState = newState
+	context := change.context
+	context.OldState = change.oldState
+	context.NewState = change.newState
+	shm.mu.Unlock()
 
-	// Execute the appropriate status hook
 	if err := shm.executor.ExecuteStatusHook(hookType, context); err != nil {
-		log.Printf("Status hook execution failed for state %s: %v", newState, err)
+		slog.Error("status hook failed", "state", context.NewState, "error", err)
 	}
 }
 
@@ -101,8 +156,9 @@ func (shm *StatusHookManager) CleanupDebounceMap() {
 
 	cutoff := time.Now().Add(-5 * time.Minute) // Clean entries older than 5 minutes
 	for key, timestamp := range shm.lastStateChange {
-		if timestamp.Before(cutoff) {
+		if _, pending := shm.pending[key]; timestamp.Before(cutoff) && !pending {
 			delete(shm.lastStateChange, key)
+			delete(shm.lastHook, key)
 		}
 	}
 }
@@ -192,6 +248,19 @@ func (shi *StatusHookIntegrator) HandleProcessStateChange(processID string, oldS
 	shi.hookManager.OnStateChange(oldState, newState, context)
 }
 
+// OnStateChange implements claude.StateChangeHandler so the integrator can be
+// registered with the Claude process monitor. Events for newly discovered
+// processes are not transitions and run no hook.
+func (shi *StatusHookIntegrator) OnStateChange(ctx context.Context, event claude.StateChangeEvent) error {
+	if event.OldState == claude.StateUnknown {
+		return nil
+	}
+
+	shi.HandleProcessStateChange(event.ProcessID, event.OldState.String(), event.NewState.String(),
+		event.WorkingDir, "", event.SessionID)
+	return nil
+}
+
 // HandleClaudeProcessEvent handles a Claude Code process event
 func (shi *StatusHookIntegrator) HandleClaudeProcessEvent(event string, processInfo map[string]interface{}) {
 	if !shi.IsEnabled() {
@@ -238,7 +307,7 @@ func (shi *StatusHookIntegrator) HandleClaudeProcessEvent(event string, processI
 		if hookType, ok := mapStateToHookType(event); ok {
 			context.NewState = event
 			if err := shi.hookManager.executor.ExecuteStatusHook(hookType, context); err != nil {
-				log.Printf("Status hook execution failed for event %s: %v", event, err)
+				slog.Error("status hook failed", "event", event, "error", err)
 			}
 		}
 	}
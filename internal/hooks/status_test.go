@@ -0,0 +1,104 @@
+package hooks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+const testDebounce = 20 * time.Millisecond
+
+// newStatusHookTest returns a status hook integrator whose idle, busy and
+// waiting hooks append a line naming the hook and its environment to a log
+// file, along with a function reading the logged lines
+func newStatusHookTest(t *testing.T) (*StatusHookIntegrator, func() []string) {
+	logFile := filepath.Join(t.TempDir(), "hooks.log")
+
+	cfg := createTestConfig()
+	for name, hook := range map[string]*config.HookConfig{
+		"idle":    &cfg.StatusHooks.IdleHook,
+		"busy":    &cfg.StatusHooks.BusyHook,
+		"waiting": &cfg.StatusHooks.WaitingHook,
+	} {
+		hook.Script = createTestScript(t, "#!/bin/sh\necho \""+name+" $CCMGR_OLD_STATE $CCMGR_NEW_STATE $CCMGR_WORKTREE_PATH\" >> "+logFile+"\n")
+		hook.Async = false
+	}
+
+	integrator := NewStatusHookIntegrator(NewDefaultExecutor(cfg))
+	integrator.GetManager().SetDebounceInterval(testDebounce)
+
+	return integrator, func() []string {
+		data, err := os.ReadFile(logFile)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		require.NoError(t, err)
+		return strings.Split(strings.TrimSpace(string(data)), "\n")
+	}
+}
+
+// stateChange is a state change of the Claude process in /work/app
+func stateChange(oldState, newState claude.ProcessState) claude.StateChangeEvent {
+	return claude.StateChangeEvent{
+		ProcessID:  "claude-1",
+		SessionID:  "claude-1",
+		OldState:   oldState,
+		NewState:   newState,
+		WorkingDir: "/work/app",
+	}
+}
+
+// settle waits for pending hooks to run
+func settle() {
+	time.Sleep(10 * testDebounce)
+}
+
+func TestStatusHookIntegrator_TransitionRunsHookOnce(t *testing.T) {
+	integrator, hookLog := newStatusHookTest(t)
+
+	require.NoError(t, integrator.OnStateChange(context.Background(), stateChange(claude.StateIdle, claude.StateBusy)))
+	settle()
+
+	assert.Equal(t, []string{"busy idle busy /work/app"}, hookLog())
+}
+
+func TestStatusHookIntegrator_DebouncesFlapping(t *testing.T) {
+	integrator, hookLog := newStatusHookTest(t)
+	ctx := context.Background()
+
+	// Flapping ends in busy: one busy hook
+	integrator.OnStateChange(ctx, stateChange(claude.StateIdle, claude.StateBusy))
+	integrator.OnStateChange(ctx, stateChange(claude.StateBusy, claude.StateIdle))
+	integrator.OnStateChange(ctx, stateChange(claude.StateIdle, claude.StateBusy))
+	settle()
+	assert.Equal(t, []string{"busy idle busy /work/app"}, hookLog())
+
+	// Flapping back to busy: no new hook
+	integrator.OnStateChange(ctx, stateChange(claude.StateBusy, claude.StateWaiting))
+	integrator.OnStateChange(ctx, stateChange(claude.StateWaiting, claude.StateBusy))
+	settle()
+	assert.Len(t, hookLog(), 1)
+
+	integrator.OnStateChange(ctx, stateChange(claude.StateBusy, claude.StateWaiting))
+	settle()
+	assert.Equal(t, []string{"busy idle busy /work/app", "waiting busy waiting /work/app"}, hookLog())
+}
+
+func TestStatusHookIntegrator_IgnoresDiscoveryAndStop(t *testing.T) {
+	integrator, hookLog := newStatusHookTest(t)
+	ctx := context.Background()
+
+	integrator.OnStateChange(ctx, stateChange(claude.StateUnknown, claude.StateIdle))
+	integrator.OnStateChange(ctx, stateChange(claude.StateIdle, claude.StateStopped))
+	settle()
+
+	assert.Empty(t, hookLog())
+}
@@ -69,6 +69,8 @@ type HookResult struct {
 	Duration  time.Duration
 	ExitCode  int
 	Output    string
+	Stderr    string
+	TimedOut  bool
 	Error     error
 	Timestamp time.Time
 }
@@ -0,0 +1,71 @@
+// Package logging configures the leveled logger used for background errors
+// that have no caller to return to, such as failing hooks and TUI refreshes.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// level filters every logger made by Setup, so the level can follow the
+// configuration once it has been loaded
+var level = new(slog.LevelVar)
+
+// ParseLevel converts a log_level config value to an slog level. An empty
+// value is info.
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unsupported log level: %s (supported: debug, info, warn, error)", name)
+	}
+}
+
+// SetLevel sets the level of the loggers made by Setup from a log_level
+// config value
+func SetLevel(name string) error {
+	l, err := ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	level.Set(l)
+	return nil
+}
+
+// New returns a logger writing text records at or above lvl to w
+func New(w io.Writer, lvl slog.Leveler) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: lvl}))
+}
+
+// Setup makes the default logger append to the file at path, creating it
+// and its directory if needed, or write to w when path is empty. The
+// returned function closes the log file.
+func Setup(path string, w io.Writer) (func() error, error) {
+	closeLog := func() error { return nil }
+
+	if path != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create log directory: %w", err)
+		}
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		w = file
+		closeLog = file.Close
+	}
+
+	slog.SetDefault(New(w, level))
+	return closeLog, nil
+}
@@ -0,0 +1,110 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// restoreDefault puts back the default logger and level after a test
+func restoreDefault(t *testing.T) {
+	t.Helper()
+
+	previous, previousLevel := slog.Default(), level.Level()
+	t.Cleanup(func() {
+		slog.SetDefault(previous)
+		level.Set(previousLevel)
+	})
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"":        slog.LevelInfo,
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warn":    slog.LevelWarn,
+		"warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+	}
+	for name, expected := range tests {
+		got, err := ParseLevel(name)
+		if err != nil {
+			t.Errorf("ParseLevel(%q) error = %v", name, err)
+		}
+		if got != expected {
+			t.Errorf("ParseLevel(%q) = %v, want %v", name, got, expected)
+		}
+	}
+
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("ParseLevel(\"verbose\") succeeded, want error")
+	}
+}
+
+func TestLevelFiltering(t *testing.T) {
+	restoreDefault(t)
+
+	var buf bytes.Buffer
+	if _, err := Setup("", &buf); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	if err := SetLevel("warn"); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+	slog.Info("routine refresh")
+	slog.Warn("hook slow")
+	slog.Error("hook failed")
+
+	output := buf.String()
+	if strings.Contains(output, "routine refresh") {
+		t.Errorf("info record logged at warn level\nOutput:\n%s", output)
+	}
+	if !strings.Contains(output, "hook slow") || !strings.Contains(output, "hook failed") {
+		t.Errorf("warn and error records missing\nOutput:\n%s", output)
+	}
+
+	// Lowering the level applies to the logger already installed
+	if err := SetLevel("debug"); err != nil {
+		t.Fatalf("SetLevel() error = %v", err)
+	}
+	slog.Debug("debug details")
+	if !strings.Contains(buf.String(), "debug details") {
+		t.Errorf("debug record missing after SetLevel(debug)\nOutput:\n%s", buf.String())
+	}
+
+	if err := SetLevel("loud"); err == nil {
+		t.Error("SetLevel(\"loud\") succeeded, want error")
+	}
+}
+
+func TestSetup_LogFile(t *testing.T) {
+	restoreDefault(t)
+	level.Set(slog.LevelInfo)
+
+	path := filepath.Join(t.TempDir(), "logs", "ccmgr.log")
+	var fallback bytes.Buffer
+	closeLog, err := Setup(path, &fallback)
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	slog.Error("tmux refresh failed", "error", "no server running")
+	if err := closeLog(); err != nil {
+		t.Fatalf("closing log file: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("log file not created: %v", err)
+	}
+	if !strings.Contains(string(data), "level=ERROR") || !strings.Contains(string(data), `error="no server running"`) {
+		t.Errorf("log file missing record\nContent:\n%s", data)
+	}
+	if fallback.Len() != 0 {
+		t.Errorf("fallback writer used alongside log file: %q", fallback.String())
+	}
+}
@@ -2,8 +2,9 @@ package storage
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
 )
 
 type Config struct {
@@ -15,12 +16,7 @@ type Config struct {
 }
 
 func DefaultConfig() *Config {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		homeDir = "."
-	}
-
-	configDir := filepath.Join(homeDir, ".config", "ccmgr-ultra")
+	configDir := config.ConfigDir()
 
 	return &Config{
 		DatabasePath:   filepath.Join(configDir, "data.db"),
@@ -54,6 +54,8 @@ type SessionEventRepository interface {
 	CreateBatch(ctx context.Context, events []*SessionEvent) error
 	GetBySessionID(ctx context.Context, sessionID string, limit int) ([]*SessionEvent, error)
 	GetByFilter(ctx context.Context, filter EventFilter) ([]*SessionEvent, error)
+	CountBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
 }
 
 type EventFilter struct {
@@ -65,9 +67,17 @@ type EventFilter struct {
 	Offset     int
 }
 
+// AggregateRepository manages the precomputed analytics tables, daily session
+// stats and productivity metrics
+type AggregateRepository interface {
+	CountBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
 type Storage interface {
 	Sessions() SessionRepository
 	Events() SessionEventRepository
+	Aggregates() AggregateRepository
 	Migrate() error
 	Close() error
 	BeginTx(ctx context.Context) (Transaction, error)
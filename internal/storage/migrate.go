@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
 )
 
 type JSONSession struct {
@@ -117,7 +118,7 @@ func (m *Migrator) FindJSONStateFiles() ([]string, error) {
 
 	var files []string
 	searchPaths := []string{
-		filepath.Join(homeDir, ".config", "ccmgr-ultra", "state.json"),
+		filepath.Join(config.ConfigDir(), "state.json"),
 		filepath.Join(homeDir, ".ccmgr-ultra", "state.json"),
 		"state.json",
 	}
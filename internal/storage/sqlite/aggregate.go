@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type aggregateRepository struct {
+	db *DB
+}
+
+func (r *aggregateRepository) CountBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var stats, metrics int64
+
+	err := r.db.conn.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM daily_session_stats WHERE date < DATE(?)", cutoff,
+	).Scan(&stats)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count daily session stats: %w", err)
+	}
+
+	err = r.db.conn.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM productivity_metrics WHERE calculated_at < ?", cutoff,
+	).Scan(&metrics)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count productivity metrics: %w", err)
+	}
+
+	return stats + metrics, nil
+}
+
+func (r *aggregateRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	tx, err := r.db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stats, err := deleteRows(ctx, tx, "DELETE FROM daily_session_stats WHERE date < DATE(?)", cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete daily session stats: %w", err)
+	}
+
+	metrics, err := deleteRows(ctx, tx, "DELETE FROM productivity_metrics WHERE calculated_at < ?", cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete productivity metrics: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return stats + metrics, nil
+}
+
+func deleteRows(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
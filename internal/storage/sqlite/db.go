@@ -13,10 +13,11 @@ import (
 )
 
 type DB struct {
-	conn     *sql.DB
-	dbPath   string
-	sessions *sessionRepository
-	events   *eventRepository
+	conn       *sql.DB
+	dbPath     string
+	sessions   *sessionRepository
+	events     *eventRepository
+	aggregates *aggregateRepository
 }
 
 func NewDB(dbPath string) (*DB, error) {
@@ -46,6 +47,7 @@ func NewDB(dbPath string) (*DB, error) {
 
 	db.sessions = &sessionRepository{db: db}
 	db.events = &eventRepository{db: db}
+	db.aggregates = &aggregateRepository{db: db}
 
 	return db, nil
 }
@@ -58,6 +60,10 @@ func (db *DB) Events() storage.SessionEventRepository {
 	return db.events
 }
 
+func (db *DB) Aggregates() storage.AggregateRepository {
+	return db.aggregates
+}
+
 func (db *DB) Close() error {
 	return db.conn.Close()
 }
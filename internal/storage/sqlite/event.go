@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/unbracketed/ccmgr-ultra/internal/storage"
 )
@@ -167,6 +168,24 @@ func (r *eventRepository) GetByFilter(ctx context.Context, filter storage.EventF
 	return events, rows.Err()
 }
 
+func (r *eventRepository) CountBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	var count int64
+	err := r.exec().QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM session_events WHERE timestamp < ?", cutoff,
+	).Scan(&count)
+	return count, err
+}
+
+func (r *eventRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := r.exec().ExecContext(ctx,
+		"DELETE FROM session_events WHERE timestamp < ?", cutoff,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 func (r *eventRepository) scanEventFromRows(rows *sql.Rows) (*storage.SessionEvent, error) {
 	var event storage.SessionEvent
 	var dataJSON string
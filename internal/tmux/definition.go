@@ -0,0 +1,129 @@
+package tmux
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Window is a tmux window as reported by list-windows
+type Window struct {
+	Index int
+	Name  string
+	// AutoName is set while tmux names the window after its running program
+	AutoName bool
+	// Command is the command the window's first pane was started with,
+	// empty for a shell
+	Command string
+}
+
+// SessionDefinition describes a session so it can be shared and recreated
+// for the same worktree elsewhere
+type SessionDefinition struct {
+	Name     string             `json:"name" yaml:"name"`
+	Worktree string             `json:"worktree" yaml:"worktree"`
+	Windows  []WindowDefinition `json:"windows,omitempty" yaml:"windows,omitempty"`
+}
+
+// WindowDefinition describes one window of a SessionDefinition. An empty
+// name lets tmux name the window, and an empty command starts a shell.
+type WindowDefinition struct {
+	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
+	Command string `json:"command,omitempty" yaml:"command,omitempty"`
+}
+
+// ValidateDefinitionName checks that name can be used as-is for an imported
+// session: tmux must accept it and it must fit within maxLength
+func ValidateDefinitionName(name string, maxLength int) error {
+	if name == "" {
+		return fmt.Errorf("session name cannot be empty")
+	}
+	if maxLength > 0 && len(name) > maxLength {
+		return fmt.Errorf("session name '%s' is %d characters, exceeding the maximum of %d", name, len(name), maxLength)
+	}
+	if SanitizeSessionName(name, 0) != name {
+		return fmt.Errorf("session name '%s' contains characters not allowed in session names", name)
+	}
+	return nil
+}
+
+// ExportSession describes session and its windows, recording worktree as the
+// worktree it belongs to
+func (sm *SessionManager) ExportSession(session *Session, worktree string) (*SessionDefinition, error) {
+	windows, err := sm.tmux.ListWindows(session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list windows of %s: %w", session.ID, err)
+	}
+
+	definition := &SessionDefinition{Name: session.Name, Worktree: worktree}
+	for _, window := range windows {
+		windowDef := WindowDefinition{Command: window.Command}
+		if !window.AutoName {
+			windowDef.Name = window.Name
+		}
+		definition.Windows = append(definition.Windows, windowDef)
+	}
+
+	// A lone shell is what every new session starts with
+	if len(definition.Windows) == 1 && definition.Windows[0] == (WindowDefinition{}) {
+		definition.Windows = nil
+	}
+
+	return definition, nil
+}
+
+// ImportSession creates the session described by definition in directory,
+// with its windows in order. The name is used unchanged, so it must pass
+// ValidateDefinitionName; an existing session with the name fails with
+// ErrSessionExists.
+func (sm *SessionManager) ImportSession(definition SessionDefinition, project, branch, directory string) (*Session, error) {
+	if err := ValidateDefinitionName(definition.Name, sm.maxSessionName()); err != nil {
+		return nil, err
+	}
+
+	exists, err := sm.tmux.HasSession(definition.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if session exists: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("session %s %w", definition.Name, ErrSessionExists)
+	}
+
+	session, err := sm.CreateSessionWithName(definition.Name, project, definition.Worktree, branch, directory)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(definition.Windows) == 0 {
+		return session, nil
+	}
+
+	if err := sm.createWindows(session, definition.Windows); err != nil {
+		// Don't leave a half-built session behind
+		_ = sm.KillSession(session.ID)
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// createWindows replaces the initial window of a new session with windows
+func (sm *SessionManager) createWindows(session *Session, windows []WindowDefinition) error {
+	initial, err := sm.tmux.ListWindows(session.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list windows of %s: %w", session.ID, err)
+	}
+
+	for _, window := range windows {
+		if err := sm.tmux.NewWindow(session.ID, window.Name, session.Directory, window.Command); err != nil {
+			return fmt.Errorf("failed to create window in %s: %w", session.ID, err)
+		}
+	}
+
+	for _, window := range initial {
+		if err := sm.tmux.KillWindow(session.ID + ":" + strconv.Itoa(window.Index)); err != nil {
+			return fmt.Errorf("failed to remove initial window of %s: %w", session.ID, err)
+		}
+	}
+
+	return nil
+}
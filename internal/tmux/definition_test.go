@@ -0,0 +1,167 @@
+package tmux
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+func newDefinitionTestManager(t *testing.T) (*SessionManager, *MockTmux) {
+	t.Helper()
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Tmux.MaxSessionName = 30
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(cfg)
+	sm.tmux = mockTmux
+	return sm, mockTmux
+}
+
+func TestExportImportSession_RoundTrip(t *testing.T) {
+	sm, mockTmux := newDefinitionTestManager(t)
+
+	definition := SessionDefinition{
+		Name:     "ccmgr-app-login",
+		Worktree: "feature/login",
+		Windows: []WindowDefinition{
+			{Name: "editor", Command: "nvim ."},
+			{Command: "npm run dev"},
+			{Name: "shell"},
+		},
+	}
+
+	session, err := sm.ImportSession(definition, "app", "feature/login", "/work/app-login")
+	if err != nil {
+		t.Fatalf("ImportSession() error = %v", err)
+	}
+	if session.Name != definition.Name || session.Directory != "/work/app-login" {
+		t.Errorf("Unexpected session: %+v", session)
+	}
+	if got := len(mockTmux.windows[definition.Name]); got != 3 {
+		t.Errorf("Expected the initial window to be replaced by 3 windows, got %d", got)
+	}
+
+	exported, err := sm.ExportSession(session, "feature/login")
+	if err != nil {
+		t.Fatalf("ExportSession() error = %v", err)
+	}
+	if !reflect.DeepEqual(*exported, definition) {
+		t.Errorf("Round trip changed the definition\nwant: %+v\ngot:  %+v", definition, *exported)
+	}
+
+	// Importing the export again recreates the same windows
+	if err := sm.KillSession(session.ID); err != nil {
+		t.Fatalf("KillSession() error = %v", err)
+	}
+	if _, err := sm.ImportSession(*exported, "app", "feature/login", "/work/app-login"); err != nil {
+		t.Fatalf("ImportSession() of export error = %v", err)
+	}
+	again, err := sm.ExportSession(session, "feature/login")
+	if err != nil {
+		t.Fatalf("ExportSession() error = %v", err)
+	}
+	if !reflect.DeepEqual(again, exported) {
+		t.Errorf("Second round trip changed the definition: %+v", again)
+	}
+}
+
+func TestExportSession_PlainShell(t *testing.T) {
+	sm, _ := newDefinitionTestManager(t)
+
+	session, err := sm.CreateSessionWithName("ccmgr-app-main", "app", "main", "main", "/work/app")
+	if err != nil {
+		t.Fatalf("CreateSessionWithName() error = %v", err)
+	}
+
+	exported, err := sm.ExportSession(session, "main")
+	if err != nil {
+		t.Fatalf("ExportSession() error = %v", err)
+	}
+	if exported.Windows != nil {
+		t.Errorf("Expected no windows for a plain shell session, got %+v", exported.Windows)
+	}
+}
+
+func TestImportSession_Existing(t *testing.T) {
+	sm, mockTmux := newDefinitionTestManager(t)
+
+	if _, err := sm.CreateSessionWithName("ccmgr-app-main", "app", "main", "main", "/work/app"); err != nil {
+		t.Fatalf("CreateSessionWithName() error = %v", err)
+	}
+
+	_, err := sm.ImportSession(SessionDefinition{Name: "ccmgr-app-main", Worktree: "main"}, "app", "main", "/work/app")
+	if !errors.Is(err, ErrSessionExists) {
+		t.Errorf("Expected ErrSessionExists, got %v", err)
+	}
+	if len(mockTmux.sessions) != 1 {
+		t.Errorf("Expected no new session, got %d sessions", len(mockTmux.sessions))
+	}
+}
+
+func TestImportSession_WindowFailureRemovesSession(t *testing.T) {
+	sm, mockTmux := newDefinitionTestManager(t)
+	mockTmux.SetFailure("NewWindow", true)
+
+	definition := SessionDefinition{Name: "ccmgr-app-main", Worktree: "main", Windows: []WindowDefinition{{Command: "make watch"}}}
+	if _, err := sm.ImportSession(definition, "app", "main", "/work/app"); err == nil {
+		t.Fatal("Expected ImportSession() to fail")
+	}
+	if mockTmux.sessions["ccmgr-app-main"] {
+		t.Error("Expected the partly created session to be killed")
+	}
+}
+
+func TestValidateDefinitionName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr string
+	}{
+		{name: "ccmgr-app-main"},
+		{name: "", wantErr: "cannot be empty"},
+		{name: "ccmgr-app-a-very-long-feature-branch", wantErr: "exceeding the maximum of 20"},
+		{name: "ccmgr-app:main", wantErr: "characters not allowed"},
+	}
+
+	for _, tt := range tests {
+		err := ValidateDefinitionName(tt.name, 20)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("ValidateDefinitionName(%q) error = %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("ValidateDefinitionName(%q) error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseWindows(t *testing.T) {
+	output := "0\tbash\t1\t\n" +
+		"1\teditor\t0\tnvim\n" +
+		"2\tserver\t0\t\"npm run dev -- --port \\\"3000\\\" \\$HOST\"\n"
+
+	windows, err := parseWindows(output)
+	if err != nil {
+		t.Fatalf("parseWindows() error = %v", err)
+	}
+
+	expected := []Window{
+		{Index: 0, Name: "bash", AutoName: true},
+		{Index: 1, Name: "editor", Command: "nvim"},
+		{Index: 2, Name: "server", Command: `npm run dev -- --port "3000" $HOST`},
+	}
+	if !reflect.DeepEqual(windows, expected) {
+		t.Errorf("parseWindows() = %+v, want %+v", windows, expected)
+	}
+
+	if _, err := parseWindows("garbage\n"); err == nil {
+		t.Error("Expected an error for malformed output")
+	}
+}
@@ -14,7 +14,14 @@ type MockTmux struct {
 	outputs  map[string]string
 	panes    map[string][]string
 	pids     map[string]int
+	dirs     map[string]string
+	windows  map[string][]Window
 	failOps  map[string]bool
+
+	// attachedInWindows records the sessions opened by AttachInNewWindow
+	attachedInWindows []string
+	// sentKeys records the input sent with SendKeys
+	sentKeys []string
 }
 
 func NewMockTmux() *MockTmux {
@@ -23,6 +30,8 @@ func NewMockTmux() *MockTmux {
 		outputs:  make(map[string]string),
 		panes:    make(map[string][]string),
 		pids:     make(map[string]int),
+		dirs:     make(map[string]string),
+		windows:  make(map[string][]Window),
 		failOps:  make(map[string]bool),
 	}
 }
@@ -37,9 +46,11 @@ func (m *MockTmux) NewSession(name, dir string) error {
 	}
 
 	m.sessions[name] = true
+	m.dirs[name] = dir
 	m.panes[name] = []string{"0"}
 	m.pids[name+":0"] = 1234
 	m.outputs[name+":0"] = "claude> ready"
+	m.windows[name] = []Window{{Index: 0, Name: "bash", AutoName: true}}
 
 	return nil
 }
@@ -85,6 +96,28 @@ func (m *MockTmux) DetachSession(name string) error {
 	return nil
 }
 
+func (m *MockTmux) RenameSession(name, newName string) error {
+	if m.failOps["RenameSession"] {
+		return fmt.Errorf("mock error: rename session failed")
+	}
+
+	if !m.sessions[name] {
+		return fmt.Errorf("session not found")
+	}
+	if m.sessions[newName] {
+		return fmt.Errorf("duplicate session: %s", newName)
+	}
+
+	delete(m.sessions, name)
+	m.sessions[newName] = true
+	m.dirs[newName] = m.dirs[name]
+	delete(m.dirs, name)
+	m.windows[newName] = m.windows[name]
+	delete(m.windows, name)
+
+	return nil
+}
+
 func (m *MockTmux) KillSession(name string) error {
 	if m.failOps["KillSession"] {
 		return fmt.Errorf("mock error: kill session failed")
@@ -95,7 +128,9 @@ func (m *MockTmux) KillSession(name string) error {
 	}
 
 	delete(m.sessions, name)
+	delete(m.dirs, name)
 	delete(m.panes, name)
+	delete(m.windows, name)
 
 	for key := range m.pids {
 		if strings.HasPrefix(key, name+":") {
@@ -121,6 +156,7 @@ func (m *MockTmux) SendKeys(session, keys string) error {
 		return fmt.Errorf("session not found")
 	}
 
+	m.sentKeys = append(m.sentKeys, keys)
 	return nil
 }
 
@@ -169,6 +205,78 @@ func (m *MockTmux) GetPanePID(session, pane string) (int, error) {
 	return pid, nil
 }
 
+func (m *MockTmux) GetSessionPath(session string) (string, error) {
+	if m.failOps["GetSessionPath"] {
+		return "", fmt.Errorf("mock error: get session path failed")
+	}
+
+	if !m.sessions[session] {
+		return "", fmt.Errorf("session not found")
+	}
+
+	return m.dirs[session], nil
+}
+
+func (m *MockTmux) ListWindows(session string) ([]Window, error) {
+	if m.failOps["ListWindows"] {
+		return nil, fmt.Errorf("mock error: list windows failed")
+	}
+
+	if !m.sessions[session] {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	return append([]Window(nil), m.windows[session]...), nil
+}
+
+func (m *MockTmux) NewWindow(session, name, startDir, command string) error {
+	if m.failOps["NewWindow"] {
+		return fmt.Errorf("mock error: new window failed")
+	}
+
+	if !m.sessions[session] {
+		return fmt.Errorf("session not found")
+	}
+
+	windows := m.windows[session]
+	index := 0
+	if len(windows) > 0 {
+		index = windows[len(windows)-1].Index + 1
+	}
+	window := Window{Index: index, Name: name, Command: command}
+	if name == "" {
+		window.Name, window.AutoName = "bash", true
+	}
+	m.windows[session] = append(windows, window)
+
+	return nil
+}
+
+func (m *MockTmux) KillWindow(target string) error {
+	if m.failOps["KillWindow"] {
+		return fmt.Errorf("mock error: kill window failed")
+	}
+
+	session, index, _ := strings.Cut(target, ":")
+	windows := m.windows[session]
+	for i, window := range windows {
+		if fmt.Sprint(window.Index) == index {
+			m.windows[session] = append(windows[:i], windows[i+1:]...)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("window not found: %s", target)
+}
+
+func (m *MockTmux) AttachInNewWindow(name string) error {
+	if m.failOps["AttachInNewWindow"] {
+		return fmt.Errorf("mock error: attach in new window failed")
+	}
+	m.attachedInWindows = append(m.attachedInWindows, name)
+	return nil
+}
+
 func (m *MockTmux) SetOutput(session, pane, output string) {
 	key := session + ":" + pane
 	m.outputs[key] = output
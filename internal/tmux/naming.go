@@ -19,6 +19,9 @@ const (
 var (
 	sessionNameRegex = regexp.MustCompile(`^ccmgr-([^-]+)-([^-]+)-(.+)$`)
 	invalidChars     = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+	// invalidSessionChars matches characters tmux rejects or treats as target
+	// separators in session names, such as '.' and ':'
+	invalidSessionChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
 )
 
 func GenerateSessionName(project, worktree, branch string) string {
@@ -64,6 +67,20 @@ func ValidateSessionName(name string) bool {
 	return sessionNameRegex.MatchString(name)
 }
 
+// SanitizeSessionName makes name usable as a tmux session name: every
+// character other than letters, digits, '_' and '-' becomes '_', and the
+// result is cut to maxLength without a trailing separator
+func SanitizeSessionName(name string, maxLength int) string {
+	sanitized := invalidSessionChars.ReplaceAllString(name, "_")
+	sanitized = strings.Trim(sanitized, "_-")
+
+	if maxLength > 0 && len(sanitized) > maxLength {
+		sanitized = strings.TrimRight(sanitized[:maxLength], "_-")
+	}
+
+	return sanitized
+}
+
 func SanitizeNameComponent(component string) string {
 	if component == "" {
 		return "unnamed"
@@ -99,6 +116,7 @@ func truncateSessionName(name string, maxLen int) string {
 	project := parts[1]
 	worktree := parts[2]
 	branch := strings.Join(parts[3:], "-")
+	fullBranch := branch
 
 	availableLength := maxLen - len(prefix) - 3
 
@@ -127,7 +145,7 @@ func truncateSessionName(name string, maxLen int) string {
 	remaining := availableLength - len(project) - len(worktree) - len(branch)
 	if remaining > 0 && branchLen > targetLen {
 		additionalBranchLen := min(remaining, branchLen-targetLen)
-		branch = branch[:len(branch)-1+additionalBranchLen] + "~"
+		branch = fullBranch[:targetLen-1+additionalBranchLen] + "~"
 	}
 
 	return fmt.Sprintf("%s-%s-%s-%s", prefix, project, worktree, branch)
@@ -39,7 +39,7 @@ func TestGenerateSessionName(t *testing.T) {
 			project:  "verylongprojectname",
 			worktree: "verylongworktreename",
 			branch:   "verylongbranchname",
-			expected: "ccmgr-verylongprojectname-verylongworktreename~-verylongbranchname~",
+			expected: "ccmgr-verylongproje~-verylongworkt~-verylongbranc~",
 		},
 	}
 
@@ -252,6 +252,49 @@ func TestSanitizeNameComponent(t *testing.T) {
 	}
 }
 
+func TestSanitizeSessionName(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		maxLength int
+		expected  string
+	}{
+		{
+			name:      "valid name unchanged",
+			input:     "ccmgr-proj-main-feature",
+			maxLength: 50,
+			expected:  "ccmgr-proj-main-feature",
+		},
+		{
+			name:      "dots and colons replaced",
+			input:     "ccmgr-proj-main-fix:bug.v2",
+			maxLength: 50,
+			expected:  "ccmgr-proj-main-fix_bug_v2",
+		},
+		{
+			name:      "truncated without trailing separator",
+			input:     "ccmgr-proj-main-feature",
+			maxLength: 16,
+			expected:  "ccmgr-proj-main",
+		},
+		{
+			name:      "zero max disables truncation",
+			input:     "ccmgr-proj-main-feature",
+			maxLength: 0,
+			expected:  "ccmgr-proj-main-feature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SanitizeSessionName(tt.input, tt.maxLength)
+			if result != tt.expected {
+				t.Errorf("SanitizeSessionName() = %s, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestTruncateSessionName(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -271,6 +314,12 @@ func TestTruncateSessionName(t *testing.T) {
 			maxLen:   30,
 			expected: "ccmgr-verylon~-verylon~-veryl~",
 		},
+		{
+			name:     "long branch keeps remaining space",
+			input:    "ccmgr-abcdefghijklmnopqrst-worktree12-abcdefghijklmnopqrst",
+			maxLen:   50,
+			expected: "ccmgr-abcdefghijklm~-worktree12-abcdefghijklmnop~",
+		},
 		{
 			name:     "extreme truncation",
 			input:    "ccmgr-project-worktree-branch",
@@ -2,15 +2,22 @@ package tmux
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/hooks"
 )
 
+// ErrSessionExists is returned when a session name is already in use
+var ErrSessionExists = errors.New("already exists")
+
 type TmuxInterface interface {
 	NewSession(name, startDir string) error
 	ListSessions() ([]string, error)
@@ -18,16 +25,23 @@ type TmuxInterface interface {
 	AttachSession(name string) error
 	DetachSession(name string) error
 	KillSession(name string) error
+	RenameSession(name, newName string) error
 	SendKeys(session, keys string) error
 	GetSessionPanes(session string) ([]string, error)
 	CapturePane(session, pane string) (string, error)
 	GetPanePID(session, pane string) (int, error)
+	GetSessionPath(session string) (string, error)
+	ListWindows(session string) ([]Window, error)
+	NewWindow(session, name, startDir, command string) error
+	KillWindow(target string) error
+	AttachInNewWindow(name string) error
 }
 
 type SessionManager struct {
 	config *config.Config
 	state  *SessionState
 	tmux   TmuxInterface
+	hooks  *hooks.WorktreeHookManager
 }
 
 type Session struct {
@@ -50,6 +64,7 @@ func NewSessionManager(config *config.Config) *SessionManager {
 	return &SessionManager{
 		config: config,
 		tmux:   NewTmuxCmd(),
+		hooks:  hooks.NewWorktreeHookManager(hooks.NewDefaultExecutor(config)),
 	}
 }
 
@@ -60,18 +75,38 @@ func NewTmuxCmd() *TmuxCmd {
 }
 
 func (sm *SessionManager) CreateSession(project, worktree, branch, directory string) (*Session, error) {
+	return sm.CreateSessionWithName(GenerateSessionName(project, worktree, branch), project, worktree, branch, directory)
+}
+
+// CreateSessionWithName creates a tmux session named after sessionName,
+// sanitized and truncated to the configured maximum length. The returned
+// Session carries the final name. When sanitizing or truncating turns the name
+// into one an existing session already uses, a numeric suffix is appended.
+func (sm *SessionManager) CreateSessionWithName(sessionName, project, worktree, branch, directory string) (*Session, error) {
 	if err := CheckTmuxAvailable(); err != nil {
 		return nil, fmt.Errorf("tmux not available: %w", err)
 	}
 
-	sessionName := GenerateSessionName(project, worktree, branch)
+	requested := sessionName
+	sessionName = SanitizeSessionName(sessionName, sm.maxSessionName())
+	if sessionName == "" {
+		return nil, fmt.Errorf("invalid session name %q", requested)
+	}
 
 	exists, err := sm.tmux.HasSession(sessionName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check if session exists: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("session %s already exists", sessionName)
+		if sessionName == requested {
+			return nil, fmt.Errorf("session %s %w", sessionName, ErrSessionExists)
+		}
+
+		tmuxSessions, err := sm.tmux.ListSessions()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tmux sessions: %w", err)
+		}
+		sessionName = uniqueSessionName(sessionName, tmuxSessions, sm.maxSessionName())
 	}
 
 	if err := sm.tmux.NewSession(sessionName, directory); err != nil {
@@ -99,6 +134,51 @@ func (sm *SessionManager) CreateSession(project, worktree, branch, directory str
 	return session, nil
 }
 
+// UniqueSessionName returns name unchanged when no tmux session uses it, or
+// name with the lowest free numeric suffix ("-2", "-3", ...) otherwise
+func (sm *SessionManager) UniqueSessionName(name string) (string, error) {
+	if err := CheckTmuxAvailable(); err != nil {
+		return "", fmt.Errorf("tmux not available: %w", err)
+	}
+
+	tmuxSessions, err := sm.tmux.ListSessions()
+	if err != nil {
+		return "", fmt.Errorf("failed to list tmux sessions: %w", err)
+	}
+
+	return uniqueSessionName(name, tmuxSessions, sm.maxSessionName()), nil
+}
+
+// maxSessionName returns the configured maximum session name length
+func (sm *SessionManager) maxSessionName() int {
+	if sm.config != nil && sm.config.Tmux.MaxSessionName > 0 {
+		return sm.config.Tmux.MaxSessionName
+	}
+	return maxNameLength
+}
+
+func uniqueSessionName(name string, existing []string, maxLength int) string {
+	taken := make(map[string]bool, len(existing))
+	for _, sessionName := range existing {
+		taken[sessionName] = true
+	}
+
+	if !taken[name] {
+		return name
+	}
+
+	for i := 2; ; i++ {
+		suffix := "-" + strconv.Itoa(i)
+		base := name
+		if len(base)+len(suffix) > maxLength {
+			base = strings.TrimRight(base[:maxLength-len(suffix)], "_-")
+		}
+		if candidate := base + suffix; !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
 func (sm *SessionManager) ListSessions() ([]*Session, error) {
 	if err := CheckTmuxAvailable(); err != nil {
 		return nil, fmt.Errorf("tmux not available: %w", err)
@@ -129,6 +209,9 @@ func (sm *SessionManager) ListSessions() ([]*Session, error) {
 			Active:     true,
 			LastAccess: time.Now(),
 		}
+		if directory, err := sm.tmux.GetSessionPath(sessionName); err == nil {
+			session.Directory = directory
+		}
 
 		sessions = append(sessions, session)
 	}
@@ -178,9 +261,96 @@ func (sm *SessionManager) AttachSession(sessionID string) error {
 		return fmt.Errorf("session %s not found", sessionID)
 	}
 
+	if err := sm.ActivateSession(sm.sessionDetails(sessionID)); err != nil {
+		return err
+	}
+
 	return sm.tmux.AttachSession(sessionID)
 }
 
+// ActivateSession runs the worktree activation hook for session before it is
+// attached. A failing synchronous hook returns an error carrying the script's
+// stderr, and the session should not be attached.
+func (sm *SessionManager) ActivateSession(session *Session) error {
+	if sm.hooks == nil {
+		return nil
+	}
+
+	if err := sm.hooks.OnWorktreeActivated(session.Directory, session.Branch, session.ID, "resume", session.Project); err != nil {
+		return fmt.Errorf("worktree activation hook failed: %w", err)
+	}
+	return nil
+}
+
+// sessionDetails describes sessionID from its persisted state or, without
+// one, from its name and the directory tmux reports for it
+func (sm *SessionManager) sessionDetails(sessionID string) *Session {
+	session := &Session{ID: sessionID, Name: sessionID}
+
+	if sm.state != nil {
+		if persisted, err := sm.state.GetSession(sessionID); err == nil {
+			session.Project = persisted.Project
+			session.Worktree = persisted.Worktree
+			session.Branch = persisted.Branch
+			session.Directory = persisted.Directory
+			return session
+		}
+	}
+
+	if project, worktree, branch, err := ParseSessionName(sessionID); err == nil {
+		session.Project = project
+		session.Worktree = worktree
+		session.Branch = branch
+	}
+	if directory, err := sm.tmux.GetSessionPath(sessionID); err == nil {
+		session.Directory = directory
+	}
+	return session
+}
+
+// AttachSessionInWindow opens sessionID in a new window of the current tmux
+// client, leaving the current window in view. It only works inside tmux.
+func (sm *SessionManager) AttachSessionInWindow(sessionID string) error {
+	if os.Getenv("TMUX") == "" {
+		return fmt.Errorf("opening session %s in a new window requires running inside tmux", sessionID)
+	}
+	if err := CheckTmuxAvailable(); err != nil {
+		return fmt.Errorf("tmux not available: %w", err)
+	}
+
+	exists, err := sm.tmux.HasSession(sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to check session: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("session %s not found", sessionID)
+	}
+
+	if err := sm.ActivateSession(sm.sessionDetails(sessionID)); err != nil {
+		return err
+	}
+
+	return sm.tmux.AttachInNewWindow(sessionID)
+}
+
+// StartClaude launches the configured Claude Code command in sessionID.
+// A prompt is passed to Claude Code as a shell-quoted argument, which it
+// takes as its first input, so nothing is typed before it is ready.
+func (sm *SessionManager) StartClaude(sessionID, prompt string) error {
+	command := sm.config.Commands.ClaudeCommand
+	if command == "" {
+		command = "claude"
+	}
+	if prompt != "" {
+		command += " " + ShellQuote(prompt)
+	}
+
+	if err := sm.tmux.SendKeys(sessionID, command); err != nil {
+		return fmt.Errorf("failed to start Claude Code in session %s: %w", sessionID, err)
+	}
+	return nil
+}
+
 func (sm *SessionManager) DetachSession(sessionID string) error {
 	if err := CheckTmuxAvailable(); err != nil {
 		return fmt.Errorf("tmux not available: %w", err)
@@ -207,6 +377,67 @@ func (sm *SessionManager) KillSession(sessionID string) error {
 	return nil
 }
 
+// RenameSession renames a tmux session and its persisted state entry. The new
+// name is sanitized and truncated like names given to CreateSessionWithName,
+// and the final name is returned. Renaming onto an existing session fails.
+func (sm *SessionManager) RenameSession(sessionID, newName string) (string, error) {
+	if err := CheckTmuxAvailable(); err != nil {
+		return "", fmt.Errorf("tmux not available: %w", err)
+	}
+
+	finalName := SanitizeSessionName(newName, sm.maxSessionName())
+	if finalName == "" {
+		return "", fmt.Errorf("invalid session name %q", newName)
+	}
+
+	exists, err := sm.tmux.HasSession(sessionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check session: %w", err)
+	}
+	if !exists {
+		return "", fmt.Errorf("session %s not found", sessionID)
+	}
+
+	if finalName == sessionID {
+		return finalName, nil
+	}
+
+	taken, err := sm.tmux.HasSession(finalName)
+	if err != nil {
+		return "", fmt.Errorf("failed to check if session exists: %w", err)
+	}
+	if taken {
+		return "", fmt.Errorf("session %s %w", finalName, ErrSessionExists)
+	}
+
+	if err := sm.tmux.RenameSession(sessionID, finalName); err != nil {
+		return "", fmt.Errorf("failed to rename session: %w", err)
+	}
+
+	if sm.state != nil {
+		if err := sm.state.RenameSession(sessionID, finalName); err != nil && !errors.Is(err, ErrSessionNotPersisted) {
+			return "", fmt.Errorf("failed to rename session in state: %w", err)
+		}
+	}
+
+	return finalName, nil
+}
+
+// LoadState loads the persisted session state from the configured state file,
+// so session changes are recorded there
+func (sm *SessionManager) LoadState() error {
+	if sm.config == nil || sm.config.Tmux.StateFile == "" {
+		return nil
+	}
+
+	state, err := LoadState(config.ExpandPath(sm.config.Tmux.StateFile))
+	if err != nil {
+		return err
+	}
+	sm.state = state
+	return nil
+}
+
 func (sm *SessionManager) IsSessionActive(sessionID string) (bool, error) {
 	if err := CheckTmuxAvailable(); err != nil {
 		return false, fmt.Errorf("tmux not available: %w", err)
@@ -251,7 +482,7 @@ func (t *TmuxCmd) HasSession(name string) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, t.executable, "has-session", "-t", name)
+	cmd := exec.CommandContext(ctx, t.executable, "has-session", "-t", SessionTarget(name))
 	err := cmd.Run()
 	if err != nil {
 		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
@@ -262,11 +493,19 @@ func (t *TmuxCmd) HasSession(name string) (bool, error) {
 	return true, nil
 }
 
+// AttachSession hands the terminal to tmux for session name until the
+// client detaches. Inside tmux, attaching would nest sessions, so the
+// current client is switched instead.
 func (t *TmuxCmd) AttachSession(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
+	args := []string{"attach-session", "-t", SessionTarget(name)}
+	if os.Getenv("TMUX") != "" {
+		args = []string{"switch-client", "-t", SessionTarget(name)}
+	}
 
-	cmd := exec.CommandContext(ctx, t.executable, "attach-session", "-t", name)
+	cmd := exec.Command(t.executable, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to attach to tmux session: %w", err)
 	}
@@ -277,7 +516,7 @@ func (t *TmuxCmd) DetachSession(name string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, t.executable, "detach-session", "-t", name)
+	cmd := exec.CommandContext(ctx, t.executable, "detach-session", "-t", SessionTarget(name))
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to detach from tmux session: %w", err)
 	}
@@ -288,18 +527,161 @@ func (t *TmuxCmd) KillSession(name string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, t.executable, "kill-session", "-t", name)
+	cmd := exec.CommandContext(ctx, t.executable, "kill-session", "-t", SessionTarget(name))
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to kill tmux session: %w", err)
 	}
 	return nil
 }
 
+func (t *TmuxCmd) RenameSession(name, newName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, t.executable, "rename-session", "-t", SessionTarget(name), newName)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to rename tmux session: %w", err)
+	}
+	return nil
+}
+
+// windowFormat lists the fields read by ListWindows, tab-separated
+const windowFormat = "#{window_index}\t#{window_name}\t#{automatic-rename}\t#{pane_start_command}"
+
+// ListWindows returns the windows of session in index order, with the
+// command their first pane was started with
+func (t *TmuxCmd) ListWindows(session string) ([]Window, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, t.executable, "list-windows", "-t", SessionTarget(session), "-F", windowFormat)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list windows: %w", err)
+	}
+
+	return parseWindows(string(output))
+}
+
+// parseWindows parses list-windows output in windowFormat
+func parseWindows(output string) ([]Window, error) {
+	var windows []Window
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 4)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("unexpected window format: %q", line)
+		}
+		index, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid window index: %s", fields[0])
+		}
+		windows = append(windows, Window{
+			Index:    index,
+			Name:     fields[1],
+			AutoName: fields[2] == "1",
+			Command:  unquoteStartCommand(fields[3]),
+		})
+	}
+	return windows, nil
+}
+
+// unquoteStartCommand undoes the double quoting tmux applies to a pane start
+// command containing spaces or special characters
+func unquoteStartCommand(command string) string {
+	if len(command) < 2 || command[0] != '"' || command[len(command)-1] != '"' {
+		return command
+	}
+
+	var b strings.Builder
+	escaped := false
+	for _, r := range command[1 : len(command)-1] {
+		if r == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// NewWindow adds a window to session starting in startDir. An empty name
+// leaves tmux to name the window, and an empty command starts a shell.
+func (t *TmuxCmd) NewWindow(session, name, startDir, command string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	args := []string{"new-window", "-d", "-t", SessionTarget(session) + ":", "-c", startDir}
+	if name != "" {
+		args = append(args, "-n", name)
+	}
+	if command != "" {
+		args = append(args, command)
+	}
+
+	cmd := exec.CommandContext(ctx, t.executable, args...)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to create tmux window: %w", err)
+	}
+	return nil
+}
+
+// KillWindow closes the window at target, such as "session:1"
+func (t *TmuxCmd) KillWindow(target string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, t.executable, "kill-window", "-t", SessionTarget(target))
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to kill tmux window: %w", err)
+	}
+	return nil
+}
+
+// AttachInNewWindow adds a background window to the current client's session
+// that runs a nested client attached to session name
+func (t *TmuxCmd) AttachInNewWindow(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	attach := fmt.Sprintf("TMUX= %s attach-session -t %s", t.executable, ShellQuote(SessionTarget(name)))
+	cmd := exec.CommandContext(ctx, t.executable, "new-window", "-d", "-n", name, attach)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to open tmux window for session %s: %w", name, err)
+	}
+	return nil
+}
+
+// SessionTarget returns a -t target that matches only the session named
+// name. Without the '=' tmux falls back to prefix and pattern matching, so a
+// command for "app" could act on "app-feature" once "app" is gone.
+func SessionTarget(name string) string {
+	return "=" + name
+}
+
+// paneTarget returns a -t target for pane, such as a pane ID from
+// GetSessionPanes, in the session named session, or for the active pane of
+// its current window when pane is empty
+func paneTarget(session, pane string) string {
+	if pane == "" {
+		return SessionTarget(session) + ":"
+	}
+	return SessionTarget(session) + ":." + pane
+}
+
+// ShellQuote quotes s for use as a single shell word
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 func (t *TmuxCmd) SendKeys(session, keys string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, t.executable, "send-keys", "-t", session, keys, "Enter")
+	cmd := exec.CommandContext(ctx, t.executable, "send-keys", "-t", paneTarget(session, ""), keys, "Enter")
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("failed to send keys to tmux session: %w", err)
 	}
@@ -310,7 +692,7 @@ func (t *TmuxCmd) GetSessionPanes(session string) ([]string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, t.executable, "list-panes", "-t", session, "-F", "#{pane_id}")
+	cmd := exec.CommandContext(ctx, t.executable, "list-panes", "-t", paneTarget(session, ""), "-F", "#{pane_id}")
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list panes: %w", err)
@@ -328,7 +710,7 @@ func (t *TmuxCmd) CapturePane(session, pane string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, t.executable, "capture-pane", "-t", session+":"+pane, "-p")
+	cmd := exec.CommandContext(ctx, t.executable, "capture-pane", "-t", paneTarget(session, pane), "-p")
 	output, err := cmd.Output()
 	if err != nil {
 		return "", fmt.Errorf("failed to capture pane: %w", err)
@@ -341,12 +723,7 @@ func (t *TmuxCmd) GetPanePID(session, pane string) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	target := session
-	if pane != "" {
-		target = session + ":" + pane
-	}
-
-	cmd := exec.CommandContext(ctx, t.executable, "display-message", "-t", target, "-p", "#{pane_pid}")
+	cmd := exec.CommandContext(ctx, t.executable, "display-message", "-t", paneTarget(session, pane), "-p", "#{pane_pid}")
 	output, err := cmd.Output()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get pane PID: %w", err)
@@ -383,3 +760,69 @@ func (s *Session) toPersistedSession() *PersistedSession {
 		Metadata:    make(map[string]interface{}),
 	}
 }
+
+func (t *TmuxCmd) GetSessionPath(session string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, t.executable, "display-message", "-t", paneTarget(session, ""), "-p", "#{session_path}")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get session path: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// SessionsInPath returns the sessions whose working directory is root or lies
+// beneath it. Paths are compared component-wise after canonicalization, so a
+// session in /foobar is not matched by a worktree at /foo.
+func SessionsInPath(sessions []*Session, root string) []*Session {
+	var matched []*Session
+	for _, session := range sessions {
+		if session.Directory != "" && IsPathWithin(session.Directory, root) {
+			matched = append(matched, session)
+		}
+	}
+	return matched
+}
+
+// ActiveSessionInPath returns an active session running within root,
+// preferring one started in root itself, or nil if there is none
+func ActiveSessionInPath(sessions []*Session, root string) *Session {
+	var match *Session
+	for _, session := range SessionsInPath(sessions, root) {
+		if !session.Active {
+			continue
+		}
+		if match == nil || canonicalPath(session.Directory) == canonicalPath(root) {
+			match = session
+		}
+	}
+	return match
+}
+
+// IsPathWithin reports whether path is root or a descendant of root
+func IsPathWithin(path, root string) bool {
+	if path == "" || root == "" {
+		return false
+	}
+
+	rel, err := filepath.Rel(canonicalPath(root), canonicalPath(path))
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// canonicalPath returns an absolute, cleaned path with symlinks resolved when
+// the path exists
+func canonicalPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
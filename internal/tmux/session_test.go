@@ -1,7 +1,10 @@
 package tmux
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -175,3 +178,551 @@ func TestSessionManagerWithoutTmux(t *testing.T) {
 		t.Error("Expected error when tmux not available")
 	}
 }
+
+func TestUniqueSessionName(t *testing.T) {
+	longName := "ccmgr-project-worktree-" + strings.Repeat("b", maxNameLength-len("ccmgr-project-worktree-"))
+
+	tests := []struct {
+		name     string
+		base     string
+		existing []string
+		expected string
+	}{
+		{
+			name:     "no collision",
+			base:     "ccmgr-proj-main-feature",
+			existing: []string{"ccmgr-proj-main-other"},
+			expected: "ccmgr-proj-main-feature",
+		},
+		{
+			name:     "first collision",
+			base:     "ccmgr-proj-main-feature",
+			existing: []string{"ccmgr-proj-main-feature"},
+			expected: "ccmgr-proj-main-feature-2",
+		},
+		{
+			name:     "skips taken suffixes",
+			base:     "ccmgr-proj-main-feature",
+			existing: []string{"ccmgr-proj-main-feature", "ccmgr-proj-main-feature-2"},
+			expected: "ccmgr-proj-main-feature-3",
+		},
+		{
+			name:     "suffix stays within max length",
+			base:     longName,
+			existing: []string{longName},
+			expected: longName[:maxNameLength-2] + "-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := uniqueSessionName(tt.base, tt.existing, maxNameLength)
+			if result != tt.expected {
+				t.Errorf("uniqueSessionName() = %s, want %s", result, tt.expected)
+			}
+			if len(result) > maxNameLength {
+				t.Errorf("uniqueSessionName() length = %d, exceeds %d", len(result), maxNameLength)
+			}
+		})
+	}
+}
+
+func TestCreateSessionWithName_SameBranchGetsDistinctName(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	sm := NewSessionManager(&config.Config{})
+	sm.tmux = NewMockTmux()
+
+	first, err := sm.CreateSession("proj", "main", "feature", "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create first session: %v", err)
+	}
+
+	name, err := sm.UniqueSessionName(GenerateSessionName("proj", "main", "feature"))
+	if err != nil {
+		t.Fatalf("UniqueSessionName() error = %v", err)
+	}
+
+	second, err := sm.CreateSessionWithName(name, "proj", "main", "feature", "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create second session: %v", err)
+	}
+
+	if first.Name == second.Name {
+		t.Errorf("Expected distinct session names, both were %s", first.Name)
+	}
+	if second.Name != first.Name+"-2" {
+		t.Errorf("Expected second session name %s-2, got %s", first.Name, second.Name)
+	}
+}
+
+func TestCreateSessionWithName_UsesProvidedName(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(&config.Config{})
+	sm.tmux = mockTmux
+
+	session, err := sm.CreateSessionWithName("review-session", "proj", "feature", "feature", "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	if session.Name != "review-session" || session.ID != "review-session" {
+		t.Errorf("Expected session name review-session, got name=%s id=%s", session.Name, session.ID)
+	}
+	if !mockTmux.sessions["review-session"] {
+		t.Error("Expected tmux session review-session to be created")
+	}
+	if session.Branch != "feature" {
+		t.Errorf("Expected branch feature, got %s", session.Branch)
+	}
+}
+
+func TestCreateSessionWithName_TruncatesLongBranch(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(&config.Config{})
+	sm.tmux = mockTmux
+
+	branch := strings.Repeat("b", 120)
+	session, err := sm.CreateSessionWithName("ccmgr-proj-wt-"+branch, "proj", "wt", branch, "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	if len(session.Name) != maxNameLength {
+		t.Errorf("Expected session name of %d characters, got %d: %s", maxNameLength, len(session.Name), session.Name)
+	}
+	if session.ID != session.Name || !mockTmux.sessions[session.Name] {
+		t.Errorf("Expected tmux session %s to be created under its final name", session.Name)
+	}
+
+	session, err = sm.CreateSession("proj", "wt", branch, "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	if len(session.Name) > maxNameLength {
+		t.Errorf("Session name too long: %d > %d", len(session.Name), maxNameLength)
+	}
+}
+
+func TestCreateSessionWithName_SanitizesColons(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(&config.Config{})
+	sm.tmux = mockTmux
+
+	session, err := sm.CreateSessionWithName("ccmgr-proj-wt-fix:login.v2", "proj", "wt", "fix:login.v2", "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	if session.Name != "ccmgr-proj-wt-fix_login_v2" {
+		t.Errorf("Expected session name ccmgr-proj-wt-fix_login_v2, got %s", session.Name)
+	}
+	if session.Branch != "fix:login.v2" {
+		t.Errorf("Expected branch to be kept as given, got %s", session.Branch)
+	}
+}
+
+func TestCreateSessionWithName_TruncationCollision(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Tmux.MaxSessionName = 20
+	sm := NewSessionManager(cfg)
+	sm.tmux = NewMockTmux()
+
+	first, err := sm.CreateSessionWithName("ccmgr-proj-wt-feature-one", "proj", "wt", "feature-one", "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create first session: %v", err)
+	}
+	if first.Name != "ccmgr-proj-wt-featur" {
+		t.Errorf("Expected first session ccmgr-proj-wt-featur, got %s", first.Name)
+	}
+
+	second, err := sm.CreateSessionWithName("ccmgr-proj-wt-feature-two", "proj", "wt", "feature-two", "/tmp")
+	if err != nil {
+		t.Fatalf("Failed to create second session: %v", err)
+	}
+	if second.Name != "ccmgr-proj-wt-feat-2" {
+		t.Errorf("Expected second session ccmgr-proj-wt-feat-2, got %s", second.Name)
+	}
+
+	if _, err := sm.CreateSessionWithName(first.Name, "proj", "wt", "feature-one", "/tmp"); err == nil {
+		t.Error("Expected an error when an unchanged name already exists")
+	}
+}
+
+func TestRenameSession(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Tmux.StateFile = filepath.Join(t.TempDir(), "sessions.json")
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(cfg)
+	sm.tmux = mockTmux
+	if err := sm.LoadState(); err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+
+	if _, err := sm.CreateSessionWithName("ccmgr-proj-wt-old", "proj", "wt", "old", "/tmp"); err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	name, err := sm.RenameSession("ccmgr-proj-wt-old", "ccmgr-proj-wt-new")
+	if err != nil {
+		t.Fatalf("RenameSession() error = %v", err)
+	}
+	if name != "ccmgr-proj-wt-new" {
+		t.Errorf("Expected new name ccmgr-proj-wt-new, got %s", name)
+	}
+	if mockTmux.sessions["ccmgr-proj-wt-old"] || !mockTmux.sessions["ccmgr-proj-wt-new"] {
+		t.Error("Expected tmux session to be renamed")
+	}
+
+	state, err := LoadState(cfg.Tmux.StateFile)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	if _, err := state.GetSession("ccmgr-proj-wt-old"); err == nil {
+		t.Error("Expected old name to be removed from the state file")
+	}
+	persisted, err := state.GetSession("ccmgr-proj-wt-new")
+	if err != nil {
+		t.Fatalf("Expected new name in the state file: %v", err)
+	}
+	if persisted.Name != "ccmgr-proj-wt-new" || persisted.Branch != "old" {
+		t.Errorf("Unexpected persisted session: %+v", persisted)
+	}
+}
+
+func TestRenameSession_RejectsCollision(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(&config.Config{})
+	sm.tmux = mockTmux
+	mockTmux.NewSession("first", "/tmp")
+	mockTmux.NewSession("second", "/tmp")
+
+	_, err := sm.RenameSession("first", "second")
+	if !errors.Is(err, ErrSessionExists) {
+		t.Fatalf("Expected ErrSessionExists, got %v", err)
+	}
+	if !mockTmux.sessions["first"] || !mockTmux.sessions["second"] {
+		t.Error("Expected both sessions to be left untouched")
+	}
+
+	if _, err := sm.RenameSession("missing", "third"); err == nil {
+		t.Error("Expected an error when renaming a missing session")
+	}
+}
+
+func TestRenameSession_TruncatesName(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.Tmux.MaxSessionName = 20
+	mockTmux := NewMockTmux()
+	sm := NewSessionManager(cfg)
+	sm.tmux = mockTmux
+	mockTmux.NewSession("old", "/tmp")
+
+	name, err := sm.RenameSession("old", "ccmgr-proj-wt-feature/renamed.branch")
+	if err != nil {
+		t.Fatalf("RenameSession() error = %v", err)
+	}
+	if name != "ccmgr-proj-wt-featur" {
+		t.Errorf("Expected ccmgr-proj-wt-featur, got %s", name)
+	}
+	if !mockTmux.sessions[name] {
+		t.Errorf("Expected tmux session %s to exist", name)
+	}
+}
+
+func TestIsPathWithin(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		root string
+		want bool
+	}{
+		{"same path", "/work/foo", "/work/foo", true},
+		{"nested path", "/work/foo/src/pkg", "/work/foo", true},
+		{"trailing slash on root", "/work/foo/src", "/work/foo/", true},
+		{"unclean path", "/work/foo/../foo/src", "/work/foo", true},
+		{"sibling with shared prefix", "/work/foobar", "/work/foo", false},
+		{"nested under sibling with shared prefix", "/work/foobar/src", "/work/foo", false},
+		{"parent directory", "/work", "/work/foo", false},
+		{"dot-dot prefixed name", "/work/..foo", "/work", true},
+		{"empty path", "", "/work/foo", false},
+		{"empty root", "/work/foo", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPathWithin(tt.path, tt.root); got != tt.want {
+				t.Errorf("IsPathWithin(%q, %q) = %v, want %v", tt.path, tt.root, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPathWithin_ResolvesSymlinks(t *testing.T) {
+	base := t.TempDir()
+	target := filepath.Join(base, "worktree")
+	if err := os.MkdirAll(filepath.Join(target, "src"), 0755); err != nil {
+		t.Fatalf("Failed to create worktree dir: %v", err)
+	}
+	link := filepath.Join(base, "link")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	if !IsPathWithin(filepath.Join(link, "src"), target) {
+		t.Error("Expected path through symlink to be within its target")
+	}
+}
+
+func TestSessionsInPath(t *testing.T) {
+	sessions := []*Session{
+		{Name: "ccmgr-proj-foo-main", Directory: "/work/foo"},
+		{Name: "ccmgr-proj-foo-sub", Directory: "/work/foo/docs"},
+		{Name: "ccmgr-proj-foobar-main", Directory: "/work/foobar"},
+		{Name: "ccmgr-proj-nodir-main", Worktree: "foo"},
+	}
+
+	matched := SessionsInPath(sessions, "/work/foo")
+	if len(matched) != 2 {
+		t.Fatalf("Expected 2 sessions within /work/foo, got %d", len(matched))
+	}
+	for _, session := range matched {
+		if session.Name == "ccmgr-proj-foobar-main" {
+			t.Error("Session in /work/foobar should not match worktree /work/foo")
+		}
+	}
+}
+
+func TestActiveSessionInPath(t *testing.T) {
+	nested := &Session{Name: "nested", Directory: "/work/foo/docs", Active: true}
+	root := &Session{Name: "root", Directory: "/work/foo", Active: true}
+	inactive := &Session{Name: "inactive", Directory: "/work/foo", Active: false}
+	sibling := &Session{Name: "sibling", Directory: "/work/foobar", Active: true}
+
+	if got := ActiveSessionInPath([]*Session{nested, root, sibling}, "/work/foo"); got != root {
+		t.Errorf("Expected the session in the worktree root, got %v", got)
+	}
+	if got := ActiveSessionInPath([]*Session{inactive, nested}, "/work/foo"); got != nested {
+		t.Errorf("Expected the active nested session, got %v", got)
+	}
+	if got := ActiveSessionInPath([]*Session{inactive, sibling}, "/work/foo"); got != nil {
+		t.Errorf("Expected no session, got %v", got)
+	}
+}
+
+func TestListSessions_PopulatesDirectory(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	mockTmux := NewMockTmux()
+	mockTmux.NewSession("ccmgr-proj-feature-main", "/work/feature")
+	sm := NewSessionManager(&config.Config{})
+	sm.tmux = mockTmux
+
+	sessions, err := sm.ListSessions()
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("Expected 1 session, got %d", len(sessions))
+	}
+	if sessions[0].Directory != "/work/feature" {
+		t.Errorf("Expected directory /work/feature, got %q", sessions[0].Directory)
+	}
+}
+
+// newActivationHookManager returns a session manager on a mocked tmux whose
+// worktree activation hook runs script synchronously
+func newActivationHookManager(t *testing.T, script string) *SessionManager {
+	scriptPath := filepath.Join(t.TempDir(), "activation.sh")
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write hook script: %v", err)
+	}
+
+	cfg := &config.Config{}
+	cfg.WorktreeHooks.Enabled = true
+	cfg.WorktreeHooks.ActivationHook = config.HookConfig{Enabled: true, Script: scriptPath, Timeout: 10}
+
+	sm := NewSessionManager(cfg)
+	sm.tmux = NewMockTmux()
+	return sm
+}
+
+func TestAttachSession_RunsActivationHook(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	envFile := filepath.Join(t.TempDir(), "env")
+	sm := newActivationHookManager(t, "#!/bin/sh\nenv > "+envFile+"\n")
+
+	worktree := t.TempDir()
+	session, err := sm.CreateSession("proj", "wt", "feature", worktree)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	if err := sm.AttachSession(session.ID); err != nil {
+		t.Fatalf("AttachSession() error = %v", err)
+	}
+
+	data, err := os.ReadFile(envFile)
+	if err != nil {
+		t.Fatalf("activation hook did not run: %v", err)
+	}
+	env := string(data)
+	for _, want := range []string{
+		"CCMGR_WORKTREE_PATH=" + worktree + "\n",
+		"CCMGR_BRANCH=feature\n",
+		"CCMGR_PROJECT=proj\n",
+		"CCMGR_SESSION_ID=" + session.ID + "\n",
+	} {
+		if !strings.Contains(env, want) {
+			t.Errorf("hook environment is missing %q", strings.TrimSpace(want))
+		}
+	}
+}
+
+func TestAttachSession_FailingActivationHook(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	sm := newActivationHookManager(t, "#!/bin/sh\necho 'direnv blocked' >&2\nexit 1\n")
+
+	session, err := sm.CreateSession("proj", "wt", "feature", t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	err = sm.AttachSession(session.ID)
+	if err == nil {
+		t.Fatal("AttachSession() succeeded with a failing activation hook")
+	}
+	if !strings.Contains(err.Error(), "direnv blocked") {
+		t.Errorf("error %q does not include the hook's stderr", err)
+	}
+}
+
+func TestAttachSessionInWindow(t *testing.T) {
+	if err := CheckTmuxAvailable(); err != nil {
+		t.Skipf("tmux not available for testing: %v", err)
+	}
+
+	mock := NewMockTmux()
+	mock.NewSession("ccmgr-app-main", "/work/app")
+	sm := &SessionManager{config: &config.Config{}, tmux: mock}
+
+	t.Run("outside tmux", func(t *testing.T) {
+		t.Setenv("TMUX", "")
+		if err := sm.AttachSessionInWindow("ccmgr-app-main"); err == nil {
+			t.Error("Expected error outside tmux")
+		}
+		if len(mock.attachedInWindows) != 0 {
+			t.Errorf("Expected no windows, got %v", mock.attachedInWindows)
+		}
+	})
+
+	t.Run("inside tmux", func(t *testing.T) {
+		t.Setenv("TMUX", "/tmp/tmux-0/default,1,0")
+		if err := sm.AttachSessionInWindow("ccmgr-app-main"); err != nil {
+			t.Fatalf("AttachSessionInWindow() error = %v", err)
+		}
+		if len(mock.attachedInWindows) != 1 || mock.attachedInWindows[0] != "ccmgr-app-main" {
+			t.Errorf("Expected a window for ccmgr-app-main, got %v", mock.attachedInWindows)
+		}
+
+		if err := sm.AttachSessionInWindow("missing"); err == nil {
+			t.Error("Expected error for a missing session")
+		}
+	})
+}
+
+func TestSessionTargets(t *testing.T) {
+	if got := SessionTarget("ccmgr-app"); got != "=ccmgr-app" {
+		t.Errorf("SessionTarget() = %q, want an exact match target", got)
+	}
+
+	tests := map[string]string{
+		"":   "=ccmgr-app:",
+		"%3": "=ccmgr-app:.%3",
+	}
+	for pane, want := range tests {
+		if got := paneTarget("ccmgr-app", pane); got != want {
+			t.Errorf("paneTarget(%q) = %q, want %q", pane, got, want)
+		}
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := map[string]string{
+		"ccmgr-app-main": "'ccmgr-app-main'",
+		"it's":           `'it'\''s'`,
+		"":               "''",
+	}
+	for input, want := range tests {
+		if got := ShellQuote(input); got != want {
+			t.Errorf("ShellQuote(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestStartClaude(t *testing.T) {
+	mock := NewMockTmux()
+	mock.NewSession("ccmgr-app-main", "/work/app")
+	cfg := &config.Config{}
+	cfg.Commands.ClaudeCommand = "claude --continue"
+	sm := &SessionManager{config: cfg, tmux: mock}
+
+	if err := sm.StartClaude("ccmgr-app-main", ""); err != nil {
+		t.Fatalf("StartClaude() error = %v", err)
+	}
+	if len(mock.sentKeys) != 1 || mock.sentKeys[0] != "claude --continue" {
+		t.Errorf("Expected the configured Claude command to be sent, got %v", mock.sentKeys)
+	}
+
+	prompt := `Fix the "login" bug; don't touch C-c handling`
+	if err := sm.StartClaude("ccmgr-app-main", prompt); err != nil {
+		t.Fatalf("StartClaude() error = %v", err)
+	}
+	want := `claude --continue 'Fix the "login" bug; don'\''t touch C-c handling'`
+	if len(mock.sentKeys) != 2 || mock.sentKeys[1] != want {
+		t.Errorf("Expected the prompt to be passed as a quoted argument, got %v", mock.sentKeys)
+	}
+
+	mock.failOps["SendKeys"] = true
+	if err := sm.StartClaude("ccmgr-app-main", prompt); err == nil || !strings.Contains(err.Error(), "failed to start Claude Code") {
+		t.Errorf("Expected a start error, got %v", err)
+	}
+}
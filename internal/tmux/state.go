@@ -2,13 +2,28 @@ package tmux
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
+	"syscall"
 	"time"
 )
 
+// ErrSessionNotPersisted is returned when a session has no entry in the state
+// file
+var ErrSessionNotPersisted = errors.New("session not found in state")
+
+// ErrStateLocked is returned when the state file stays locked by another
+// process for longer than the lock timeout
+var ErrStateLocked = errors.New("another ccmgr-ultra instance is holding the state file")
+
+// stateLockTimeout is how long reads and writes wait for the state file lock
+var stateLockTimeout = 5 * time.Second
+
+const stateLockRetryInterval = 50 * time.Millisecond
+
 type SessionState struct {
 	FilePath string
 	Sessions map[string]*PersistedSession
@@ -29,6 +44,10 @@ type PersistedSession struct {
 	Metadata    map[string]interface{} `json:"metadata"`
 }
 
+// LoadState reads the session state from filePath, creating the file when it
+// does not exist. Every instance of ccmgr-ultra shares the file, so reads and
+// writes take a lock on filePath + ".lock", and changes reread the file first
+// to pick up what other instances changed.
 func LoadState(filePath string) (*SessionState, error) {
 	state := &SessionState{
 		FilePath: filePath,
@@ -42,25 +61,20 @@ func LoadState(filePath string) (*SessionState, error) {
 		return state, nil
 	}
 
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read state file: %w", err)
-	}
-
-	if len(data) == 0 {
-		return state, nil
-	}
-
-	var sessions map[string]*PersistedSession
-	if err := json.Unmarshal(data, &sessions); err != nil {
-		backupPath := filePath + ".backup." + time.Now().Format("20060102-150405")
-		if backupErr := os.WriteFile(backupPath, data, 0644); backupErr == nil {
-			fmt.Printf("Warning: Corrupted state file backed up to %s\n", backupPath)
+	err := state.withFileLock(syscall.LOCK_SH, func() error {
+		sessions, err := readSessions(filePath)
+		if err != nil {
+			return err
 		}
-		return state, nil
+		if sessions != nil {
+			state.Sessions = sessions
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	state.Sessions = sessions
 	return state, nil
 }
 
@@ -68,26 +82,7 @@ func (ss *SessionState) SaveState() error {
 	ss.mutex.RLock()
 	defer ss.mutex.RUnlock()
 
-	if err := os.MkdirAll(filepath.Dir(ss.FilePath), 0755); err != nil {
-		return fmt.Errorf("failed to create state directory: %w", err)
-	}
-
-	data, err := json.MarshalIndent(ss.Sessions, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal state: %w", err)
-	}
-
-	tempFile := ss.FilePath + ".tmp"
-	if err := os.WriteFile(tempFile, data, 0644); err != nil {
-		return fmt.Errorf("failed to write temp state file: %w", err)
-	}
-
-	if err := os.Rename(tempFile, ss.FilePath); err != nil {
-		os.Remove(tempFile)
-		return fmt.Errorf("failed to atomic write state file: %w", err)
-	}
-
-	return nil
+	return ss.withFileLock(syscall.LOCK_EX, ss.saveStateUnsafe)
 }
 
 func (ss *SessionState) AddSession(session *PersistedSession) error {
@@ -105,58 +100,87 @@ func (ss *SessionState) AddSession(session *PersistedSession) error {
 		session.Metadata = make(map[string]interface{})
 	}
 
-	ss.Sessions[session.ID] = session
-	return ss.saveStateUnsafe()
+	return ss.updateUnsafe(func() error {
+		ss.Sessions[session.ID] = session
+		return nil
+	})
 }
 
 func (ss *SessionState) RemoveSession(sessionID string) error {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
 
-	if _, exists := ss.Sessions[sessionID]; !exists {
-		return fmt.Errorf("session %s not found", sessionID)
-	}
+	return ss.updateUnsafe(func() error {
+		if _, exists := ss.Sessions[sessionID]; !exists {
+			return fmt.Errorf("session %s not found", sessionID)
+		}
 
-	delete(ss.Sessions, sessionID)
-	return ss.saveStateUnsafe()
+		delete(ss.Sessions, sessionID)
+		return nil
+	})
+}
+
+// RenameSession moves the entry for sessionID to newID, updating its ID and
+// name
+func (ss *SessionState) RenameSession(sessionID, newID string) error {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	return ss.updateUnsafe(func() error {
+		session, exists := ss.Sessions[sessionID]
+		if !exists {
+			return fmt.Errorf("session %s: %w", sessionID, ErrSessionNotPersisted)
+		}
+		if _, taken := ss.Sessions[newID]; taken {
+			return fmt.Errorf("session %s already exists", newID)
+		}
+
+		delete(ss.Sessions, sessionID)
+		session.ID = newID
+		session.Name = newID
+		ss.Sessions[newID] = session
+		return nil
+	})
 }
 
 func (ss *SessionState) UpdateSession(sessionID string, updates map[string]interface{}) error {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
 
-	session, exists := ss.Sessions[sessionID]
-	if !exists {
-		return fmt.Errorf("session %s not found", sessionID)
-	}
+	return ss.updateUnsafe(func() error {
+		session, exists := ss.Sessions[sessionID]
+		if !exists {
+			return fmt.Errorf("session %s not found", sessionID)
+		}
 
-	for key, value := range updates {
-		switch key {
-		case "last_access":
-			if t, ok := value.(time.Time); ok {
-				session.LastAccess = t
-			}
-		case "last_state":
-			if state, ok := value.(ProcessState); ok {
-				session.LastState = state
+		for key, value := range updates {
+			switch key {
+			case "last_access":
+				if t, ok := value.(time.Time); ok {
+					session.LastAccess = t
+				}
+			case "last_state":
+				if state, ok := value.(ProcessState); ok {
+					session.LastState = state
+				}
+			case "directory":
+				if dir, ok := value.(string); ok {
+					session.Directory = dir
+				}
+			case "branch":
+				if branch, ok := value.(string); ok {
+					session.Branch = branch
+				}
+			default:
+				if session.Metadata == nil {
+					session.Metadata = make(map[string]interface{})
+				}
+				session.Metadata[key] = value
 			}
-		case "directory":
-			if dir, ok := value.(string); ok {
-				session.Directory = dir
-			}
-		case "branch":
-			if branch, ok := value.(string); ok {
-				session.Branch = branch
-			}
-		default:
-			if session.Metadata == nil {
-				session.Metadata = make(map[string]interface{})
-			}
-			session.Metadata[key] = value
 		}
-	}
 
-	return ss.saveStateUnsafe()
+		return nil
+	})
 }
 
 func (ss *SessionState) GetSession(sessionID string) (*PersistedSession, error) {
@@ -209,29 +233,48 @@ func (ss *SessionState) CleanupStaleEntries(maxAge time.Duration) error {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
 
-	cutoff := time.Now().Add(-maxAge)
-	staleSessions := make([]string, 0)
+	return ss.updateUnsafe(func() error {
+		cutoff := time.Now().Add(-maxAge)
 
-	for id, session := range ss.Sessions {
-		if session.LastAccess.Before(cutoff) {
-			exists, err := checkSessionExists(id)
-			if err != nil || !exists {
-				staleSessions = append(staleSessions, id)
+		for id, session := range ss.Sessions {
+			if session.LastAccess.Before(cutoff) {
+				exists, err := checkSessionExists(id)
+				if err != nil || !exists {
+					delete(ss.Sessions, id)
+				}
 			}
 		}
-	}
 
-	for _, id := range staleSessions {
-		delete(ss.Sessions, id)
-	}
+		return nil
+	})
+}
 
-	if len(staleSessions) > 0 {
-		return ss.saveStateUnsafe()
-	}
+// updateUnsafe applies change and writes the result while holding the
+// exclusive file lock. The sessions are reloaded from the file first, as it
+// is the source of truth, so only this change is written and the additions,
+// updates and removals of other instances are kept. The caller must hold
+// ss.mutex.
+func (ss *SessionState) updateUnsafe(change func() error) error {
+	return ss.withFileLock(syscall.LOCK_EX, func() error {
+		sessions, err := readSessions(ss.FilePath)
+		if err != nil {
+			return err
+		}
+		if sessions == nil {
+			sessions = make(map[string]*PersistedSession)
+		}
+		ss.Sessions = sessions
 
-	return nil
+		if err := change(); err != nil {
+			return err
+		}
+		return ss.saveStateUnsafe()
+	})
 }
 
+// saveStateUnsafe writes the sessions to a temporary file and renames it over
+// the state file, so readers never see a partial write. The caller must hold
+// the exclusive file lock.
 func (ss *SessionState) saveStateUnsafe() error {
 	if err := os.MkdirAll(filepath.Dir(ss.FilePath), 0755); err != nil {
 		return fmt.Errorf("failed to create state directory: %w", err)
@@ -242,19 +285,93 @@ func (ss *SessionState) saveStateUnsafe() error {
 		return fmt.Errorf("failed to marshal state: %w", err)
 	}
 
-	tempFile := ss.FilePath + ".tmp"
-	if err := os.WriteFile(tempFile, data, 0644); err != nil {
+	tempFile, err := os.CreateTemp(filepath.Dir(ss.FilePath), filepath.Base(ss.FilePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp state file: %w", err)
+	}
+	defer os.Remove(tempFile.Name())
+
+	if _, err := tempFile.Write(data); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to write temp state file: %w", err)
+	}
+	if err := tempFile.Chmod(0644); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to write temp state file: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
 		return fmt.Errorf("failed to write temp state file: %w", err)
 	}
 
-	if err := os.Rename(tempFile, ss.FilePath); err != nil {
-		os.Remove(tempFile)
+	if err := os.Rename(tempFile.Name(), ss.FilePath); err != nil {
 		return fmt.Errorf("failed to atomic write state file: %w", err)
 	}
 
 	return nil
 }
 
+// withFileLock runs fn holding a flock of the given kind (syscall.LOCK_SH or
+// syscall.LOCK_EX) on the state's lock file. The state file itself is
+// replaced on every write, so it cannot carry the lock.
+func (ss *SessionState) withFileLock(how int, fn func() error) error {
+	lockPath := ss.FilePath + ".lock"
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open state lock file: %w", err)
+	}
+	// Closing the file releases the lock
+	defer lockFile.Close()
+
+	deadline := time.Now().Add(stateLockTimeout)
+	for {
+		err := syscall.Flock(int(lockFile.Fd()), how|syscall.LOCK_NB)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, syscall.EWOULDBLOCK) && !errors.Is(err, syscall.EINTR) {
+			return fmt.Errorf("failed to lock state file: %w", err)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w (waited %s for %s)", ErrStateLocked, stateLockTimeout, lockPath)
+		}
+		time.Sleep(stateLockRetryInterval)
+	}
+
+	return fn()
+}
+
+// readSessions reads the sessions recorded in filePath. It returns nil when
+// the file is missing, empty or corrupted, backing up a corrupted file first.
+// The caller must hold the file lock.
+func readSessions(filePath string) (map[string]*PersistedSession, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state file: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var sessions map[string]*PersistedSession
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		backupPath := filePath + ".backup." + time.Now().Format("20060102-150405")
+		if backupErr := os.WriteFile(backupPath, data, 0644); backupErr == nil {
+			fmt.Printf("Warning: Corrupted state file backed up to %s\n", backupPath)
+		}
+		return nil, nil
+	}
+
+	return sessions, nil
+}
+
 func checkSessionExists(sessionID string) (bool, error) {
 	tmux := NewTmuxCmd()
 	return tmux.HasSession(sessionID)
@@ -1,8 +1,14 @@
 package tmux
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -225,6 +231,9 @@ func TestRemoveSession(t *testing.T) {
 	}
 
 	state.Sessions["test-session"] = session
+	if err := state.SaveState(); err != nil {
+		t.Fatalf("SaveState() error = %v", err)
+	}
 
 	err := state.RemoveSession("test-session")
 	if err != nil {
@@ -265,6 +274,9 @@ func TestUpdateSession(t *testing.T) {
 	}
 
 	state.Sessions["test-session"] = session
+	if err := state.SaveState(); err != nil {
+		t.Fatalf("SaveState() error = %v", err)
+	}
 
 	newTime := time.Now()
 	updates := map[string]interface{}{
@@ -507,3 +519,148 @@ func TestGetSessionsByWorktree(t *testing.T) {
 		t.Errorf("Expected 1 session for dev worktree, got %d", len(devSessions))
 	}
 }
+
+func TestSessionStateConcurrentInstances(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	// Each goroutine loads its own SessionState, like separate ccmgr-ultra
+	// processes sharing the state file
+	const instances = 8
+	const sessionsPerInstance = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, instances)
+	for i := 0; i < instances; i++ {
+		wg.Add(1)
+		go func(instance int) {
+			defer wg.Done()
+
+			state, err := LoadState(stateFile)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for j := 0; j < sessionsPerInstance; j++ {
+				id := fmt.Sprintf("session-%d-%d", instance, j)
+				if err := state.AddSession(&PersistedSession{ID: id, Name: id}); err != nil {
+					errs <- err
+					return
+				}
+				if err := state.UpdateSession(id, map[string]interface{}{"last_access": time.Now()}); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Concurrent state change failed: %v", err)
+	}
+
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatalf("Failed to read state file: %v", err)
+	}
+	var sessions map[string]*PersistedSession
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		t.Fatalf("State file is not valid JSON: %v\n%s", err, data)
+	}
+
+	expected := instances * sessionsPerInstance
+	if len(sessions) != expected {
+		t.Errorf("Expected %d sessions to survive concurrent writes, got %d", expected, len(sessions))
+	}
+
+	leftovers, _ := filepath.Glob(stateFile + ".*.tmp")
+	if len(leftovers) != 0 {
+		t.Errorf("Expected no temp files to be left behind, got %v", leftovers)
+	}
+}
+
+func TestSessionStateKeepsOtherInstancesChanges(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	first, err := LoadState(stateFile)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	for _, id := range []string{"removed", "updated"} {
+		if err := first.AddSession(&PersistedSession{ID: id, Name: id, Branch: "main"}); err != nil {
+			t.Fatalf("AddSession() error = %v", err)
+		}
+	}
+
+	// Another instance removes one session and updates the other
+	second, err := LoadState(stateFile)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	if err := second.RemoveSession("removed"); err != nil {
+		t.Fatalf("RemoveSession() error = %v", err)
+	}
+	if err := second.UpdateSession("updated", map[string]interface{}{"branch": "feature"}); err != nil {
+		t.Fatalf("UpdateSession() error = %v", err)
+	}
+
+	// The first instance's stale copy must not undo those changes
+	if err := first.AddSession(&PersistedSession{ID: "added", Name: "added"}); err != nil {
+		t.Fatalf("AddSession() error = %v", err)
+	}
+
+	reloaded, err := LoadState(stateFile)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	if _, err := reloaded.GetSession("removed"); err == nil {
+		t.Error("Expected the removed session to stay removed")
+	}
+	updated, err := reloaded.GetSession("updated")
+	if err != nil {
+		t.Fatalf("GetSession() error = %v", err)
+	}
+	if updated.Branch != "feature" {
+		t.Errorf("Expected branch feature, got %s", updated.Branch)
+	}
+	if _, err := reloaded.GetSession("added"); err != nil {
+		t.Errorf("Expected the added session to be saved: %v", err)
+	}
+}
+
+func TestSessionStateLockTimeout(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	state, err := LoadState(stateFile)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+
+	previous := stateLockTimeout
+	stateLockTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { stateLockTimeout = previous })
+
+	// Hold the lock as another instance would
+	holder, err := os.OpenFile(stateFile+".lock", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open lock file: %v", err)
+	}
+	defer holder.Close()
+	if err := syscall.Flock(int(holder.Fd()), syscall.LOCK_EX); err != nil {
+		t.Fatalf("Failed to lock state file: %v", err)
+	}
+
+	err = state.AddSession(&PersistedSession{ID: "blocked"})
+	if !errors.Is(err, ErrStateLocked) {
+		t.Fatalf("Expected ErrStateLocked, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "another ccmgr-ultra instance is holding the state file") {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+
+	if err := syscall.Flock(int(holder.Fd()), syscall.LOCK_UN); err != nil {
+		t.Fatalf("Failed to unlock state file: %v", err)
+	}
+	if err := state.AddSession(&PersistedSession{ID: "unblocked"}); err != nil {
+		t.Errorf("AddSession() after unlock error = %v", err)
+	}
+}
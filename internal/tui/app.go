@@ -53,8 +53,18 @@ type AppModel struct {
 	ready     bool
 	quitting  bool
 
+	// confirmingQuit is set while the quit confirmation modal is shown
+	confirmingQuit bool
+
+	// pendingKill holds the sessions awaiting kill confirmation, if any
+	pendingKill []SessionInfo
+
+	// pendingDelete holds the worktree awaiting delete confirmation, if any
+	pendingDelete *WorktreeInfo
+
 	// Styles
-	theme Theme
+	theme        Theme
+	themeWarning error
 }
 
 // Theme holds the color scheme and styles for the TUI
@@ -85,75 +95,6 @@ type Theme struct {
 	WarningStyle  lipgloss.Style // New: for warning messages
 }
 
-// DefaultTheme returns the default color theme
-func DefaultTheme() Theme {
-	return Theme{
-		Primary:    lipgloss.Color("#646CFF"),
-		Secondary:  lipgloss.Color("#747BFF"),
-		Accent:     lipgloss.Color("#42A5F5"),
-		Background: lipgloss.Color("#1E1E2E"),
-		Text:       lipgloss.Color("#CDD6F4"),
-		Muted:      lipgloss.Color("#6C7086"),
-		Success:    lipgloss.Color("#A6E3A1"),
-		Warning:    lipgloss.Color("#F9E2AF"),
-		Error:      lipgloss.Color("#F38BA8"),
-		Info:       lipgloss.Color("#89B4FA"), // New: blue for info status
-
-		BorderStyle: lipgloss.RoundedBorder(),
-
-		TitleStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#646CFF")).
-			Bold(true).
-			Padding(0, 1),
-
-		HeaderStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#CDD6F4")).
-			Bold(true).
-			Padding(0, 1),
-
-		ContentStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#CDD6F4")).
-			Padding(1),
-
-		FooterStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6C7086")).
-			Padding(0, 1),
-
-		SelectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#1E1E2E")).
-			Background(lipgloss.Color("#646CFF")).
-			Bold(true),
-
-		StatusStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#CDD6F4")).
-			Background(lipgloss.Color("#313244")).
-			Padding(0, 1),
-
-		LabelStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#CDD6F4")).
-			Bold(false),
-
-		FocusedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#646CFF")).
-			Bold(true),
-
-		MutedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6C7086")),
-
-		SuccessStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#A6E3A1")).
-			Bold(true),
-
-		ErrorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F38BA8")).
-			Bold(true),
-
-		WarningStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F9E2AF")).
-			Bold(true),
-	}
-}
-
 // NewAppModel creates a new application model
 func NewAppModel(ctx context.Context, config *config.Config) (*AppModel, error) {
 	// Initialize integration layer
@@ -165,8 +106,9 @@ func NewAppModel(ctx context.Context, config *config.Config) (*AppModel, error)
 	// Create key handler
 	keyHandler := NewKeyHandler()
 
-	// Initialize theme
-	theme := DefaultTheme()
+	// Initialize theme; an unknown name falls back to the default and is
+	// reported once the TUI is running
+	theme, themeErr := ThemeFromConfig(&config.TUI)
 
 	// Convert theme for modal and context systems
 	modalTheme := modals.Theme{
@@ -219,7 +161,8 @@ func NewAppModel(ctx context.Context, config *config.Config) (*AppModel, error)
 			Warning:    theme.Warning,
 			Error:      theme.Error,
 		}),
-		theme: theme,
+		theme:        theme,
+		themeWarning: themeErr,
 	}
 
 	// Create integration adapter for workflows
@@ -258,11 +201,20 @@ func (m *AppModel) initializeScreens() {
 
 // Init implements the tea.Model interface
 func (m *AppModel) Init() tea.Cmd {
-	// Start background data refresh
-	return tea.Batch(
+	cmds := []tea.Cmd{
+		// Start background data refresh
 		m.integration.StartPeriodicRefresh(),
 		tea.WindowSize(), // Get initial window size
-	)
+	}
+
+	if m.themeWarning != nil {
+		warning := m.themeWarning.Error()
+		cmds = append(cmds, func() tea.Msg {
+			return ShowErrorMsg{Title: "Unknown Theme", Message: warning}
+		})
+	}
+
+	return tea.Batch(cmds...)
 }
 
 // Update implements the tea.Model interface
@@ -295,6 +247,11 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.KeyMsg:
+		// ctrl+c at the quit confirmation quits without answering it
+		if m.confirmingQuit && msg.String() == "ctrl+c" {
+			return m.quit()
+		}
+
 		// Handle modal input first if modal is active
 		if m.modalManager.IsActive() {
 			cmd = m.modalManager.Update(msg)
@@ -320,9 +277,15 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle global key bindings
 		switch msg.String() {
-		case "ctrl+c", "q":
-			m.quitting = true
-			return m, tea.Quit
+		case "ctrl+c":
+			return m.quit()
+
+		case "q":
+			if m.config.TUI.ConfirmQuit {
+				m.showQuitConfirmation()
+				return m, nil
+			}
+			return m.quit()
 
 		case "1":
 			return m.switchScreen(ScreenDashboard)
@@ -337,15 +300,11 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Workflow shortcuts
 		case "ctrl+n":
-			// New session wizard - TODO: Implement
-			modal := modals.NewSimpleErrorModal("Not Implemented", "Session wizard not yet implemented")
-			m.modalManager.ShowModal(modal)
+			m.modalManager.ShowModal(m.sessionWizard.CreateWizard())
 			return m, nil
 
 		case "ctrl+w":
-			// New worktree wizard - TODO: Implement
-			modal := modals.NewSimpleErrorModal("Not Implemented", "Worktree wizard not yet implemented")
-			m.modalManager.ShowModal(modal)
+			m.modalManager.ShowModal(m.worktreeWizard.CreateWizard())
 			return m, nil
 
 		// Dashboard quick actions (only when on dashboard screen)
@@ -411,6 +370,43 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Handle resume session request
 		return m.handleResumeSessionRequest(msg)
 
+	case DeleteWorktreeRequestedMsg:
+		// Handle delete request from the worktree details panel
+		m.showDeleteWorktreeConfirmation(msg.Worktree)
+		return m, nil
+
+	case WorktreeDeletedMsg:
+		return m, m.integration.RefreshData()
+
+	case KillSessionsRequestedMsg:
+		m.showKillSessionsConfirmation(msg.Sessions)
+		return m, nil
+
+	case SessionsKilledMsg:
+		if msg.Err != nil {
+			m.modalManager.ShowModal(modals.NewSimpleErrorModal("Error",
+				"Failed to kill sessions: "+msg.Err.Error()))
+		}
+		return m, m.integration.RefreshData()
+
+	case WorktreeCreatedMsg:
+		m.modalManager.ShowModal(modals.NewSimpleErrorModal("Success",
+			"Worktree created at '"+msg.Path+"'"))
+		return m, m.integration.RefreshData()
+
+	case SessionCreatedMsg:
+		m.modalManager.ShowModal(modals.NewSimpleErrorModal("Success",
+			"Session '"+msg.SessionID+"' created successfully"))
+		return m, m.integration.RefreshData()
+
+	case ShowErrorMsg:
+		m.modalManager.ShowModal(modals.NewSimpleErrorModal(msg.Title, msg.Message))
+		return m, nil
+
+	case ErrorMsg:
+		m.modalManager.ShowModal(modals.NewSimpleErrorModal("Error", msg.Error.Error()))
+		return m, nil
+
 	default:
 		// Update modal manager
 		if m.modalManager.IsActive() {
@@ -545,8 +541,86 @@ type RefreshDataMsg struct{}
 // TickMsg is sent periodically for animations or time-based updates
 type TickMsg time.Time
 
+// quit stops the application
+func (m *AppModel) quit() (tea.Model, tea.Cmd) {
+	m.confirmingQuit = false
+	m.quitting = true
+	return m, tea.Quit
+}
+
+// showQuitConfirmation asks whether to quit; handleModalResult acts on the
+// answer
+func (m *AppModel) showQuitConfirmation() {
+	m.confirmingQuit = true
+	m.modalManager.ShowModal(modals.NewConfirmModal(modals.ConfirmModalConfig{
+		Title:       "Quit",
+		Message:     "Quit ccmgr-ultra?",
+		ConfirmText: "Quit",
+		CancelText:  "Cancel",
+	}))
+}
+
+// showKillSessionsConfirmation asks whether to kill sessions;
+// handleModalResult acts on the answer
+func (m *AppModel) showKillSessionsConfirmation(sessions []SessionInfo) {
+	if len(sessions) == 0 {
+		return
+	}
+
+	message := "Kill session '" + sessions[0].Name + "'?"
+	if len(sessions) > 1 {
+		message = fmt.Sprintf("Kill %d sessions?", len(sessions))
+	}
+
+	m.pendingKill = sessions
+	m.modalManager.ShowModal(modals.NewConfirmModal(modals.ConfirmModalConfig{
+		Title:       "Kill Session",
+		Message:     message,
+		ConfirmText: "Kill",
+		CancelText:  "Cancel",
+	}))
+}
+
+// showDeleteWorktreeConfirmation asks whether to delete a worktree;
+// handleModalResult acts on the answer
+func (m *AppModel) showDeleteWorktreeConfirmation(wt WorktreeInfo) {
+	m.pendingDelete = &wt
+	m.modalManager.ShowModal(modals.NewConfirmModal(modals.ConfirmModalConfig{
+		Title:       "Delete Worktree",
+		Message:     "Delete worktree '" + wt.Path + "'?",
+		ConfirmText: "Delete",
+		CancelText:  "Cancel",
+		DangerMode:  true,
+	}))
+}
+
 // handleModalResult processes the result of a completed modal
 func (m *AppModel) handleModalResult(result *modals.ModalResult) tea.Cmd {
+	if m.confirmingQuit {
+		m.confirmingQuit = false
+		if confirmed, _ := result.Data.(bool); confirmed && !result.Canceled {
+			_, cmd := m.quit()
+			return cmd
+		}
+		return nil
+	}
+
+	if sessions := m.pendingKill; sessions != nil {
+		m.pendingKill = nil
+		if confirmed, _ := result.Data.(bool); confirmed && !result.Canceled {
+			return m.integration.KillSessions(sessionIDs(sessions))
+		}
+		return nil
+	}
+
+	if wt := m.pendingDelete; wt != nil {
+		m.pendingDelete = nil
+		if confirmed, _ := result.Data.(bool); confirmed && !result.Canceled {
+			return m.integration.DeleteWorktree(wt.Path)
+		}
+		return nil
+	}
+
 	if result.Canceled {
 		return nil
 	}
@@ -561,16 +635,17 @@ func (m *AppModel) handleModalResult(result *modals.ModalResult) tea.Cmd {
 	// Handle successful results based on data type
 	switch data := result.Data.(type) {
 	case map[string]interface{}:
+		// Check if this is a worktree creation result; these carry a
+		// session name too when a session is requested for the worktree
+		if _, ok := data["repository_path"].(string); ok {
+			return m.handleWorktreeCreation(data)
+		}
+
 		// Check if this is a session creation result
 		if sessionName, ok := data["session_name"].(string); ok {
 			return m.handleSessionCreation(data, sessionName)
 		}
 
-		// Check if this is a worktree creation result
-		if worktreePath, ok := data["worktree_path"].(string); ok {
-			return m.handleWorktreeCreation(data, worktreePath)
-		}
-
 	case string:
 		// Handle simple string results
 		return m.handleStringResult(data)
@@ -588,14 +663,10 @@ func (m *AppModel) handleContextMenuAction(msg contextmenu.ContextMenuActionMsg)
 
 	switch msg.Action {
 	case "session_new":
-		// TODO: Implement session wizard
-		modal := modals.NewSimpleErrorModal("Not Implemented", "Session wizard not yet implemented")
-		m.modalManager.ShowModal(modal)
+		m.modalManager.ShowModal(m.sessionWizard.CreateWizard())
 
 	case "worktree_new":
-		// TODO: Implement worktree wizard
-		modal := modals.NewSimpleErrorModal("Not Implemented", "Worktree wizard not yet implemented")
-		m.modalManager.ShowModal(modal)
+		m.modalManager.ShowModal(m.worktreeWizard.CreateWizard())
 
 	case "session_attach", "session_kill", "session_delete":
 		return m.handleSessionAction(msg.Action)
@@ -616,31 +687,50 @@ func (m *AppModel) handleContextMenuAction(msg contextmenu.ContextMenuActionMsg)
 	return nil
 }
 
-// handleSessionCreation processes session creation results
+// handleSessionCreation creates the session collected by a session wizard in
+// the selected worktree, or the project directory when no worktree was chosen
 func (m *AppModel) handleSessionCreation(data map[string]interface{}, sessionName string) tea.Cmd {
-	// TODO: Implement actual session creation via integration
-	// For now, show success message
-	modal := modals.NewSimpleErrorModal("Success",
-		"Session '"+sessionName+"' created successfully")
-	m.modalManager.ShowModal(modal)
+	config := workflows.SessionConfig{Name: sessionName}
+	config.ProjectName, _ = data["project_name"].(string)
+	config.ProjectPath, _ = data["project_path"].(string)
+	config.WorktreePath, _ = data["worktree_path"].(string)
+	config.Branch, _ = data["branch"].(string)
+	config.Description, _ = data["session_description"].(string)
+
+	return m.integration.CreateSession(config)
+}
 
-	// Refresh sessions screen
-	return func() tea.Msg {
-		return RefreshDataMsg{}
+// handleWorktreeCreation creates the worktree collected by a worktree
+// wizard, then the session requested for it
+func (m *AppModel) handleWorktreeCreation(data map[string]interface{}) tea.Cmd {
+	config := workflows.WorktreeConfig{}
+	config.RepositoryPath, _ = data["repository_path"].(string)
+	config.BranchName, _ = data["branch_name"].(string)
+	config.BaseBranch, _ = data["base_branch"].(string)
+	config.NewBranch, _ = data["new_branch"].(bool)
+	config.TrackRemote, _ = data["track_remote"].(bool)
+	config.CreateSession, _ = data["create_session"].(bool)
+	config.SessionName, _ = data["session_name"].(string)
+	if auto, _ := data["auto_path"].(bool); !auto {
+		config.WorktreePath, _ = data["worktree_path"].(string)
 	}
-}
 
-// handleWorktreeCreation processes worktree creation results
-func (m *AppModel) handleWorktreeCreation(data map[string]interface{}, worktreePath string) tea.Cmd {
-	// TODO: Implement actual worktree creation via integration
-	// For now, show success message
-	modal := modals.NewSimpleErrorModal("Success",
-		"Worktree created at '"+worktreePath+"'")
-	m.modalManager.ShowModal(modal)
+	createWorktree := m.integration.CreateWorktree(worktreeOptions(config))
+	if !config.CreateSession {
+		return createWorktree
+	}
 
-	// Refresh worktrees screen
 	return func() tea.Msg {
-		return RefreshDataMsg{}
+		msg := createWorktree()
+		if created, ok := msg.(WorktreeCreatedMsg); ok {
+			return m.integration.CreateSession(workflows.SessionConfig{
+				Name:         config.SessionName,
+				ProjectPath:  config.RepositoryPath,
+				WorktreePath: created.Path,
+				Branch:       created.Branch,
+			})()
+		}
+		return msg
 	}
 }
 
@@ -2,6 +2,8 @@ package tui
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -73,6 +75,271 @@ func TestAppModel_Update_KeyPress_Quit(t *testing.T) {
 	assert.True(t, appModel.quitting)
 }
 
+// isQuitCmd reports whether running cmd, or any command it batches, quits
+func isQuitCmd(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	switch msg := cmd().(type) {
+	case tea.QuitMsg:
+		return true
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if isQuitCmd(c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestAppModel_Update_ConfirmQuit(t *testing.T) {
+	quitKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}
+
+	newApp := func(t *testing.T, confirmQuit bool) *AppModel {
+		cfg := config.DefaultConfig()
+		cfg.TUI.ConfirmQuit = confirmQuit
+		app, err := NewAppModel(context.Background(), cfg)
+		require.NoError(t, err)
+		return app
+	}
+
+	t.Run("disabled quits immediately", func(t *testing.T) {
+		app := newApp(t, false)
+
+		_, cmd := app.Update(quitKey)
+		assert.True(t, isQuitCmd(cmd))
+		assert.True(t, app.quitting)
+		assert.False(t, app.modalManager.IsActive())
+	})
+
+	t.Run("confirmed with y", func(t *testing.T) {
+		app := newApp(t, true)
+
+		_, cmd := app.Update(quitKey)
+		assert.False(t, isQuitCmd(cmd))
+		assert.False(t, app.quitting)
+		require.True(t, app.modalManager.IsActive(), "confirmation is shown")
+
+		_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+		assert.True(t, isQuitCmd(cmd))
+		assert.True(t, app.quitting)
+	})
+
+	t.Run("confirmed with enter on the quit button", func(t *testing.T) {
+		app := newApp(t, true)
+
+		app.Update(quitKey)
+		app.Update(tea.KeyMsg{Type: tea.KeyRight})
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		assert.True(t, isQuitCmd(cmd))
+	})
+
+	for name, key := range map[string]tea.KeyMsg{
+		"declined with n":   {Type: tea.KeyRunes, Runes: []rune{'n'}},
+		"canceled with esc": {Type: tea.KeyEsc},
+		"enter on cancel":   {Type: tea.KeyEnter},
+	} {
+		t.Run(name, func(t *testing.T) {
+			app := newApp(t, true)
+
+			app.Update(quitKey)
+			_, cmd := app.Update(key)
+			assert.False(t, isQuitCmd(cmd))
+			assert.False(t, app.quitting)
+			assert.False(t, app.modalManager.IsActive(), "confirmation is closed")
+			assert.False(t, app.confirmingQuit)
+
+			// The app keeps working normally afterwards
+			app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+			assert.Equal(t, ScreenSessions, app.currentScreen)
+
+			_, cmd = app.Update(quitKey)
+			assert.False(t, isQuitCmd(cmd))
+			assert.True(t, app.modalManager.IsActive(), "quitting asks again")
+		})
+	}
+
+	t.Run("ctrl+c force quits", func(t *testing.T) {
+		app := newApp(t, true)
+
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+		assert.True(t, isQuitCmd(cmd))
+		assert.True(t, app.quitting)
+	})
+
+	t.Run("ctrl+c at the confirmation quits", func(t *testing.T) {
+		app := newApp(t, true)
+
+		app.Update(quitKey)
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+		assert.True(t, isQuitCmd(cmd))
+		assert.True(t, app.quitting)
+	})
+}
+
+// runCmd runs cmd and returns the messages it produces, expanding batches
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, runCmd(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestAppModel_SessionWizard_CreatesSession(t *testing.T) {
+	app, err := NewAppModel(context.Background(), config.DefaultConfig())
+	require.NoError(t, err)
+
+	tmuxMgr := &fakeTmuxManager{}
+	app.integration.tmuxMgr = tmuxMgr
+	app.integration.worktrees = []WorktreeInfo{
+		{Path: "/work/app", Branch: "main", Repository: "app"},
+		{Path: "/work/app-auth", Branch: "feature/auth", Repository: "app"},
+	}
+
+	press := func(keys ...tea.KeyMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, key := range keys {
+			_, cmd = app.Update(key)
+		}
+		return cmd
+	}
+	next := tea.KeyMsg{Type: tea.KeyCtrlN}
+
+	press(next)
+	require.True(t, app.modalManager.IsActive(), "ctrl+n opens the session wizard")
+
+	// Select the second worktree
+	press(tea.KeyMsg{Type: tea.KeyTab}, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyEnter}, next)
+
+	// Name the session
+	for _, r := range "auth-work" {
+		press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	press(next)
+
+	// Claude configuration, then confirmation
+	press(next)
+	assert.Empty(t, tmuxMgr.created, "nothing is created before the wizard finishes")
+	cmd := press(next)
+	assert.False(t, app.modalManager.IsActive(), "the wizard closes when finished")
+
+	msgs := runCmd(cmd)
+	require.Len(t, tmuxMgr.created, 1, "finishing the wizard creates a session")
+	assert.Equal(t, "/work/app-auth", tmuxMgr.created[0].Directory)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, SessionCreatedMsg{SessionID: "auth-work"}, msgs[0])
+
+	// The created session is reported
+	app.Update(msgs[0])
+	assert.True(t, app.modalManager.IsActive())
+}
+
+func TestAppModel_SessionWizard_Cancel(t *testing.T) {
+	app, err := NewAppModel(context.Background(), config.DefaultConfig())
+	require.NoError(t, err)
+
+	tmuxMgr := &fakeTmuxManager{}
+	app.integration.tmuxMgr = tmuxMgr
+
+	app.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	require.True(t, app.modalManager.IsActive())
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	runCmd(cmd)
+	assert.False(t, app.modalManager.IsActive())
+	assert.Empty(t, tmuxMgr.created)
+}
+
+// createWorktreeWithWizard opens the worktree wizard with ctrl+w, creates a
+// new branch whose worktree path comes from the directory pattern, and
+// returns the messages produced by finishing the wizard
+func createWorktreeWithWizard(t *testing.T, app *AppModel, branch string) []tea.Msg {
+	t.Helper()
+
+	press := func(keys ...tea.KeyMsg) tea.Cmd {
+		var cmd tea.Cmd
+		for _, key := range keys {
+			_, cmd = app.Update(key)
+		}
+		return cmd
+	}
+	next := tea.KeyMsg{Type: tea.KeyCtrlN}
+
+	press(tea.KeyMsg{Type: tea.KeyCtrlW})
+	require.True(t, app.modalManager.IsActive(), "ctrl+w opens the worktree wizard")
+
+	// Repository
+	press(tea.KeyMsg{Type: tea.KeyEnter}, next)
+
+	// New branch
+	press(tea.KeyMsg{Type: tea.KeyTab})
+	for _, r := range branch {
+		press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	press(tea.KeyMsg{Type: tea.KeyEnter}, next)
+
+	// Default path, then confirmation
+	press(next)
+	cmd := press(next)
+	assert.False(t, app.modalManager.IsActive(), "the wizard closes when finished")
+
+	return runCmd(cmd)
+}
+
+func TestAppModel_WorktreeWizard_CreatesWorktree(t *testing.T) {
+	app, err := NewAppModel(context.Background(), config.DefaultConfig())
+	require.NoError(t, err)
+
+	gitMgr := newFakeWorktreeManager()
+	app.integration.gitMgr = gitMgr
+
+	msgs := createWorktreeWithWizard(t, app, "feature-x")
+
+	require.Len(t, gitMgr.created, 1, "finishing the wizard creates a worktree")
+	opts := gitMgr.created[0]
+	assert.Equal(t, "feature-x", opts.Branch)
+	assert.True(t, opts.CreateBranch)
+	assert.True(t, opts.AutoName, "the default path comes from the directory pattern")
+	assert.Empty(t, opts.Path)
+
+	require.Len(t, msgs, 1)
+	assert.Equal(t, WorktreeCreatedMsg{Path: "/work/worktrees/feature-x", Branch: "feature-x"}, msgs[0])
+
+	app.Update(msgs[0])
+	assert.True(t, app.modalManager.IsActive(), "the created worktree is reported")
+}
+
+func TestAppModel_WorktreeWizard_PatternError(t *testing.T) {
+	app, err := NewAppModel(context.Background(), config.DefaultConfig())
+	require.NoError(t, err)
+
+	gitMgr := newFakeWorktreeManager()
+	gitMgr.err = fmt.Errorf("failed to generate worktree path: %w",
+		errors.New("template execution failed: map has no entry for key \"Nope\""))
+	app.integration.gitMgr = gitMgr
+
+	msgs := createWorktreeWithWizard(t, app, "feature-x")
+
+	assert.Empty(t, gitMgr.created)
+	require.Len(t, msgs, 1)
+	errMsg, ok := msgs[0].(ShowErrorMsg)
+	require.True(t, ok, "got %T", msgs[0])
+	assert.Contains(t, errMsg.Message, "template execution failed")
+	assert.Contains(t, errMsg.Message, "Check your directory_pattern in config")
+
+	app.Update(errMsg)
+	assert.True(t, app.modalManager.IsActive(), "the error is shown in a modal")
+}
+
 func TestAppModel_Update_KeyPress_Navigation(t *testing.T) {
 	ctx := context.Background()
 	cfg := config.DefaultConfig()
@@ -259,3 +526,124 @@ func TestAppModel_InitializeScreens(t *testing.T) {
 		assert.NotNil(t, model, "Screen %v should not be nil", screen)
 	}
 }
+
+func TestAppModel_KillSessions(t *testing.T) {
+	newApp := func(t *testing.T, keys ...tea.KeyMsg) (*AppModel, *fakeTmuxManager) {
+		app, err := NewAppModel(context.Background(), config.DefaultConfig())
+		require.NoError(t, err)
+
+		tmuxMgr := &fakeTmuxManager{}
+		app.integration.tmuxMgr = tmuxMgr
+		app.integration.sessions = []SessionInfo{
+			{ID: "ccmgr-app-main", Name: "ccmgr-app-main", Active: true},
+			{ID: "ccmgr-app-auth", Name: "ccmgr-app-auth", Active: true},
+		}
+
+		app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+		for _, key := range keys {
+			app.Update(key)
+		}
+
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+		msgs := runCmd(cmd)
+		require.Len(t, msgs, 1)
+		app.Update(msgs[0])
+		require.True(t, app.modalManager.IsActive(), "killing sessions asks for confirmation")
+		return app, tmuxMgr
+	}
+	confirm := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}}
+
+	t.Run("confirmed", func(t *testing.T) {
+		app, tmuxMgr := newApp(t, tea.KeyMsg{Type: tea.KeyDown})
+
+		_, cmd := app.Update(confirm)
+		msgs := runCmd(cmd)
+		assert.Equal(t, []string{"ccmgr-app-auth"}, tmuxMgr.killed)
+		assert.Contains(t, msgs, tea.Msg(SessionsKilledMsg{SessionIDs: []string{"ccmgr-app-auth"}}))
+		assert.Nil(t, app.pendingKill)
+
+		// The session list is refreshed afterwards
+		_, cmd = app.Update(SessionsKilledMsg{SessionIDs: []string{"ccmgr-app-auth"}})
+		assert.NotNil(t, cmd)
+		assert.False(t, app.modalManager.IsActive())
+	})
+
+	t.Run("selected sessions", func(t *testing.T) {
+		app, tmuxMgr := newApp(t,
+			tea.KeyMsg{Type: tea.KeyTab},
+			tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+
+		_, cmd := app.Update(confirm)
+		runCmd(cmd)
+		assert.Equal(t, []string{"ccmgr-app-main", "ccmgr-app-auth"}, tmuxMgr.killed)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		app, tmuxMgr := newApp(t)
+
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+		runCmd(cmd)
+		assert.Empty(t, tmuxMgr.killed)
+		assert.Nil(t, app.pendingKill)
+		assert.False(t, app.modalManager.IsActive())
+	})
+
+	t.Run("failures are reported", func(t *testing.T) {
+		app, _ := newApp(t)
+		app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		require.False(t, app.modalManager.IsActive())
+
+		app.Update(SessionsKilledMsg{Err: errors.New("ccmgr-app-main: no such session")})
+		assert.True(t, app.modalManager.IsActive())
+	})
+}
+
+func TestAppModel_DeleteWorktree(t *testing.T) {
+	newApp := func(t *testing.T) (*AppModel, *fakeWorktreeManager) {
+		app, err := NewAppModel(context.Background(), config.DefaultConfig())
+		require.NoError(t, err)
+
+		gitMgr := newFakeWorktreeManager()
+		app.integration.gitMgr = gitMgr
+
+		app.Update(DeleteWorktreeRequestedMsg{Worktree: WorktreeInfo{Path: "/work/app-auth", Branch: "feature/auth"}})
+		require.True(t, app.modalManager.IsActive(), "deleting a worktree asks for confirmation")
+		return app, gitMgr
+	}
+
+	t.Run("confirmed", func(t *testing.T) {
+		app, gitMgr := newApp(t)
+
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+		msgs := runCmd(cmd)
+		assert.Equal(t, []string{"/work/app-auth"}, gitMgr.deleted)
+		assert.Contains(t, msgs, tea.Msg(WorktreeDeletedMsg{Path: "/work/app-auth"}))
+		assert.Nil(t, app.pendingDelete)
+
+		// The worktree list is refreshed afterwards
+		_, cmd = app.Update(WorktreeDeletedMsg{Path: "/work/app-auth"})
+		assert.NotNil(t, cmd)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		app, gitMgr := newApp(t)
+
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+		runCmd(cmd)
+		assert.Empty(t, gitMgr.deleted)
+		assert.Nil(t, app.pendingDelete)
+		assert.False(t, app.modalManager.IsActive())
+	})
+
+	t.Run("failures are reported", func(t *testing.T) {
+		app, gitMgr := newApp(t)
+		gitMgr.err = errors.New("worktree has uncommitted changes")
+
+		_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+		msgs := runCmd(cmd)
+		require.Len(t, msgs, 1)
+		errMsg, ok := msgs[0].(ErrorMsg)
+		require.True(t, ok)
+		assert.Contains(t, errMsg.Error.Error(), "uncommitted changes")
+	})
+}
@@ -80,6 +80,7 @@ type TUISettingsModel struct {
 func NewTUISettingsModel(cfg *config.TUIConfig, theme Theme) *TUISettingsModel {
 	original := &config.TUIConfig{
 		Theme:           cfg.Theme,
+		ThemeColors:     cfg.ThemeColors,
 		RefreshInterval: cfg.RefreshInterval,
 		MouseSupport:    cfg.MouseSupport,
 		DefaultScreen:   cfg.DefaultScreen,
@@ -241,7 +242,7 @@ func (m *TmuxSettingsModel) initComponents() {
 		NewConfigTextInput("Session prefix", m.config.SessionPrefix, "ccmgr", m.theme),
 		NewConfigTextInput("Naming pattern", m.config.NamingPattern, "{{.prefix}}-{{.project}}-{{.branch}}", m.theme),
 		NewConfigNumberInput("Max session name length", m.config.MaxSessionName, 10, 100, 5, m.theme),
-		NewConfigTextInput("State file", m.config.StateFile, "~/.config/ccmgr-ultra/tmux-sessions.json", m.theme),
+		NewConfigTextInput("State file", m.config.StateFile, config.ConfigDirPath("tmux-sessions.json"), m.theme),
 		NewConfigListInput("Default environment", envList, m.theme),
 		NewConfigToggle("Auto cleanup", m.config.AutoCleanup, m.theme),
 	}
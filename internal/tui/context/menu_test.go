@@ -247,3 +247,43 @@ func TestMenuWithSubmenu(t *testing.T) {
 		}
 	})
 }
+
+func TestWorktreeItemMenu_Detached(t *testing.T) {
+	menus := NewWorktreeContextMenu(Theme{})
+
+	findItem := func(menu *ContextMenu, action string) *ContextMenuItem {
+		for _, item := range menu.items {
+			if item.Submenu != nil {
+				if found := findSubmenuItem(item.Submenu, action); found != nil {
+					return found
+				}
+			}
+		}
+		return nil
+	}
+
+	attached := menus.CreateWorktreeItemMenu(WorktreeInfo{Branch: "feature"})
+	detached := menus.CreateWorktreeItemMenu(WorktreeInfo{Detached: true})
+
+	if detached.title != "(detached HEAD)" {
+		t.Errorf("Expected the detached menu title to mark the detached HEAD, got %q", detached.title)
+	}
+	for _, action := range []string{"git_push", "branch_merge_into"} {
+		if item := findItem(attached, action); item == nil || !item.Enabled {
+			t.Errorf("Expected %s to be enabled on a branch", action)
+		}
+		if item := findItem(detached, action); item == nil || item.Enabled {
+			t.Errorf("Expected %s to be disabled on a detached HEAD", action)
+		}
+	}
+}
+
+// findSubmenuItem returns the item of menu with action, if any
+func findSubmenuItem(menu *ContextMenu, action string) *ContextMenuItem {
+	for i := range menu.items {
+		if menu.items[i].Action == action {
+			return &menu.items[i]
+		}
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ type WorktreeInfo struct {
 	UpstreamSync  bool
 	LastAccess    string
 	IsMain        bool
+	Detached      bool // HEAD is not on a branch
 	ConflictState string
 
 	// New session-related fields
@@ -147,8 +148,13 @@ func (w *WorktreeContextMenu) CreateWorktreeItemMenu(worktree WorktreeInfo) *Con
 		)
 	}
 
+	title := worktree.Branch
+	if worktree.Detached {
+		title = "(detached HEAD)"
+	}
+
 	return NewContextMenu(ContextMenuConfig{
-		Title: worktree.Branch,
+		Title: title,
 		Items: items,
 	}, w.theme)
 }
@@ -162,7 +168,7 @@ func (w *WorktreeContextMenu) createGitSubmenu(worktree WorktreeInfo) *ContextMe
 		NewMenuDivider(),
 		NewMenuItemWithIcon("Fetch", "git_fetch", "f", "⬇️"),
 		NewMenuItemWithIcon("Pull", "git_pull", "p", "⬇️"),
-		NewMenuItemWithIcon("Push", "git_push", "u", "⬆️"),
+		detachedDisabled(NewMenuItemWithIcon("Push", "git_push", "u", "⬆️"), worktree),
 		NewMenuDivider(),
 	}
 
@@ -193,7 +199,7 @@ func (w *WorktreeContextMenu) createBranchSubmenu(worktree WorktreeInfo) *Contex
 		NewMenuItemWithIcon("Create Branch", "branch_create", "c", "🌱"),
 		NewMenuItemWithIcon("Switch Branch", "branch_switch", "s", "🔄"),
 		NewMenuDivider(),
-		NewMenuItemWithIcon("Merge Into", "branch_merge_into", "m", "🔀"),
+		detachedDisabled(NewMenuItemWithIcon("Merge Into", "branch_merge_into", "m", "🔀"), worktree),
 		NewMenuItemWithIcon("Rebase Onto", "branch_rebase", "r", "📈"),
 		NewMenuDivider(),
 		NewMenuItemWithIcon("Compare Branches", "branch_compare", "d", "🔍"),
@@ -539,3 +545,12 @@ func (w *WorktreeContextMenu) CreateWorktreeSelectionMenu(selectedWorktrees []Wo
 		Items: items,
 	}, w.theme)
 }
+
+// detachedDisabled disables item, which needs a branch, when worktree has a
+// detached HEAD
+func detachedDisabled(item ContextMenuItem, worktree WorktreeInfo) ContextMenuItem {
+	if worktree.Detached {
+		item.Enabled = false
+	}
+	return item
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+)
+
+// listFilter holds a screen's search filter and the indices of the items
+// that match it
+type listFilter struct {
+	filterText      string // search filter
+	filteredIndices []int  // indices after filtering
+	filterInvalid   bool   // Regex filter failed to compile; substring match used
+	searchMode      bool   // search input mode
+}
+
+// visibleIndices returns the indices of the items of an n item list that
+// pass the filter
+func (f *listFilter) visibleIndices(n int) []int {
+	if f.filterText != "" {
+		return f.filteredIndices
+	}
+
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+// filterItems records the indices of the n items for which matchItem
+// reports a match
+func (f *listFilter) filterItems(n int, matchItem func(i int, matches func(string) bool) bool) {
+	f.filteredIndices = []int{}
+	f.filterInvalid = false
+
+	if f.filterText == "" {
+		// No filter, show all
+		return
+	}
+
+	matches := f.filterMatcher()
+	for i := 0; i < n; i++ {
+		if matchItem(i, matches) {
+			f.filteredIndices = append(f.filteredIndices, i)
+		}
+	}
+}
+
+// filterMatcher returns the match function for the current filter text.
+// Text starting with "/" is a case-insensitive regular expression; anything
+// else, or a regex that does not compile, is matched as a case-insensitive
+// substring.
+func (f *listFilter) filterMatcher() func(string) bool {
+	text := f.filterText
+	if pattern, ok := strings.CutPrefix(text, "/"); ok {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err == nil {
+			return re.MatchString
+		}
+		f.filterInvalid = true
+		text = pattern
+	}
+
+	filterLower := strings.ToLower(text)
+	return func(s string) bool {
+		return strings.Contains(strings.ToLower(s), filterLower)
+	}
+}
+
+// enterSearchMode enables search input mode
+func (f *listFilter) enterSearchMode() {
+	f.searchMode = true
+}
+
+// editSearch applies a key pressed in search mode to the filter text. It
+// reports whether the filter text changed and whether search mode ended.
+func (f *listFilter) editSearch(key string) (changed, exited bool) {
+	switch key {
+	case "esc", "enter":
+		f.searchMode = false
+		return false, true
+	case "backspace":
+		if len(f.filterText) > 0 {
+			f.filterText = f.filterText[:len(f.filterText)-1]
+			return true, false
+		}
+	case "ctrl+c":
+		f.filterText = ""
+		f.searchMode = false
+		return true, true
+	default:
+		// Add character to search
+		if len(key) == 1 && key >= " " && key <= "~" {
+			f.filterText += key
+			return true, false
+		}
+	}
+	return false, false
+}
@@ -2,6 +2,12 @@ package tui
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -9,15 +15,22 @@ import (
 	"github.com/unbracketed/ccmgr-ultra/internal/claude"
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
 	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/hooks"
 	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+	"github.com/unbracketed/ccmgr-ultra/internal/tui/workflows"
 )
 
 // Integration manages the integration between TUI and backend services
 type Integration struct {
 	config    *config.Config
-	claudeMgr *claude.ProcessManager
-	tmuxMgr   *tmux.SessionManager
-	gitMgr    *git.WorktreeManager
+	claudeMgr claudeProcessSource
+	tmuxMgr   tmuxSessionManager
+	gitMgr    gitWorktreeManager
+
+	// Repository of the working directory, detected again on each refresh
+	// or when the working directory changes
+	repos   *git.RepositoryCache
+	gitRepo *git.Repository
 
 	// Data cache
 	mu              sync.RWMutex
@@ -26,12 +39,41 @@ type Integration struct {
 	systemStatus    SystemStatus
 	lastRefresh     time.Time
 	refreshInterval time.Duration
+	sampler         processSampler
+
+	// execAttach replaces attaching to tmux in tests
+	execAttach func(sessionID string) tea.Cmd
 
 	// Context for background operations
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
+// tmuxSessionManager is the subset of tmux.SessionManager used by the integration
+type tmuxSessionManager interface {
+	ListSessions() ([]*tmux.Session, error)
+	AttachSession(sessionID string) error
+	ActivateSession(session *tmux.Session) error
+	CreateSessionWithName(sessionName, project, worktree, branch, directory string) (*tmux.Session, error)
+	KillSession(sessionID string) error
+	AttachSessionInWindow(sessionID string) error
+	StartClaude(sessionID, prompt string) error
+}
+
+// claudeProcessSource is the subset of claude.ProcessManager used by the integration
+type claudeProcessSource interface {
+	GetAllProcesses() []*claude.ProcessInfo
+}
+
+// gitWorktreeManager is the subset of git.WorktreeManager used by the integration
+type gitWorktreeManager interface {
+	Repository() *git.Repository
+	ListBranches() ([]git.BranchInfo, error)
+	ResolveWorktreePath(branch string, opts git.WorktreeOptions) (string, error)
+	CreateWorktree(branch string, opts git.WorktreeOptions) (*git.WorktreeInfo, error)
+	DeleteWorktree(path string, force bool) error
+}
+
 // SessionInfo represents session information for the TUI
 type SessionInfo struct {
 	ID         string
@@ -50,6 +92,7 @@ type SessionInfo struct {
 type WorktreeInfo struct {
 	Path           string
 	Branch         string
+	Detached       bool // HEAD is not on a branch, so Branch is empty
 	Repository     string
 	Active         bool
 	LastAccess     time.Time
@@ -131,12 +174,27 @@ func NewIntegration(config *config.Config) (*Integration, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// Initialize backend managers
-	claudeMgr, err := claude.NewProcessManager(&claude.ProcessConfig{})
+	processConfig, err := claude.NewConfigAdapter(&config.Claude).ToProcessConfig()
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid claude configuration: %w", err)
+	}
+	claudeMgr, err := claude.NewProcessManager(processConfig)
 	if err != nil {
 		cancel()
 		return nil, err
 	}
 
+	// Run the status hooks as the monitored Claude processes change state
+	statusHooks := hooks.NewStatusHookIntegrator(hooks.NewDefaultExecutor(config))
+	claudeMgr.AddStateChangeHandler(statusHooks)
+	if config.Claude.Enabled {
+		// The TUI is still usable without process monitoring
+		if err := claudeMgr.Start(ctx); err != nil {
+			slog.Error("failed to start claude process monitor", "error", err)
+		}
+	}
+
 	tmuxMgr := tmux.NewSessionManager(config)
 
 	// Note: gitMgr requires a repository, so we'll initialize it when needed
@@ -152,6 +210,7 @@ func NewIntegration(config *config.Config) (*Integration, error) {
 		claudeMgr:       claudeMgr,
 		tmuxMgr:         tmuxMgr,
 		gitMgr:          nil, // Will be initialized per-repository
+		repos:           git.NewRepositoryCache(nil),
 		sessions:        []SessionInfo{},
 		worktrees:       []WorktreeInfo{},
 		systemStatus:    DefaultSystemStatus(),
@@ -202,10 +261,20 @@ func (i *Integration) refreshAllData() {
 	// Refresh Git worktrees
 	i.refreshGitData()
 
+	// Associate tmux sessions with the worktrees they run in
+	i.associateSessionsWithWorktrees()
+
 	// Update system status
 	i.updateSystemStatus()
 }
 
+// recordError logs a background refresh failure and keeps it for the
+// system status. Callers must hold i.mu.
+func (i *Integration) recordError(message string, err error) {
+	slog.Error(message, "error", err)
+	i.systemStatus.Errors = append(i.systemStatus.Errors, message+": "+err.Error())
+}
+
 // refreshClaudeData refreshes Claude process information
 func (i *Integration) refreshClaudeData() {
 	processes := i.claudeMgr.GetAllProcesses()
@@ -228,8 +297,7 @@ func (i *Integration) refreshClaudeData() {
 func (i *Integration) refreshTmuxData() {
 	sessions, err := i.tmuxMgr.ListSessions()
 	if err != nil {
-		i.systemStatus.Errors = append(i.systemStatus.Errors,
-			"Failed to list tmux sessions: "+err.Error())
+		i.recordError("Failed to list tmux sessions", err)
 		return
 	}
 
@@ -255,8 +323,41 @@ func (i *Integration) refreshTmuxData() {
 	}
 }
 
+// repository returns the repository of the working directory and keeps
+// gitMgr pointed at it. Callers must hold i.mu.
+func (i *Integration) repository() (*git.Repository, error) {
+	if i.repos == nil {
+		i.repos = git.NewRepositoryCache(nil)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	repo, err := i.repos.Detect(cwd)
+	if err != nil {
+		i.gitRepo, i.gitMgr = nil, nil
+		return nil, err
+	}
+
+	if repo != i.gitRepo && i.config != nil {
+		i.gitRepo = repo
+		i.gitMgr = git.NewWorktreeManager(repo, i.config, i.repos.GitCmd())
+	}
+	return repo, nil
+}
+
 // refreshGitData refreshes Git worktree information
 func (i *Integration) refreshGitData() {
+	// Detect the repository again, as its branch and remotes may have changed
+	// since the last refresh. Outside a repository the TUI still runs,
+	// without a worktree manager.
+	if i.repos != nil {
+		i.repos.Invalidate()
+	}
+	i.repository()
+
 	// Since we don't have repository context at this level,
 	// we'll implement a basic worktree discovery mechanism
 
@@ -396,6 +497,46 @@ func (i *Integration) refreshGitData() {
 	}
 }
 
+// associateSessionsWithWorktrees fills each worktree's ActiveSessions with the
+// tmux sessions whose directory is the worktree path or a subdirectory of it.
+// When worktrees are nested, a session belongs to the most specific one.
+func (i *Integration) associateSessionsWithWorktrees() {
+	for j := range i.worktrees {
+		i.worktrees[j].ActiveSessions = []SessionSummary{}
+	}
+
+	for _, session := range i.sessions {
+		if session.Directory == "" {
+			continue
+		}
+
+		match := -1
+		for j, wt := range i.worktrees {
+			if !tmux.IsPathWithin(session.Directory, wt.Path) {
+				continue
+			}
+			if match < 0 || len(wt.Path) > len(i.worktrees[match].Path) {
+				match = j
+			}
+		}
+		if match < 0 {
+			continue
+		}
+
+		state := "paused"
+		if session.Active {
+			state = "active"
+		}
+
+		i.worktrees[match].ActiveSessions = append(i.worktrees[match].ActiveSessions, SessionSummary{
+			ID:       session.ID,
+			Name:     session.Name,
+			State:    state,
+			LastUsed: session.LastAccess,
+		})
+	}
+}
+
 // updateSystemStatus updates the overall system status
 func (i *Integration) updateSystemStatus() {
 	activeProcesses := len(i.claudeMgr.GetAllProcesses())
@@ -429,27 +570,35 @@ func (i *Integration) updateSystemStatus() {
 	}
 }
 
-// getMemoryStats returns current memory statistics
+// monitoringEnabled reports whether performance sampling is switched on
+func (i *Integration) monitoringEnabled() bool {
+	return i.config == nil || i.config.Analytics.Performance.EnableMonitoring
+}
+
+// getMemoryStats returns the memory used by ccmgr-ultra, or zero values when
+// performance monitoring is disabled
 func (i *Integration) getMemoryStats() MemoryStats {
-	// Placeholder implementation
-	// In a real implementation, this would query system memory usage
-	return MemoryStats{
-		UsedMB:     512,
-		TotalMB:    2048,
-		Percentage: 25.0,
+	if !i.monitoringEnabled() {
+		return MemoryStats{}
 	}
+	return processMemoryStats()
 }
 
-// getPerformanceStats returns current performance statistics
+// getPerformanceStats returns the CPU usage of ccmgr-ultra since the last
+// refresh, the host load average and how long the refresh took. CPU and load
+// are only sampled when performance monitoring is enabled.
 func (i *Integration) getPerformanceStats() PerformanceStats {
-	// Placeholder implementation
-	// In a real implementation, this would query system performance metrics
-	return PerformanceStats{
-		CPUPercent:   15.5,
-		LoadAverage:  0.8,
-		ResponseTime: 50 * time.Millisecond,
-		ErrorRate:    0.1,
+	stats := PerformanceStats{}
+	if !i.lastRefresh.IsZero() {
+		stats.ResponseTime = time.Since(i.lastRefresh)
 	}
+
+	if i.monitoringEnabled() {
+		stats.CPUPercent = i.sampler.sampleCPU(time.Now())
+		stats.LoadAverage = hostLoadAverage()
+	}
+
+	return stats
 }
 
 // Public methods for TUI access
@@ -520,32 +669,213 @@ func (i *Integration) AttachSession(sessionID string) tea.Cmd {
 	}
 }
 
-// OpenWorktree opens a worktree directory
+// KillSessions kills the tmux sessions with the given IDs, carrying on past
+// failures so that one bad session does not keep the others alive
+func (i *Integration) KillSessions(sessionIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		var killed []string
+		var errs []error
+		for _, id := range sessionIDs {
+			if err := i.tmuxMgr.KillSession(id); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", id, err))
+				continue
+			}
+			killed = append(killed, id)
+		}
+		return SessionsKilledMsg{SessionIDs: killed, Err: errors.Join(errs...)}
+	}
+}
+
+// AttachSessionsInWindows opens each session in a new window of the tmux
+// client running the TUI
+func (i *Integration) AttachSessionsInWindows(sessionIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		var errs []error
+		for _, id := range sessionIDs {
+			if err := i.tmuxMgr.AttachSessionInWindow(id); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if err := errors.Join(errs...); err != nil {
+			return ErrorMsg{Error: err}
+		}
+		return SessionsOpenedMsg{SessionIDs: sessionIDs}
+	}
+}
+
+// OpenWorktree attaches to the tmux session running in the worktree at
+// path, suspending the TUI until it detaches, after running the worktree
+// activation hook. Without a running session it opens the session wizard for
+// the worktree instead.
 func (i *Integration) OpenWorktree(path string) tea.Cmd {
 	return func() tea.Msg {
-		// In a real implementation, this would open the worktree
-		// For now, just return a success message
-		return WorktreeOpenedMsg{Path: path}
+		sessions, err := i.tmuxMgr.ListSessions()
+		if err != nil {
+			return ErrorMsg{Error: fmt.Errorf("failed to list tmux sessions: %w", err)}
+		}
+
+		session := tmux.ActiveSessionInPath(sessions, path)
+		if session == nil {
+			return NewSessionRequestedMsg{Worktrees: []WorktreeInfo{i.worktreeForPath(path)}}
+		}
+
+		if err := i.tmuxMgr.ActivateSession(session); err != nil {
+			return ErrorMsg{Error: err}
+		}
+
+		return i.attachProcess(session.ID)()
+	}
+}
+
+// worktreeForPath returns the cached worktree at path, or one with only the
+// path set when it is not cached
+func (i *Integration) worktreeForPath(path string) WorktreeInfo {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, wt := range i.worktrees {
+		if wt.Path == path {
+			return wt
+		}
 	}
+	return WorktreeInfo{Path: path}
 }
 
-// CreateSession creates a new tmux session
-func (i *Integration) CreateSession(name, directory string) tea.Cmd {
+// attachProcess returns a command that runs tmux attached to the terminal
+// for sessionID, suspending the TUI until tmux exits
+func (i *Integration) attachProcess(sessionID string) tea.Cmd {
+	if i.execAttach != nil {
+		return i.execAttach(sessionID)
+	}
+
+	// Inside tmux, attaching would nest sessions, so switch the client instead
+	args := []string{"attach-session", "-t", tmux.SessionTarget(sessionID)}
+	if os.Getenv("TMUX") != "" {
+		args = []string{"switch-client", "-t", tmux.SessionTarget(sessionID)}
+	}
+
+	return tea.ExecProcess(exec.Command("tmux", args...), func(err error) tea.Msg {
+		if err != nil {
+			return ErrorMsg{Error: fmt.Errorf("failed to attach to session %s: %w", sessionID, err)}
+		}
+		return SessionAttachedMsg{SessionID: sessionID}
+	})
+}
+
+// CreateSession creates a tmux session for config in its worktree or, without
+// one, its project directory. Without a name the session is named after the
+// project, worktree and branch like sessions created from the CLI.
+func (i *Integration) CreateSession(config workflows.SessionConfig) tea.Cmd {
 	return func() tea.Msg {
-		_, err := i.tmuxMgr.CreateSession("unknown", "unknown", "main", directory)
+		directory := config.WorktreePath
+		if directory == "" {
+			directory = config.ProjectPath
+		}
+
+		project := config.ProjectName
+		if project == "" && config.ProjectPath != "" {
+			project = filepath.Base(config.ProjectPath)
+		}
+		worktree := filepath.Base(directory)
+
+		name := config.Name
+		if name == "" {
+			name = tmux.GenerateSessionName(project, worktree, config.Branch)
+		}
+
+		session, err := i.tmuxMgr.CreateSessionWithName(name, project, worktree, config.Branch, directory)
 		if err != nil {
 			return ErrorMsg{Error: err}
 		}
-		return SessionCreatedMsg{SessionID: name}
+		return SessionCreatedMsg{SessionID: session.ID}
+	}
+}
+
+// worktreeManager returns the worktree manager of the working directory's
+// repository
+func (i *Integration) worktreeManager() (gitWorktreeManager, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if i.gitMgr == nil {
+		return nil, fmt.Errorf("not in a git repository")
+	}
+	return i.gitMgr, nil
+}
+
+// GetRepository returns the repository worktrees are created in
+func (i *Integration) GetRepository() (*git.Repository, error) {
+	mgr, err := i.worktreeManager()
+	if err != nil {
+		return nil, err
+	}
+	return mgr.Repository(), nil
+}
+
+// GetBranches returns the local branches of the repository
+func (i *Integration) GetBranches() ([]git.BranchInfo, error) {
+	mgr, err := i.worktreeManager()
+	if err != nil {
+		return nil, err
 	}
+	return mgr.ListBranches()
 }
 
-// CreateWorktree creates a new git worktree
-func (i *Integration) CreateWorktree(path, branch string) tea.Cmd {
+// ResolveWorktreePath returns the path worktree.directory_pattern gives a
+// worktree for branch
+func (i *Integration) ResolveWorktreePath(branch string) (string, error) {
+	mgr, err := i.worktreeManager()
+	if err != nil {
+		return "", err
+	}
+	return mgr.ResolveWorktreePath(branch, git.WorktreeOptions{})
+}
+
+// CreateWorktree creates a new git worktree. A failure caused by the
+// worktree base directory or directory pattern is reported with the same
+// suggestion the CLI gives.
+func (i *Integration) CreateWorktree(opts git.WorktreeOptions) tea.Cmd {
+	return func() tea.Msg {
+		wt, err := i.createWorktree(opts)
+		if err != nil {
+			if suggestion := git.PatternErrorSuggestion(err); suggestion != "" {
+				return ShowErrorMsg{
+					Title:   "Worktree Creation Failed",
+					Message: err.Error() + "\n\n" + suggestion,
+				}
+			}
+			return ErrorMsg{Error: err}
+		}
+		return WorktreeCreatedMsg{Path: wt.Path, Branch: wt.Branch}
+	}
+}
+
+// createWorktree creates a worktree for opts.Branch
+func (i *Integration) createWorktree(opts git.WorktreeOptions) (*git.WorktreeInfo, error) {
+	mgr, err := i.worktreeManager()
+	if err != nil {
+		return nil, err
+	}
+
+	wt, err := mgr.CreateWorktree(opts.Branch, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create worktree: %w", err)
+	}
+	return wt, nil
+}
+
+// DeleteWorktree removes the worktree at path. Worktrees with uncommitted
+// changes are refused when the configuration requires a clean workdir.
+func (i *Integration) DeleteWorktree(path string) tea.Cmd {
 	return func() tea.Msg {
-		// This would use the git manager to create a worktree
-		// For now, return a placeholder success message
-		return WorktreeCreatedMsg{Path: path, Branch: branch}
+		mgr, err := i.worktreeManager()
+		if err != nil {
+			return ErrorMsg{Error: err}
+		}
+		if err := mgr.DeleteWorktree(path, false); err != nil {
+			return ErrorMsg{Error: fmt.Errorf("failed to delete worktree: %w", err)}
+		}
+		return WorktreeDeletedMsg{Path: path}
 	}
 }
 
@@ -651,6 +981,17 @@ func (i *Integration) ResumeSession(sessionID string) tea.Cmd {
 	}
 }
 
+// RestartSession starts Claude Code again inside an existing session whose
+// Claude Code has stopped, then resumes the session
+func (i *Integration) RestartSession(sessionID string) tea.Cmd {
+	return func() tea.Msg {
+		if err := i.tmuxMgr.StartClaude(sessionID, ""); err != nil {
+			return ErrorMsg{Error: err}
+		}
+		return i.ResumeSession(sessionID)()
+	}
+}
+
 // StartRealtimeStatusUpdates begins real-time status monitoring
 func (i *Integration) StartRealtimeStatusUpdates() tea.Cmd {
 	return tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
@@ -671,76 +1012,65 @@ func (i *Integration) ProcessRealtimeStatusUpdate() tea.Cmd {
 	}
 }
 
-// updateClaudeStatusesRealtime updates Claude statuses in background
+// updateClaudeStatusesRealtime sets the Claude status of each worktree from
+// the Claude process running in it
 func (i *Integration) updateClaudeStatusesRealtime() {
+	processes := i.claudeMgr.GetAllProcesses()
+	now := time.Now()
+
 	i.mu.Lock()
 	defer i.mu.Unlock()
 
-	// Get current Claude processes
-	processes := i.claudeMgr.GetAllProcesses()
-
-	// Update each worktree's Claude status
+	matched := processesByWorktree(i.worktrees, processes)
 	for idx := range i.worktrees {
 		wt := &i.worktrees[idx]
 
-		// Find matching Claude process for this worktree
-		found := false
-		for _, process := range processes {
-			// In a real implementation, this would match by working directory
-			// For now, we'll simulate status changes
-			if process.SessionID != "" && len(wt.ActiveSessions) > 0 {
-				// Check if any session matches
-				for _, session := range wt.ActiveSessions {
-					if session.ID == process.SessionID {
-						wt.ClaudeStatus = ClaudeStatus{
-							State:      process.State.String(),
-							ProcessID:  process.PID,
-							LastUpdate: time.Now(),
-							SessionID:  process.SessionID,
-						}
-						found = true
-						break
-					}
-				}
-			}
+		process, ok := matched[wt.Path]
+		if !ok {
+			wt.ClaudeStatus = ClaudeStatus{State: "unknown", LastUpdate: now}
+			continue
 		}
 
-		if !found {
-			// Simulate some status evolution for demo purposes
-			switch wt.ClaudeStatus.State {
-			case "idle":
-				// Sometimes become busy
-				if time.Since(wt.ClaudeStatus.LastUpdate) > 30*time.Second {
-					if wt.Path == "/example/worktree3" { // Demo: make worktree3 busy
-						wt.ClaudeStatus.State = "busy"
-						wt.ClaudeStatus.LastUpdate = time.Now()
-					}
-				}
-			case "busy":
-				// Sometimes return to idle
-				if time.Since(wt.ClaudeStatus.LastUpdate) > 45*time.Second {
-					wt.ClaudeStatus.State = "idle"
-					wt.ClaudeStatus.LastUpdate = time.Now()
-				}
-			case "error":
-				// Errors can be cleared after some time
-				if time.Since(wt.ClaudeStatus.LastUpdate) > 60*time.Second {
-					wt.ClaudeStatus.State = "idle"
-					wt.ClaudeStatus.LastUpdate = time.Now()
-				}
-			case "waiting":
-				// Waiting can transition to busy or idle
-				if time.Since(wt.ClaudeStatus.LastUpdate) > 20*time.Second {
-					wt.ClaudeStatus.State = "idle"
-					wt.ClaudeStatus.LastUpdate = time.Now()
-				}
+		wt.ClaudeStatus = ClaudeStatus{
+			State:      process.GetState().String(),
+			ProcessID:  process.PID,
+			LastUpdate: now,
+			SessionID:  process.SessionID,
+		}
+	}
+}
+
+// processesByWorktree matches Claude processes to the worktrees they run in
+// by working directory, keyed by worktree path. A process in nested
+// worktrees belongs to the innermost one; when several processes run in a
+// worktree the one with the lowest PID is used.
+func processesByWorktree(worktrees []WorktreeInfo, processes []*claude.ProcessInfo) map[string]*claude.ProcessInfo {
+	matched := make(map[string]*claude.ProcessInfo)
+
+	for _, process := range processes {
+		var path string
+		for _, wt := range worktrees {
+			if process.InDirectory(wt.Path) && len(wt.Path) > len(path) {
+				path = wt.Path
 			}
 		}
+		if path == "" {
+			continue
+		}
+
+		if current, ok := matched[path]; !ok || process.PID < current.PID {
+			matched[path] = process
+		}
 	}
+
+	return matched
 }
 
 // Shutdown gracefully shuts down the integration layer
 func (i *Integration) Shutdown() {
+	if monitor, ok := i.claudeMgr.(*claude.ProcessManager); ok {
+		monitor.Stop()
+	}
 	if i.cancel != nil {
 		i.cancel()
 	}
@@ -795,9 +1125,20 @@ type SessionCreatedMsg struct {
 	SessionID string
 }
 
-// WorktreeOpenedMsg indicates a worktree was opened
-type WorktreeOpenedMsg struct {
-	Path string
+// SessionsKilledMsg reports the sessions that were killed and any failures
+type SessionsKilledMsg struct {
+	SessionIDs []string
+	Err        error
+}
+
+// SessionsOpenedMsg indicates sessions were opened in new tmux windows
+type SessionsOpenedMsg struct {
+	SessionIDs []string
+}
+
+// KillSessionsRequestedMsg requests killing sessions once confirmed
+type KillSessionsRequestedMsg struct {
+	Sessions []SessionInfo
 }
 
 // WorktreeCreatedMsg indicates a worktree was created
@@ -806,6 +1147,11 @@ type WorktreeCreatedMsg struct {
 	Branch string
 }
 
+// WorktreeDeletedMsg indicates a worktree was deleted
+type WorktreeDeletedMsg struct {
+	Path string
+}
+
 // New session workflow messages
 type NewSessionRequestedMsg struct {
 	Worktrees []WorktreeInfo
@@ -819,6 +1165,28 @@ type ResumeSessionRequestedMsg struct {
 	Worktrees []WorktreeInfo
 }
 
+// DeleteWorktreeRequestedMsg requests deletion of a worktree
+type DeleteWorktreeRequestedMsg struct {
+	Worktree WorktreeInfo
+}
+
+// ShowErrorMsg asks the app to show an error modal
+type ShowErrorMsg struct {
+	Title   string
+	Message string
+}
+
+// ConfigReloadedMsg carries the result of reloading the config file
+type ConfigReloadedMsg struct {
+	Config *config.Config
+	Err    error
+}
+
+// configEditedMsg is sent when the external editor for the config exits
+type configEditedMsg struct {
+	Err error
+}
+
 // Real-time status update messages
 type RealtimeStatusUpdateMsg struct {
 	Timestamp time.Time
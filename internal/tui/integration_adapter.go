@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
 	"github.com/unbracketed/ccmgr-ultra/internal/tui/workflows"
 )
 
@@ -81,7 +82,7 @@ func (a *IntegrationAdapter) GetAvailableWorktrees() ([]workflows.WorktreeInfo,
 // CreateSession creates a new session using the integration layer
 func (a *IntegrationAdapter) CreateSession(config workflows.SessionConfig) error {
 	// Use the integration layer to create the session
-	cmd := a.integration.CreateSession(config.Name, config.WorktreePath)
+	cmd := a.integration.CreateSession(config)
 
 	// Execute the command (this is a simplified approach)
 	// In a real implementation, we would need to handle the async nature properly
@@ -209,10 +210,82 @@ func (a *IntegrationAdapter) AttachToSession(sessionID string) error {
 	return fmt.Errorf("failed to attach to session")
 }
 
-// GetDefaultWorktreeDir returns the default directory for creating worktrees
-func (a *IntegrationAdapter) GetDefaultWorktreeDir(repoPath string) (string, error) {
-	// Return a sensible default based on the repository path
-	return filepath.Join(filepath.Dir(repoPath), "worktrees"), nil
+// GetAvailableRepositories returns the repository of the working directory,
+// the one the git worktree manager creates worktrees in
+func (a *IntegrationAdapter) GetAvailableRepositories() ([]workflows.RepositoryInfo, error) {
+	repo, err := a.integration.GetRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return []workflows.RepositoryInfo{{
+		Name:          filepath.Base(repo.RootPath),
+		Path:          repo.RootPath,
+		CurrentBranch: repo.CurrentBranch,
+		RemoteURL:     repo.Origin,
+		HasWorktrees:  len(repo.Worktrees) > 0,
+		WorktreeCount: len(repo.Worktrees),
+	}}, nil
+}
+
+// GetBranches returns the local branches of the repository
+func (a *IntegrationAdapter) GetBranches(repoPath string) ([]workflows.BranchInfo, error) {
+	branches, err := a.integration.GetBranches()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]workflows.BranchInfo, 0, len(branches))
+	for _, branch := range branches {
+		result = append(result, workflows.BranchInfo{
+			Name:    branch.Name,
+			Current: branch.Current,
+		})
+	}
+	return result, nil
+}
+
+// GetDefaultWorktreePath returns the path worktree.directory_pattern gives a
+// worktree for branchName
+func (a *IntegrationAdapter) GetDefaultWorktreePath(repoPath, branchName string) (string, error) {
+	return a.integration.ResolveWorktreePath(branchName)
+}
+
+// ValidateBranchName validates the name of a branch to create
+func (a *IntegrationAdapter) ValidateBranchName(name string) error {
+	result := git.NewValidator(a.config).ValidateBranchName(name)
+	if !result.Valid {
+		return fmt.Errorf("%s", strings.Join(result.Errors, "; "))
+	}
+	return nil
+}
+
+// ValidateNewWorktreePath validates a path typed for a new worktree
+func (a *IntegrationAdapter) ValidateNewWorktreePath(path string) error {
+	result := git.NewValidator(a.config).ValidateWorktreePath(path)
+	if !result.Valid {
+		return fmt.Errorf("%s", strings.Join(result.Errors, "; "))
+	}
+	return nil
+}
+
+// CreateWorktree creates a worktree with the git worktree manager
+func (a *IntegrationAdapter) CreateWorktree(config workflows.WorktreeConfig) error {
+	_, err := a.integration.createWorktree(worktreeOptions(config))
+	return err
+}
+
+// worktreeOptions converts a worktree wizard configuration to git options.
+// Without a WorktreePath the worktree is named by worktree.directory_pattern.
+func worktreeOptions(config workflows.WorktreeConfig) git.WorktreeOptions {
+	return git.WorktreeOptions{
+		Path:         config.WorktreePath,
+		Branch:       config.BranchName,
+		CreateBranch: config.NewBranch,
+		Checkout:     true,
+		TrackRemote:  config.TrackRemote,
+		AutoName:     config.WorktreePath == "",
+	}
 }
 
 // GetAvailableProjects and GetAvailableWorktrees are already implemented above
@@ -1,12 +1,23 @@
 package tui
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/claude"
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
+	"github.com/unbracketed/ccmgr-ultra/internal/logging"
+	"github.com/unbracketed/ccmgr-ultra/internal/tmux"
+	"github.com/unbracketed/ccmgr-ultra/internal/tui/workflows"
 )
 
 func TestIntegration_NewIntegration(t *testing.T) {
@@ -20,6 +31,19 @@ func TestIntegration_NewIntegration(t *testing.T) {
 	assert.Equal(t, cfg, integration.config)
 }
 
+func TestIntegration_NewIntegrationSkipsDisabledMonitor(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Claude.Enabled = false
+
+	integration, err := NewIntegration(cfg)
+	require.NoError(t, err)
+	defer integration.Shutdown()
+
+	monitor, ok := integration.claudeMgr.(*claude.ProcessManager)
+	require.True(t, ok)
+	assert.False(t, monitor.IsRunning())
+}
+
 func TestIntegration_GetSystemStatus(t *testing.T) {
 	cfg := config.DefaultConfig()
 
@@ -113,58 +137,154 @@ func TestIntegration_AttachSession(t *testing.T) {
 }
 
 func TestIntegration_OpenWorktree(t *testing.T) {
-	cfg := config.DefaultConfig()
-
-	integration, err := NewIntegration(cfg)
-	require.NoError(t, err)
+	worktree := WorktreeInfo{Path: "/work/app-auth", Branch: "feature/auth"}
+	newIntegration := func(tmuxMgr *fakeTmuxManager) (*Integration, *[]string) {
+		var attached []string
+		return &Integration{
+			tmuxMgr:   tmuxMgr,
+			worktrees: []WorktreeInfo{worktree},
+			execAttach: func(sessionID string) tea.Cmd {
+				attached = append(attached, sessionID)
+				return func() tea.Msg { return SessionAttachedMsg{SessionID: sessionID} }
+			},
+		}, &attached
+	}
 
-	cmd := integration.OpenWorktree("/test/path")
-	assert.NotNil(t, cmd)
+	t.Run("attaches to the running session", func(t *testing.T) {
+		tmuxMgr := &fakeTmuxManager{sessions: []*tmux.Session{
+			{ID: "$1", Directory: "/work/app", Active: true},
+			{ID: "$2", Directory: "/work/app-auth/internal", Active: true},
+			{ID: "$3", Directory: "/work/app-auth", Active: true},
+		}}
+		integration, attached := newIntegration(tmuxMgr)
+
+		msg := integration.OpenWorktree(worktree.Path)()
+		assert.Equal(t, SessionAttachedMsg{SessionID: "$3"}, msg)
+		assert.Equal(t, []string{"$3"}, *attached)
+		assert.Equal(t, []string{"$3"}, tmuxMgr.activated)
+	})
+
+	t.Run("does not attach when the activation hook fails", func(t *testing.T) {
+		integration, attached := newIntegration(&fakeTmuxManager{
+			sessions:    []*tmux.Session{{ID: "$3", Directory: "/work/app-auth", Active: true}},
+			activateErr: errors.New("worktree activation hook failed: direnv blocked"),
+		})
 
-	// Execute the command to test the message
-	msg := cmd()
-	worktreeMsg, ok := msg.(WorktreeOpenedMsg)
-	assert.True(t, ok)
-	assert.Equal(t, "/test/path", worktreeMsg.Path)
+		msg, ok := integration.OpenWorktree(worktree.Path)().(ErrorMsg)
+		require.True(t, ok)
+		assert.Contains(t, msg.Error.Error(), "direnv blocked")
+		assert.Empty(t, *attached)
+	})
+
+	t.Run("offers the session wizard without a running session", func(t *testing.T) {
+		integration, attached := newIntegration(&fakeTmuxManager{sessions: []*tmux.Session{
+			{ID: "$1", Directory: "/work/app-auth", Active: false},
+			{ID: "$2", Directory: "/work/app-authz", Active: true},
+		}})
+
+		msg := integration.OpenWorktree(worktree.Path)()
+		assert.Equal(t, NewSessionRequestedMsg{Worktrees: []WorktreeInfo{worktree}}, msg)
+		assert.Empty(t, *attached)
+
+		msg = integration.OpenWorktree("/work/uncached")()
+		assert.Equal(t, NewSessionRequestedMsg{Worktrees: []WorktreeInfo{{Path: "/work/uncached"}}}, msg)
+	})
+
+	t.Run("reports tmux failures", func(t *testing.T) {
+		integration, attached := newIntegration(&fakeTmuxManager{err: errors.New("no server running")})
+
+		msg, ok := integration.OpenWorktree(worktree.Path)().(ErrorMsg)
+		require.True(t, ok)
+		assert.Contains(t, msg.Error.Error(), "no server running")
+		assert.Empty(t, *attached)
+	})
 }
 
 func TestIntegration_CreateSession(t *testing.T) {
-	cfg := config.DefaultConfig()
+	tmuxMgr := &fakeTmuxManager{}
+	integration := &Integration{tmuxMgr: tmuxMgr}
+
+	cmd := integration.CreateSession(workflows.SessionConfig{
+		Name:         "test-session",
+		ProjectName:  "app",
+		ProjectPath:  "/work/app",
+		WorktreePath: "/work/app-auth",
+		Branch:       "feature/auth",
+	})
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, SessionCreatedMsg{SessionID: "test-session"}, cmd())
+	require.Len(t, tmuxMgr.created, 1)
+	assert.Equal(t, &tmux.Session{
+		ID:        "test-session",
+		Name:      "test-session",
+		Project:   "app",
+		Worktree:  "app-auth",
+		Branch:    "feature/auth",
+		Directory: "/work/app-auth",
+	}, tmuxMgr.created[0])
+}
 
-	integration, err := NewIntegration(cfg)
-	require.NoError(t, err)
+func TestIntegration_CreateSession_ProjectDirectory(t *testing.T) {
+	tmuxMgr := &fakeTmuxManager{}
+	integration := &Integration{tmuxMgr: tmuxMgr}
 
-	cmd := integration.CreateSession("test-session", "/test/dir")
-	assert.NotNil(t, cmd)
+	msg := integration.CreateSession(workflows.SessionConfig{ProjectPath: "/work/app", Branch: "main"})()
 
-	// Execute the command to test the message
-	msg := cmd()
-	switch msg := msg.(type) {
-	case SessionCreatedMsg:
-		assert.Equal(t, "test-session", msg.SessionID)
-	case ErrorMsg:
-		// This might happen if tmux is not available
-		assert.NotNil(t, msg.Error)
-	default:
-		t.Fatalf("Unexpected message type: %T", msg)
-	}
+	require.Len(t, tmuxMgr.created, 1)
+	session := tmuxMgr.created[0]
+	assert.Equal(t, "app", session.Project, "the project is named after its directory")
+	assert.Equal(t, "app", session.Worktree)
+	assert.Equal(t, "/work/app", session.Directory)
+	assert.Equal(t, tmux.GenerateSessionName("app", "app", "main"), session.Name, "an unnamed session gets the generated name")
+	assert.Equal(t, SessionCreatedMsg{SessionID: session.ID}, msg)
 }
 
-func TestIntegration_CreateWorktree(t *testing.T) {
-	cfg := config.DefaultConfig()
+func TestIntegration_CreateSession_Error(t *testing.T) {
+	integration := &Integration{tmuxMgr: &fakeTmuxManager{err: errors.New("tmux not available")}}
 
-	integration, err := NewIntegration(cfg)
-	require.NoError(t, err)
+	msg := integration.CreateSession(workflows.SessionConfig{Name: "test-session", ProjectPath: "/work/app"})()
+	errMsg, ok := msg.(ErrorMsg)
+	require.True(t, ok, "got %T", msg)
+	assert.EqualError(t, errMsg.Error, "tmux not available")
+}
 
-	cmd := integration.CreateWorktree("/test/path", "test-branch")
-	assert.NotNil(t, cmd)
+func TestIntegration_CreateWorktree(t *testing.T) {
+	gitMgr := newFakeWorktreeManager()
+	integration := &Integration{gitMgr: gitMgr}
+
+	cmd := integration.CreateWorktree(git.WorktreeOptions{Branch: "test-branch", CreateBranch: true, AutoName: true})
+	require.NotNil(t, cmd)
 
-	// Execute the command to test the message
 	msg := cmd()
 	worktreeMsg, ok := msg.(WorktreeCreatedMsg)
-	assert.True(t, ok)
-	assert.Equal(t, "/test/path", worktreeMsg.Path)
+	require.True(t, ok, "got %T", msg)
+	assert.Equal(t, "/work/worktrees/test-branch", worktreeMsg.Path)
 	assert.Equal(t, "test-branch", worktreeMsg.Branch)
+	require.Len(t, gitMgr.created, 1)
+	assert.True(t, gitMgr.created[0].CreateBranch)
+}
+
+func TestIntegration_CreateWorktree_PatternError(t *testing.T) {
+	gitMgr := newFakeWorktreeManager()
+	gitMgr.err = fmt.Errorf("invalid base directory configuration: %w",
+		&git.InsideRepositoryError{Subject: "base directory", Path: "/work/app/.worktrees", RepoRoot: "/work/app"})
+	integration := &Integration{gitMgr: gitMgr}
+
+	msg := integration.CreateWorktree(git.WorktreeOptions{Branch: "test-branch", AutoName: true})()
+	errMsg, ok := msg.(ShowErrorMsg)
+	require.True(t, ok, "got %T", msg)
+	assert.Contains(t, errMsg.Message, "cannot be inside repository")
+	assert.Contains(t, errMsg.Message, git.PatternErrorSuggestion(gitMgr.err))
+}
+
+func TestIntegration_CreateWorktree_OutsideRepository(t *testing.T) {
+	integration := &Integration{}
+
+	msg := integration.CreateWorktree(git.WorktreeOptions{Branch: "test-branch"})()
+	errMsg, ok := msg.(ErrorMsg)
+	require.True(t, ok, "got %T", msg)
+	assert.Contains(t, errMsg.Error.Error(), "not in a git repository")
 }
 
 func TestIntegration_RefreshData(t *testing.T) {
@@ -314,3 +434,288 @@ func TestWorktreeInfo_Struct(t *testing.T) {
 	assert.True(t, worktree.HasChanges)
 	assert.Equal(t, "modified", worktree.Status)
 }
+
+// fakeTmuxManager returns a fixed set of tmux sessions and records the
+// sessions it is asked to create, activate, kill or open in windows, failing
+// with err when it is set
+type fakeTmuxManager struct {
+	sessions    []*tmux.Session
+	err         error
+	created     []*tmux.Session
+	activated   []string
+	activateErr error
+	killed      []string
+	windows     []string
+	started     []string
+}
+
+func (f *fakeTmuxManager) ListSessions() ([]*tmux.Session, error) {
+	return f.sessions, f.err
+}
+
+func (f *fakeTmuxManager) AttachSession(sessionID string) error {
+	return nil
+}
+
+func (f *fakeTmuxManager) ActivateSession(session *tmux.Session) error {
+	f.activated = append(f.activated, session.ID)
+	return f.activateErr
+}
+
+func (f *fakeTmuxManager) CreateSessionWithName(sessionName, project, worktree, branch, directory string) (*tmux.Session, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	session := &tmux.Session{
+		ID:        sessionName,
+		Name:      sessionName,
+		Project:   project,
+		Worktree:  worktree,
+		Branch:    branch,
+		Directory: directory,
+	}
+	f.created = append(f.created, session)
+	return session, nil
+}
+
+func (f *fakeTmuxManager) KillSession(sessionID string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.killed = append(f.killed, sessionID)
+	return nil
+}
+
+func (f *fakeTmuxManager) StartClaude(sessionID, prompt string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.started = append(f.started, sessionID)
+	return nil
+}
+
+func (f *fakeTmuxManager) AttachSessionInWindow(sessionID string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.windows = append(f.windows, sessionID)
+	return nil
+}
+
+// fakeWorktreeManager creates worktrees for /work/app in memory, failing
+// with err when it is set
+type fakeWorktreeManager struct {
+	repo     *git.Repository
+	branches []git.BranchInfo
+	err      error
+	created  []git.WorktreeOptions
+	deleted  []string
+}
+
+func newFakeWorktreeManager() *fakeWorktreeManager {
+	return &fakeWorktreeManager{
+		repo:     &git.Repository{RootPath: "/work/app", CurrentBranch: "main"},
+		branches: []git.BranchInfo{{Name: "main", Current: true}, {Name: "develop"}},
+	}
+}
+
+func (f *fakeWorktreeManager) Repository() *git.Repository {
+	return f.repo
+}
+
+func (f *fakeWorktreeManager) ListBranches() ([]git.BranchInfo, error) {
+	return f.branches, nil
+}
+
+func (f *fakeWorktreeManager) ResolveWorktreePath(branch string, opts git.WorktreeOptions) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	if opts.Path != "" {
+		return opts.Path, nil
+	}
+	return "/work/worktrees/" + branch, nil
+}
+
+func (f *fakeWorktreeManager) CreateWorktree(branch string, opts git.WorktreeOptions) (*git.WorktreeInfo, error) {
+	path, err := f.ResolveWorktreePath(branch, opts)
+	if err != nil {
+		return nil, err
+	}
+	f.created = append(f.created, opts)
+	return &git.WorktreeInfo{Path: path, Branch: branch}, nil
+}
+
+func (f *fakeWorktreeManager) DeleteWorktree(path string, force bool) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.deleted = append(f.deleted, path)
+	return nil
+}
+
+func TestIntegration_AssociateSessionsWithWorktrees(t *testing.T) {
+	lastAccess := time.Now().Add(-10 * time.Minute)
+	integration := &Integration{
+		tmuxMgr: &fakeTmuxManager{sessions: []*tmux.Session{
+			{ID: "$1", Name: "ccmgr-app-main", Directory: "/work/app", Active: true, LastAccess: lastAccess},
+			{ID: "$2", Name: "ccmgr-app-auth", Directory: "/work/app-auth/internal/auth", Active: false},
+			{ID: "$3", Name: "ccmgr-app-nested", Directory: "/work/app/.worktrees/docs"},
+			{ID: "$4", Name: "scratch", Directory: "/tmp/scratch", Active: true},
+			{ID: "$5", Name: "sibling", Directory: "/work/app-authz"},
+		}},
+		worktrees: []WorktreeInfo{
+			{Path: "/work/app", Branch: "main"},
+			{Path: "/work/app-auth", Branch: "feature/auth"},
+			{Path: "/work/app/.worktrees/docs", Branch: "docs"},
+			{Path: "/work/app-idle", Branch: "feature/idle", ActiveSessions: []SessionSummary{{ID: "stale"}}},
+		},
+	}
+
+	integration.refreshTmuxData()
+	integration.associateSessionsWithWorktrees()
+
+	sessionIDs := func(wt WorktreeInfo) []string {
+		ids := []string{}
+		for _, s := range wt.ActiveSessions {
+			ids = append(ids, s.ID)
+		}
+		return ids
+	}
+
+	worktrees := integration.worktrees
+	assert.Equal(t, []string{"$1"}, sessionIDs(worktrees[0]))
+	assert.Equal(t, []string{"$2"}, sessionIDs(worktrees[1]), "subdirectory sessions belong to the worktree")
+	assert.Equal(t, []string{"$3"}, sessionIDs(worktrees[2]), "nested worktrees take their own sessions")
+	assert.Empty(t, worktrees[3].ActiveSessions, "stale sessions are cleared")
+
+	mainSession := worktrees[0].ActiveSessions[0]
+	assert.Equal(t, "ccmgr-app-main", mainSession.Name)
+	assert.Equal(t, "active", mainSession.State)
+	assert.Equal(t, lastAccess, mainSession.LastUsed)
+	assert.Equal(t, "paused", worktrees[1].ActiveSessions[0].State)
+}
+
+// countingGit answers every git command with a fixed repository root and
+// counts repository detections
+type countingGit struct {
+	root       string
+	detections int
+}
+
+func (g *countingGit) Execute(dir string, args ...string) (string, error) {
+	switch strings.Join(args, " ") {
+	case "rev-parse --git-dir":
+		g.detections++
+		return ".git", nil
+	case "rev-parse --show-toplevel":
+		return g.root, nil
+	}
+	return "", nil
+}
+
+func (g *countingGit) ExecuteWithInput(dir, input string, args ...string) (string, error) {
+	return g.Execute(dir, args...)
+}
+
+func TestIntegration_RepositoryRefreshedOnlyWhenCwdChanges(t *testing.T) {
+	gitCmd := &countingGit{root: "/work/app"}
+	integration := &Integration{config: config.DefaultConfig(), repos: git.NewRepositoryCache(gitCmd)}
+
+	t.Chdir(t.TempDir())
+	first, err := integration.repository()
+	require.NoError(t, err)
+	manager := integration.gitMgr
+	require.NotNil(t, manager)
+
+	for range 3 {
+		repo, err := integration.repository()
+		require.NoError(t, err)
+		assert.Same(t, first, repo)
+	}
+	assert.Equal(t, 1, gitCmd.detections, "an unchanged working directory reuses the repository")
+	assert.Same(t, manager, integration.gitMgr)
+
+	t.Chdir(t.TempDir())
+	second, err := integration.repository()
+	require.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, 2, gitCmd.detections)
+	assert.NotSame(t, manager, integration.gitMgr, "the worktree manager follows the repository")
+}
+
+// fakeClaudeProcesses returns a fixed set of Claude processes
+type fakeClaudeProcesses []*claude.ProcessInfo
+
+func (f fakeClaudeProcesses) GetAllProcesses() []*claude.ProcessInfo {
+	return f
+}
+
+func TestIntegration_UpdateClaudeStatusesFromProcesses(t *testing.T) {
+	integration := &Integration{
+		claudeMgr: fakeClaudeProcesses{
+			{PID: 200, SessionID: "auth-2", WorkingDir: "/work/app-auth/src", State: claude.StateIdle},
+			{PID: 100, SessionID: "app", WorkingDir: "/work/app", State: claude.StateIdle},
+			{PID: 300, SessionID: "nested", WorkingDir: "/work/app/nested/pkg", State: claude.StateWaiting},
+			{PID: 50, SessionID: "auth-1", WorkingDir: "/work/app-auth", State: claude.StateBusy},
+			{PID: 400, SessionID: "other", WorkingDir: "/elsewhere", State: claude.StateBusy},
+		},
+		worktrees: []WorktreeInfo{
+			{Path: "/work/app"},
+			{Path: "/work/app-auth"},
+			{Path: "/work/app/nested"},
+			{Path: "/work/docs", ClaudeStatus: ClaudeStatus{State: "busy", ProcessID: 9, SessionID: "stale"}},
+		},
+	}
+
+	want := map[string]ClaudeStatus{
+		"/work/app":        {State: "idle", ProcessID: 100, SessionID: "app"},
+		"/work/app-auth":   {State: "busy", ProcessID: 50, SessionID: "auth-1"},
+		"/work/app/nested": {State: "waiting", ProcessID: 300, SessionID: "nested"},
+		"/work/docs":       {State: "unknown"},
+	}
+
+	for range 2 {
+		integration.updateClaudeStatusesRealtime()
+
+		for _, wt := range integration.GetAllWorktrees() {
+			status := wt.ClaudeStatus
+			assert.False(t, status.LastUpdate.IsZero(), wt.Path)
+			status.LastUpdate = time.Time{}
+			assert.Equal(t, want[wt.Path], status, wt.Path)
+		}
+	}
+}
+
+func TestIntegration_RefreshTmuxDataLogsErrors(t *testing.T) {
+	previous := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	var logged bytes.Buffer
+	slog.SetDefault(logging.New(&logged, slog.LevelInfo))
+
+	integration := &Integration{tmuxMgr: &fakeTmuxManager{err: errors.New("no server running")}}
+	integration.refreshTmuxData()
+
+	assert.Equal(t, []string{"Failed to list tmux sessions: no server running"}, integration.systemStatus.Errors)
+	assert.Contains(t, logged.String(), "level=ERROR")
+	assert.Contains(t, logged.String(), `error="no server running"`)
+}
+
+func TestIntegration_RefreshReloadsRepository(t *testing.T) {
+	gitCmd := &countingGit{root: "/work/app"}
+	integration := &Integration{config: config.DefaultConfig(), repos: git.NewRepositoryCache(gitCmd)}
+	t.Chdir(t.TempDir())
+
+	integration.refreshGitData()
+	first := integration.gitRepo
+	require.NotNil(t, first)
+
+	gitCmd.root = "/work/app-renamed"
+	integration.refreshGitData()
+	assert.Equal(t, 2, gitCmd.detections, "every refresh detects the repository again")
+	require.NotNil(t, integration.gitRepo)
+	assert.NotSame(t, first, integration.gitRepo)
+	assert.Equal(t, "/work/app-renamed", integration.gitRepo.RootPath)
+}
@@ -106,8 +106,15 @@ func (m *MultiStepModal) HandleKeyMsg(msg tea.KeyMsg) (Modal, tea.Cmd) {
 	return m, nil
 }
 
-// nextStep moves to the next step
+// nextStep moves to the next step, or finishes the wizard on the last step
 func (m *MultiStepModal) nextStep() (Modal, tea.Cmd) {
+	if m.currentStep == len(m.steps)-1 {
+		if m.canFinish() {
+			m.MarkComplete(m.stepData)
+		}
+		return m, nil
+	}
+
 	if !m.canGoNext {
 		return m, nil
 	}
@@ -121,13 +128,8 @@ func (m *MultiStepModal) nextStep() (Modal, tea.Cmd) {
 		}
 	}
 
-	if m.currentStep < len(m.steps)-1 {
-		m.currentStep++
-		m.updateNavigationState()
-	} else if m.canFinish() {
-		m.MarkComplete(m.stepData)
-		return m, nil
-	}
+	m.currentStep++
+	m.updateNavigationState()
 
 	return m, nil
 }
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// PreferencesFileName is the file holding TUI preferences, stored next to
+// the tmux state file
+const PreferencesFileName = "tui-preferences.json"
+
+// WorktreePreferences are the worktrees screen settings kept across restarts
+type WorktreePreferences struct {
+	SortMode     WorktreeSortMode `json:"sort_mode"`
+	SortReversed bool             `json:"sort_reversed"`
+	FilterText   string           `json:"filter_text"`
+}
+
+// DefaultWorktreePreferences returns the settings used when nothing was saved
+func DefaultWorktreePreferences() WorktreePreferences {
+	return WorktreePreferences{SortMode: SortByLastAccess}
+}
+
+// PreferencesPath returns the preferences file location: the directory of
+// tmux.state_file, or the config directory when that is not set
+func PreferencesPath(cfg *config.Config) string {
+	if cfg == nil || cfg.Tmux.StateFile == "" {
+		return filepath.Join(config.GetConfigPath(), PreferencesFileName)
+	}
+	return filepath.Join(filepath.Dir(config.ExpandPath(cfg.Tmux.StateFile)), PreferencesFileName)
+}
+
+// LoadWorktreePreferences reads the preferences at path. A missing file
+// yields the defaults; a corrupt file or an unknown sort mode yields the
+// defaults together with an error describing the problem.
+func LoadWorktreePreferences(path string) (WorktreePreferences, error) {
+	prefs := DefaultWorktreePreferences()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return prefs, nil
+	}
+	if err != nil {
+		return prefs, fmt.Errorf("failed to read preferences: %w", err)
+	}
+
+	var loaded WorktreePreferences
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return prefs, fmt.Errorf("failed to parse preferences %s: %w", path, err)
+	}
+	if loaded.SortMode < SortByName || loaded.SortMode > SortByStatus {
+		return prefs, fmt.Errorf("invalid sort mode %d in %s", loaded.SortMode, path)
+	}
+
+	return loaded, nil
+}
+
+// SaveWorktreePreferences writes prefs to path atomically
+func SaveWorktreePreferences(path string, prefs WorktreePreferences) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create preferences directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(prefs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal preferences: %w", err)
+	}
+
+	tempFile := path + ".tmp"
+	if err := os.WriteFile(tempFile, data, 0644); err != nil {
+		return fmt.Errorf("failed to write temp preferences file: %w", err)
+	}
+
+	if err := os.Rename(tempFile, path); err != nil {
+		os.Remove(tempFile)
+		return fmt.Errorf("failed to save preferences: %w", err)
+	}
+
+	return nil
+}
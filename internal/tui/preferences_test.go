@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+func TestWorktreePreferences_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", PreferencesFileName)
+	prefs := WorktreePreferences{SortMode: SortByStatus, SortReversed: true, FilterText: "feature"}
+
+	require.NoError(t, SaveWorktreePreferences(path, prefs))
+
+	loaded, err := LoadWorktreePreferences(path)
+	require.NoError(t, err)
+	assert.Equal(t, prefs, loaded)
+}
+
+func TestLoadWorktreePreferences_Fallbacks(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("missing file", func(t *testing.T) {
+		prefs, err := LoadWorktreePreferences(filepath.Join(dir, "missing.json"))
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultWorktreePreferences(), prefs)
+	})
+
+	t.Run("corrupt file", func(t *testing.T) {
+		path := filepath.Join(dir, "corrupt.json")
+		require.NoError(t, os.WriteFile(path, []byte("{not json"), 0644))
+
+		prefs, err := LoadWorktreePreferences(path)
+		assert.Error(t, err)
+		assert.Equal(t, DefaultWorktreePreferences(), prefs)
+	})
+
+	t.Run("unknown sort mode", func(t *testing.T) {
+		path := filepath.Join(dir, "mode.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"sort_mode": 9}`), 0644))
+
+		prefs, err := LoadWorktreePreferences(path)
+		assert.Error(t, err)
+		assert.Equal(t, DefaultWorktreePreferences(), prefs)
+	})
+}
+
+func TestPreferencesPath(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Tmux.StateFile = "/var/lib/ccmgr/tmux-sessions.json"
+	assert.Equal(t, "/var/lib/ccmgr/"+PreferencesFileName, PreferencesPath(cfg))
+
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	assert.Equal(t, filepath.Join("/xdg", config.ConfigDirName, PreferencesFileName), PreferencesPath(nil))
+}
+
+func TestWorktreesModel_PersistsPreferences(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PreferencesFileName)
+
+	m := NewWorktreesModel(nil, DefaultTheme())
+	m.preferencesPath = path
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	restored := NewWorktreesModel(nil, DefaultTheme())
+	restored.preferencesPath = path
+	restored.loadPreferences()
+
+	assert.Equal(t, SortByBranch, restored.sortMode)
+	assert.True(t, restored.sortReversed)
+	assert.Equal(t, "fx", restored.filterText)
+}
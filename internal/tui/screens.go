@@ -2,12 +2,16 @@ package tui
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
+	"sort"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/unbracketed/ccmgr-ultra/internal/config"
+	"github.com/unbracketed/ccmgr-ultra/internal/logging"
 )
 
 // Screen interface that all screens must implement
@@ -181,7 +185,7 @@ func (m *DashboardModel) renderRecentWorktrees() string {
 	for _, wt := range worktrees {
 		line := fmt.Sprintf("• %s (%s) - %s",
 			wt.Path,
-			wt.Branch,
+			worktreeBranch(wt),
 			wt.LastAccess.Format("Jan 2 15:04"),
 		)
 		worktreeLines = append(worktreeLines, line)
@@ -221,12 +225,16 @@ type SessionsModel struct {
 	height      int
 	cursor      int
 	sessions    []SessionInfo
+
+	selection
+	listFilter
 }
 
 func NewSessionsModel(integration *Integration, theme Theme) *SessionsModel {
 	return &SessionsModel{
 		integration: integration,
 		theme:       theme,
+		selection:   newSelection(),
 	}
 }
 
@@ -240,49 +248,206 @@ func (m *SessionsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 	case tea.KeyMsg:
+		// Handle search mode input
+		if m.searchMode {
+			if changed, _ := m.editSearch(msg.String()); changed {
+				m.applyFilter()
+			}
+			return m, nil
+		}
+
 		switch msg.String() {
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
 			}
 		case "down", "j":
-			if m.cursor < len(m.sessions)-1 {
+			if m.cursor < len(m.getVisibleIndices())-1 {
 				m.cursor++
 			}
 		case "enter":
-			// Attach to selected session
-			if m.cursor < len(m.sessions) {
-				session := m.sessions[m.cursor]
+			// Open selected sessions in new windows, or attach to the current one
+			if selected := m.getSelectedSessions(); len(selected) > 0 {
+				return m, m.integration.AttachSessionsInWindows(sessionIDs(selected))
+			}
+			if session := m.getCurrentSession(); session != nil {
 				return m, m.integration.AttachSession(session.ID)
 			}
+		case "d":
+			// Ask the app to confirm before killing the selected sessions
+			sessions := m.getSelectedSessions()
+			if session := m.getCurrentSession(); len(sessions) == 0 && session != nil {
+				sessions = []SessionInfo{*session}
+			}
+			if len(sessions) > 0 {
+				return m, func() tea.Msg {
+					return KillSessionsRequestedMsg{Sessions: sessions}
+				}
+			}
+		case "r":
+			if session := m.getCurrentSession(); session != nil {
+				return m, m.resumeSession(*session)
+			}
+		case " ":
+			// Toggle selection of current session
+			if indices := m.getVisibleIndices(); m.cursor < len(indices) {
+				m.toggleSelected(indices[m.cursor])
+			}
+		case "a":
+			// Select all / deselect all
+			m.toggleSelectAllOf(m.getVisibleIndices())
+		case "/":
+			// Enter search/filter mode
+			m.enterSearchMode()
+		case "tab":
+			m.toggleSelectionMode()
+		case "esc":
+			// Clear search filter or exit selection mode
+			if m.filterText != "" {
+				m.clearSearch()
+			} else if m.selectionMode {
+				m.toggleSelectionMode()
+			}
 		}
 	case RefreshDataMsg:
-		m.sessions = m.integration.GetAllSessions()
+		m.refreshSessions()
 	}
 	return m, nil
 }
 
+// refreshSessions reloads the session list, keeping the same sessions
+// selected even when their positions change
+func (m *SessionsModel) refreshSessions() {
+	selected := make(map[string]bool)
+	for _, session := range m.getSelectedSessions() {
+		selected[session.ID] = true
+	}
+
+	m.sessions = m.integration.GetAllSessions()
+	m.selectedItems = make(map[int]bool)
+	for idx, session := range m.sessions {
+		if selected[session.ID] {
+			m.selectedItems[idx] = true
+		}
+	}
+
+	m.applyFilter()
+}
+
+// applyFilter filters sessions based on current filter text, matching their
+// name, project, branch and directory
+func (m *SessionsModel) applyFilter() {
+	m.filterItems(len(m.sessions), func(i int, matches func(string) bool) bool {
+		session := m.sessions[i]
+		return matches(session.Name) || matches(session.Project) ||
+			matches(session.Branch) || matches(session.Directory)
+	})
+
+	// Reset cursor if it's out of bounds
+	if visible := len(m.getVisibleIndices()); m.cursor >= visible {
+		m.cursor = max(visible-1, 0)
+	}
+}
+
+// clearSearch clears the current search filter
+func (m *SessionsModel) clearSearch() {
+	m.filterText = ""
+	m.filteredIndices = []int{}
+	m.cursor = 0
+}
+
+// getVisibleIndices returns indices of currently visible sessions
+func (m *SessionsModel) getVisibleIndices() []int {
+	return m.visibleIndices(len(m.sessions))
+}
+
+// getCurrentSession returns the session at cursor position
+func (m *SessionsModel) getCurrentSession() *SessionInfo {
+	indices := m.getVisibleIndices()
+	if m.cursor < len(indices) {
+		return &m.sessions[indices[m.cursor]]
+	}
+	return nil
+}
+
+// getSelectedSessions returns currently selected sessions
+func (m *SessionsModel) getSelectedSessions() []SessionInfo {
+	var selected []SessionInfo
+	for _, idx := range m.selectedIndices(len(m.sessions)) {
+		selected = append(selected, m.sessions[idx])
+	}
+	return selected
+}
+
+// sessionIDs returns the IDs of sessions
+func sessionIDs(sessions []SessionInfo) []string {
+	ids := make([]string, len(sessions))
+	for i, session := range sessions {
+		ids[i] = session.ID
+	}
+	return ids
+}
+
+// resumeSession resumes a running session, or restarts Claude Code inside
+// a stopped one
+func (m *SessionsModel) resumeSession(session SessionInfo) tea.Cmd {
+	if session.Active {
+		return m.integration.ResumeSession(session.ID)
+	}
+	return m.integration.RestartSession(session.ID)
+}
+
 func (m *SessionsModel) View() string {
 	if m.width == 0 {
 		return "Loading sessions..."
 	}
 
-	header := m.theme.HeaderStyle.Render("🖥️  Session Management")
+	headerText := "🖥️  Session Management"
+	if m.selectionMode {
+		headerText += fmt.Sprintf(" [MULTI-SELECT: %d selected]", len(m.getSelectedSessions()))
+	}
+	if m.filterText != "" {
+		headerText += fmt.Sprintf(" [FILTER: %s]", m.filterText)
+		if m.filterInvalid {
+			headerText += m.theme.MutedStyle.Render(" invalid regex")
+		}
+	}
+	header := m.theme.HeaderStyle.Render(headerText)
+
+	var footer []string
+	if m.searchMode {
+		footer = append(footer, "", m.theme.MutedStyle.Render(
+			fmt.Sprintf("Search: %s| • Enter/Esc: Exit search", m.filterText)))
+	}
 
-	if len(m.sessions) == 0 {
-		return lipgloss.JoinVertical(lipgloss.Left,
+	indices := m.getVisibleIndices()
+	if len(indices) == 0 {
+		noResults := "No sessions found"
+		if m.filterText != "" {
+			noResults = fmt.Sprintf("No sessions match filter: %s", m.filterText)
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, append([]string{
 			header,
 			"",
-			m.theme.ContentStyle.Render("No sessions found"),
-		)
+			m.theme.ContentStyle.Render(noResults),
+		}, footer...)...)
 	}
 
 	var sessionLines []string
-	for i, session := range m.sessions {
+	for i, idx := range indices {
+		session := m.sessions[idx]
+
 		cursor := " "
 		if i == m.cursor {
 			cursor = ">"
 		}
+		if m.selectionMode {
+			if m.selectedItems[idx] {
+				cursor += " ✓"
+			} else {
+				cursor += " ☐"
+			}
+		}
 
 		status := "●"
 		statusColor := m.theme.Success
@@ -304,11 +469,11 @@ func (m *SessionsModel) View() string {
 
 	content := strings.Join(sessionLines, "\n")
 
-	return lipgloss.JoinVertical(lipgloss.Left,
+	return lipgloss.JoinVertical(lipgloss.Left, append([]string{
 		header,
 		"",
 		m.theme.ContentStyle.Render(content),
-	)
+	}, footer...)...)
 }
 
 func (m *SessionsModel) Title() string {
@@ -316,11 +481,38 @@ func (m *SessionsModel) Title() string {
 }
 
 func (m *SessionsModel) Help() []string {
+	if m.searchMode {
+		return []string{
+			"Type to search",
+			"/pattern: Regular expression",
+			"Enter/Esc: Exit search",
+			"Backspace: Delete character",
+			"Ctrl+C: Clear and exit",
+		}
+	}
+
+	if m.selectionMode {
+		return []string{
+			"↑/k: Move up",
+			"↓/j: Move down",
+			"Space: Toggle selection",
+			"a: Select/deselect all",
+			"Enter: Open selected in new windows",
+			"d: Kill selected sessions",
+			"/: Search/filter",
+			"Tab: Exit multi-select",
+		}
+	}
 	return []string{
 		"↑/k: Move up",
 		"↓/j: Move down",
 		"Enter: Attach session",
 		"n: New session",
+		"d: Kill session",
+		"r: Resume/restart session",
+		"/: Search/filter",
+		"Esc: Clear filter",
+		"Tab: Multi-select mode",
 	}
 }
 
@@ -332,27 +524,51 @@ type WorktreesModel struct {
 	height          int
 	cursor          int
 	worktrees       []WorktreeInfo
-	selectedItems   map[int]bool            // New: multi-selection state
-	selectionMode   bool                    // New: toggle selection mode
-	filterText      string                  // New: search filter
-	sortMode        WorktreeSortMode        // New: sorting mode
-	claudeStatuses  map[string]ClaudeStatus // New: status tracking
-	filteredIndices []int                   // New: indices after filtering
-	searchMode      bool                    // New: search input mode
+	sortMode        WorktreeSortMode // New: sorting mode
+	sortReversed    bool             // Invert the order of sortMode
+	inspectMode     bool             // Details panel for the current worktree
+	preferencesPath string           // Where sort/filter choices persist; empty disables
+
+	selection
+	listFilter
 }
 
 func NewWorktreesModel(integration *Integration, theme Theme) *WorktreesModel {
-	return &WorktreesModel{
-		integration:     integration,
-		theme:           theme,
-		selectedItems:   make(map[int]bool),
-		selectionMode:   false,
-		filterText:      "",
-		sortMode:        SortByLastAccess,
-		claudeStatuses:  make(map[string]ClaudeStatus),
-		filteredIndices: []int{},
-		searchMode:      false,
+	m := &WorktreesModel{
+		integration: integration,
+		theme:       theme,
+		selection:   newSelection(),
+		sortMode:    SortByLastAccess,
+	}
+
+	if integration != nil {
+		m.preferencesPath = PreferencesPath(integration.config)
+		m.loadPreferences()
+	}
+
+	return m
+}
+
+// loadPreferences restores the saved sort and filter settings. Unreadable
+// preferences leave the defaults in place.
+func (m *WorktreesModel) loadPreferences() {
+	prefs, _ := LoadWorktreePreferences(m.preferencesPath)
+	m.sortMode = prefs.SortMode
+	m.sortReversed = prefs.SortReversed
+	m.filterText = prefs.FilterText
+}
+
+// savePreferences persists the current sort and filter settings. Failures
+// are ignored; the settings simply do not survive a restart.
+func (m *WorktreesModel) savePreferences() {
+	if m.preferencesPath == "" {
+		return
 	}
+	SaveWorktreePreferences(m.preferencesPath, WorktreePreferences{
+		SortMode:     m.sortMode,
+		SortReversed: m.sortReversed,
+		FilterText:   m.filterText,
+	})
 }
 
 func (m *WorktreesModel) Init() tea.Cmd {
@@ -364,59 +580,24 @@ func (m *WorktreesModel) Init() tea.Cmd {
 
 // New methods for enhanced worktree functionality
 
-// toggleSelectionMode toggles between single and multi-selection mode
-func (m *WorktreesModel) toggleSelectionMode() {
-	m.selectionMode = !m.selectionMode
-	if !m.selectionMode {
-		// Clear all selections when exiting selection mode
-		m.selectedItems = make(map[int]bool)
-	}
-}
-
 // toggleItemSelection toggles selection state of item at given index
 func (m *WorktreesModel) toggleItemSelection(index int) {
-	if !m.selectionMode {
-		return
-	}
-
-	if len(m.filteredIndices) > 0 && index < len(m.filteredIndices) {
-		realIndex := m.filteredIndices[index]
-		m.selectedItems[realIndex] = !m.selectedItems[realIndex]
-	} else if index < len(m.worktrees) {
-		m.selectedItems[index] = !m.selectedItems[index]
+	indices := m.getVisibleIndices()
+	if index < len(indices) {
+		m.toggleSelected(indices[index])
 	}
 }
 
 // toggleSelectAll selects or deselects all visible items
 func (m *WorktreesModel) toggleSelectAll() {
-	if !m.selectionMode {
-		return
-	}
-
-	// Check if all visible items are selected
-	allSelected := true
-	indices := m.getVisibleIndices()
-
-	for _, idx := range indices {
-		if !m.selectedItems[idx] {
-			allSelected = false
-			break
-		}
-	}
-
-	// Toggle: if all selected, deselect all; otherwise select all
-	for _, idx := range indices {
-		m.selectedItems[idx] = !allSelected
-	}
+	m.toggleSelectAllOf(m.getVisibleIndices())
 }
 
 // getSelectedWorktrees returns currently selected worktrees
 func (m *WorktreesModel) getSelectedWorktrees() []WorktreeInfo {
 	var selected []WorktreeInfo
-	for idx, isSelected := range m.selectedItems {
-		if isSelected && idx < len(m.worktrees) {
-			selected = append(selected, m.worktrees[idx])
-		}
+	for _, idx := range m.selectedIndices(len(m.worktrees)) {
+		selected = append(selected, m.worktrees[idx])
 	}
 	return selected
 }
@@ -435,101 +616,73 @@ func (m *WorktreesModel) getCurrentWorktree() *WorktreeInfo {
 
 // getVisibleIndices returns indices of currently visible worktrees
 func (m *WorktreesModel) getVisibleIndices() []int {
-	if len(m.filteredIndices) > 0 {
-		return m.filteredIndices
-	}
-
-	indices := make([]int, len(m.worktrees))
-	for i := range indices {
-		indices[i] = i
-	}
-	return indices
+	return m.visibleIndices(len(m.worktrees))
 }
 
-// applyFilter filters worktrees based on current filter text
+// applyFilter filters worktrees based on current filter text, matching
+// their path, branch name and repository
 func (m *WorktreesModel) applyFilter() {
-	m.filteredIndices = []int{}
-
-	if m.filterText == "" {
-		// No filter, show all
-		return
-	}
-
-	filterLower := strings.ToLower(m.filterText)
-	for i, wt := range m.worktrees {
-		// Search in path, branch name, and repository
-		if strings.Contains(strings.ToLower(wt.Path), filterLower) ||
-			strings.Contains(strings.ToLower(wt.Branch), filterLower) ||
-			strings.Contains(strings.ToLower(wt.Repository), filterLower) {
-			m.filteredIndices = append(m.filteredIndices, i)
-		}
-	}
+	m.filterItems(len(m.worktrees), func(i int, matches func(string) bool) bool {
+		wt := m.worktrees[i]
+		return matches(wt.Path) || matches(wt.Branch) || matches(wt.Repository)
+	})
 
 	// Reset cursor if it's out of bounds
-	if m.cursor >= len(m.filteredIndices) {
-		m.cursor = len(m.filteredIndices) - 1
-	}
-	if m.cursor < 0 {
-		m.cursor = 0
+	if visible := len(m.getVisibleIndices()); m.cursor >= visible {
+		m.cursor = max(visible-1, 0)
 	}
 }
 
 // sortWorktrees sorts the worktree list according to current sort mode
 func (m *WorktreesModel) sortWorktrees() {
+	var less func(a, b WorktreeInfo) bool
+
 	switch m.sortMode {
 	case SortByName:
 		// Sort by path (name)
-		for i := 0; i < len(m.worktrees)-1; i++ {
-			for j := i + 1; j < len(m.worktrees); j++ {
-				if strings.ToLower(m.worktrees[i].Path) > strings.ToLower(m.worktrees[j].Path) {
-					m.worktrees[i], m.worktrees[j] = m.worktrees[j], m.worktrees[i]
-				}
-			}
+		less = func(a, b WorktreeInfo) bool {
+			return strings.ToLower(a.Path) < strings.ToLower(b.Path)
 		}
 	case SortByLastAccess:
 		// Sort by last access time (most recent first)
-		for i := 0; i < len(m.worktrees)-1; i++ {
-			for j := i + 1; j < len(m.worktrees); j++ {
-				if m.worktrees[i].LastAccess.Before(m.worktrees[j].LastAccess) {
-					m.worktrees[i], m.worktrees[j] = m.worktrees[j], m.worktrees[i]
-				}
-			}
+		less = func(a, b WorktreeInfo) bool {
+			return a.LastAccess.After(b.LastAccess)
 		}
 	case SortByBranch:
 		// Sort by branch name
-		for i := 0; i < len(m.worktrees)-1; i++ {
-			for j := i + 1; j < len(m.worktrees); j++ {
-				if strings.ToLower(m.worktrees[i].Branch) > strings.ToLower(m.worktrees[j].Branch) {
-					m.worktrees[i], m.worktrees[j] = m.worktrees[j], m.worktrees[i]
-				}
-			}
+		less = func(a, b WorktreeInfo) bool {
+			return strings.ToLower(a.Branch) < strings.ToLower(b.Branch)
 		}
 	case SortByStatus:
-		// Sort by Claude status and git status
-		for i := 0; i < len(m.worktrees)-1; i++ {
-			for j := i + 1; j < len(m.worktrees); j++ {
-				// Primary sort: Claude status (busy > idle > error)
-				statusPriority := func(status string) int {
-					switch status {
-					case "busy":
-						return 3
-					case "idle":
-						return 2
-					case "waiting":
-						return 1
-					default:
-						return 0
-					}
-				}
+		// Sort by Claude status (busy > idle > waiting > other)
+		less = func(a, b WorktreeInfo) bool {
+			return claudeStatusPriority(a.ClaudeStatus.State) > claudeStatusPriority(b.ClaudeStatus.State)
+		}
+	default:
+		return
+	}
 
-				iPriority := statusPriority(m.worktrees[i].ClaudeStatus.State)
-				jPriority := statusPriority(m.worktrees[j].ClaudeStatus.State)
+	if m.sortReversed {
+		forward := less
+		less = func(a, b WorktreeInfo) bool { return forward(b, a) }
+	}
 
-				if iPriority < jPriority {
-					m.worktrees[i], m.worktrees[j] = m.worktrees[j], m.worktrees[i]
-				}
-			}
-		}
+	sort.SliceStable(m.worktrees, func(i, j int) bool {
+		return less(m.worktrees[i], m.worktrees[j])
+	})
+}
+
+// claudeStatusPriority ranks Claude states for SortByStatus
+func claudeStatusPriority(state string) int {
+	switch state {
+	case "busy":
+		return 3
+	case "idle":
+		return 2
+	case "waiting":
+		return 1
+	default:
+		return 0
 	}
 }
 
@@ -538,6 +691,15 @@ func (m *WorktreesModel) cycleSortMode() {
 	m.sortMode = (m.sortMode + 1) % 4
 	m.sortWorktrees()
 	m.applyFilter() // Reapply filter after sorting
+	m.savePreferences()
+}
+
+// toggleSortReversed reverses the direction of the current sort mode
+func (m *WorktreesModel) toggleSortReversed() {
+	m.sortReversed = !m.sortReversed
+	m.sortWorktrees()
+	m.applyFilter() // Reapply filter after sorting
+	m.savePreferences()
 }
 
 // refreshWorktreeData refreshes worktree data and applies current sorting/filtering
@@ -548,45 +710,27 @@ func (m *WorktreesModel) refreshWorktreeData() {
 	m.applyFilter()
 }
 
-// refreshClaudeStatuses updates Claude status information for all worktrees
+// refreshClaudeStatuses fills in the Claude status of worktrees that have
+// none yet. WorktreeInfo.ClaudeStatus is the only status the screen sorts and
+// renders by; a worktree without a reported process is "unknown", as the
+// integration's realtime updates mark it, rather than idle.
 func (m *WorktreesModel) refreshClaudeStatuses() {
-	// This would query the actual Claude processes for each worktree
-	// For now, we'll update the statuses in the worktree info directly
 	for i := range m.worktrees {
-		// In a real implementation, this would check for Claude processes
-		// running in each worktree directory
 		if m.worktrees[i].ClaudeStatus.State == "" {
 			m.worktrees[i].ClaudeStatus = ClaudeStatus{
-				State:      "idle",
-				ProcessID:  0,
+				State:      "unknown",
 				LastUpdate: time.Now(),
-				SessionID:  "",
 			}
 		}
 	}
 }
 
-// enterSearchMode enables search input mode
-func (m *WorktreesModel) enterSearchMode() {
-	m.searchMode = true
-}
-
-// exitSearchMode disables search input mode
-func (m *WorktreesModel) exitSearchMode() {
-	m.searchMode = false
-}
-
-// handleSearchInput processes search input characters
-func (m *WorktreesModel) handleSearchInput(char string) {
-	m.filterText += char
-	m.applyFilter()
-}
-
 // clearSearch clears the current search filter
 func (m *WorktreesModel) clearSearch() {
 	m.filterText = ""
 	m.filteredIndices = []int{}
 	m.cursor = 0
+	m.savePreferences()
 }
 
 func (m *WorktreesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -597,28 +741,22 @@ func (m *WorktreesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		// Handle search mode input
 		if m.searchMode {
-			switch msg.String() {
-			case "esc":
-				m.exitSearchMode()
-			case "enter":
-				m.exitSearchMode()
-			case "backspace":
-				if len(m.filterText) > 0 {
-					m.filterText = m.filterText[:len(m.filterText)-1]
-					m.applyFilter()
-				}
-			case "ctrl+c":
-				m.clearSearch()
-				m.exitSearchMode()
-			default:
-				// Add character to search
-				if len(msg.String()) == 1 && msg.String() >= " " && msg.String() <= "~" {
-					m.handleSearchInput(msg.String())
-				}
+			changed, exited := m.editSearch(msg.String())
+			if changed {
+				m.applyFilter()
+			}
+			if exited {
+				// Remember the filter
+				m.savePreferences()
 			}
 			return m, nil
 		}
 
+		// Handle details panel input
+		if m.inspectMode {
+			return m, m.handleInspectKey(msg.String())
+		}
+
 		// Normal mode keyboard handling
 		switch msg.String() {
 		case "up", "k":
@@ -635,6 +773,11 @@ func (m *WorktreesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if wt := m.getCurrentWorktree(); wt != nil {
 				return m, m.integration.OpenWorktree(wt.Path)
 			}
+		case "i":
+			// Inspect current worktree
+			if m.getCurrentWorktree() != nil {
+				m.inspectMode = true
+			}
 		case "n":
 			// New session for current/selected worktrees
 			return m, m.createNewSessionForSelection()
@@ -656,6 +799,9 @@ func (m *WorktreesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "s":
 			// Cycle through sort modes
 			m.cycleSortMode()
+		case "S":
+			// Reverse the sort direction
+			m.toggleSortReversed()
 		case "tab":
 			// Toggle selection mode
 			m.toggleSelectionMode()
@@ -681,6 +827,34 @@ func (m *WorktreesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleInspectKey handles key presses while the details panel is open. Actions
+// apply to the inspected worktree only, regardless of multi-selection.
+func (m *WorktreesModel) handleInspectKey(key string) tea.Cmd {
+	wt := m.getCurrentWorktree()
+	if wt == nil {
+		m.inspectMode = false
+		return nil
+	}
+	worktrees := []WorktreeInfo{*wt}
+
+	switch key {
+	case "esc", "i", "q":
+		m.inspectMode = false
+	case "enter":
+		return m.integration.OpenWorktree(wt.Path)
+	case "n":
+		return func() tea.Msg { return NewSessionRequestedMsg{Worktrees: worktrees} }
+	case "c":
+		return func() tea.Msg { return ContinueSessionRequestedMsg{Worktrees: worktrees} }
+	case "r":
+		return func() tea.Msg { return ResumeSessionRequestedMsg{Worktrees: worktrees} }
+	case "d":
+		m.inspectMode = false
+		return func() tea.Msg { return DeleteWorktreeRequestedMsg{Worktree: *wt} }
+	}
+	return nil
+}
+
 // Session workflow commands (to be implemented in step 3)
 
 func (m *WorktreesModel) createNewSessionForSelection() tea.Cmd {
@@ -734,6 +908,12 @@ func (m *WorktreesModel) View() string {
 		return "Loading worktrees..."
 	}
 
+	if m.inspectMode {
+		if wt := m.getCurrentWorktree(); wt != nil {
+			return m.renderInspectPanel(*wt)
+		}
+	}
+
 	// Build header with mode indicators
 	headerText := "🌳 Worktree Selection"
 	if m.selectionMode {
@@ -742,11 +922,18 @@ func (m *WorktreesModel) View() string {
 	}
 	if m.filterText != "" {
 		headerText += fmt.Sprintf(" [FILTER: %s]", m.filterText)
+		if m.filterInvalid {
+			headerText += m.theme.MutedStyle.Render(" invalid regex")
+		}
 	}
 
 	// Add sort mode indicator
 	sortNames := []string{"Name", "Last Access", "Branch", "Status"}
-	headerText += fmt.Sprintf(" [SORT: %s]", sortNames[m.sortMode])
+	sortDirection := "↓"
+	if m.sortReversed {
+		sortDirection = "↑"
+	}
+	headerText += fmt.Sprintf(" [SORT: %s %s]", sortNames[m.sortMode], sortDirection)
 
 	header := m.theme.HeaderStyle.Render(headerText)
 
@@ -764,6 +951,8 @@ func (m *WorktreesModel) View() string {
 		)
 	}
 
+	cols := layoutWorktreeColumns(m.width - m.theme.ContentStyle.GetHorizontalFrameSize())
+
 	var worktreeLines []string
 	for i, idx := range indices {
 		wt := m.worktrees[idx]
@@ -802,35 +991,12 @@ func (m *WorktreesModel) View() string {
 			statusColor = m.theme.Error
 		}
 
-		// Session count indicator
-		sessionCount := len(wt.ActiveSessions)
-		sessionIndicator := ""
-		if sessionCount > 0 {
-			sessionIndicator = fmt.Sprintf(" [%d]", sessionCount)
-		}
-
-		// Git status indicator
-		gitIndicator := ""
-		if !wt.GitStatus.IsClean {
-			changes := wt.GitStatus.Modified + wt.GitStatus.Staged + wt.GitStatus.Untracked
-			if changes > 0 {
-				gitIndicator = fmt.Sprintf(" +%d", changes)
-			}
-		}
-		if wt.GitStatus.Ahead > 0 || wt.GitStatus.Behind > 0 {
-			gitIndicator += fmt.Sprintf(" ↑%d↓%d", wt.GitStatus.Ahead, wt.GitStatus.Behind)
-		}
-
 		// Format the line
-		line := fmt.Sprintf("%s%s %s %s (%s)%s%s - %s",
+		line := fmt.Sprintf("%s%s %s %s",
 			cursor,
 			selection,
 			lipgloss.NewStyle().Foreground(statusColor).Render(statusIcon),
-			wt.Path,
-			wt.Branch,
-			sessionIndicator,
-			gitIndicator,
-			wt.LastAccess.Format("Jan 2 15:04"),
+			formatWorktreeColumns(wt, cols),
 		)
 
 		// Apply highlighting for current item
@@ -857,7 +1023,7 @@ func (m *WorktreesModel) View() string {
 
 		// Key shortcuts
 		shortcuts := []string{
-			"n:New", "c:Continue", "r:Resume",
+			"i:Inspect", "n:New", "c:Continue", "r:Resume",
 		}
 		if !m.selectionMode {
 			shortcuts = append(shortcuts, "Space:Select", "Tab:Multi-mode")
@@ -878,6 +1044,88 @@ func (m *WorktreesModel) View() string {
 	)
 }
 
+// renderInspectPanel renders the full details of a single worktree
+func (m *WorktreesModel) renderInspectPanel(wt WorktreeInfo) string {
+	header := m.theme.HeaderStyle.Render("🔍 Worktree Details")
+
+	label := func(name string) string {
+		return m.theme.LabelStyle.Render(fmt.Sprintf("%-12s", name))
+	}
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return "never"
+		}
+		return t.Format("Jan 2 15:04")
+	}
+
+	var lines []string
+	lines = append(lines,
+		label("Path:")+wt.Path,
+		label("Branch:")+worktreeBranch(wt),
+	)
+	if wt.Repository != "" {
+		lines = append(lines, label("Repository:")+wt.Repository)
+	}
+	lines = append(lines, label("Last access:")+formatTime(wt.LastAccess))
+
+	// Git status
+	git := wt.GitStatus
+	gitState := m.theme.SuccessStyle.Render("clean")
+	if !git.IsClean {
+		gitState = m.theme.WarningStyle.Render("dirty")
+	}
+	if wt.Detached {
+		gitState = m.theme.WarningStyle.Render("detached")
+	}
+	lines = append(lines,
+		"",
+		m.theme.TitleStyle.Render("Git"),
+		label("State:")+gitState,
+		label("Upstream:")+fmt.Sprintf("↑%d ↓%d", git.Ahead, git.Behind),
+		label("Changes:")+fmt.Sprintf("%d staged, %d modified, %d untracked, %d conflicted",
+			git.Staged, git.Modified, git.Untracked, git.Conflicted),
+	)
+	if git.LastCommit != "" {
+		lines = append(lines, label("Last commit:")+fmt.Sprintf("%s (%s)", git.LastCommit, formatTime(git.LastCommitAt)))
+	}
+
+	// Claude process
+	claudeState := wt.ClaudeStatus.State
+	if claudeState == "" {
+		claudeState = "not running"
+	}
+	lines = append(lines,
+		"",
+		m.theme.TitleStyle.Render("Claude"),
+		label("State:")+claudeState,
+	)
+	if wt.ClaudeStatus.ProcessID > 0 {
+		lines = append(lines, label("PID:")+fmt.Sprintf("%d", wt.ClaudeStatus.ProcessID))
+	}
+	if wt.ClaudeStatus.SessionID != "" {
+		lines = append(lines, label("Session:")+wt.ClaudeStatus.SessionID)
+	}
+
+	// Sessions
+	lines = append(lines, "", m.theme.TitleStyle.Render(fmt.Sprintf("Sessions (%d)", len(wt.ActiveSessions))))
+	if len(wt.ActiveSessions) == 0 {
+		lines = append(lines, m.theme.MutedStyle.Render("  No active sessions"))
+	}
+	for _, session := range wt.ActiveSessions {
+		lines = append(lines, fmt.Sprintf("  %s [%s] - %s", session.Name, session.State, formatTime(session.LastUsed)))
+	}
+
+	statusBar := m.theme.StatusStyle.Render("n:New c:Continue r:Resume d:Delete Enter:Open Esc:Back")
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		"",
+		m.theme.ContentStyle.Render(strings.Join(lines, "\n")),
+		"",
+		statusBar,
+	)
+}
+
 func (m *WorktreesModel) Title() string {
 	return "Worktrees"
 }
@@ -886,15 +1134,28 @@ func (m *WorktreesModel) Help() []string {
 	if m.searchMode {
 		return []string{
 			"Type to search",
+			"/pattern: Regular expression",
 			"Enter/Esc: Exit search",
 			"Backspace: Delete character",
 			"Ctrl+C: Clear and exit",
 		}
 	}
 
+	if m.inspectMode {
+		return []string{
+			"n: New session",
+			"c: Continue session",
+			"r: Resume session",
+			"d: Delete worktree",
+			"Enter: Open worktree",
+			"Esc/i: Close details",
+		}
+	}
+
 	helpItems := []string{
 		"↑/k, ↓/j: Navigate",
 		"Enter: Open worktree",
+		"i: Inspect worktree",
 		"n: New session",
 		"c: Continue session",
 		"r: Resume session",
@@ -916,6 +1177,7 @@ func (m *WorktreesModel) Help() []string {
 	helpItems = append(helpItems, []string{
 		"/: Search/filter",
 		"s: Cycle sort mode",
+		"S: Reverse sort order",
 		"Esc: Clear filter/exit mode",
 	}...)
 
@@ -924,16 +1186,29 @@ func (m *WorktreesModel) Help() []string {
 
 // ConfigModel represents the configuration screen
 type ConfigModel struct {
-	config *config.Config
-	theme  Theme
-	width  int
-	height int
+	config     *config.Config
+	theme      Theme
+	width      int
+	height     int
+	configPath string                                               // File edited
+	load       func(current *config.Config) (*config.Config, error) // Reloads and validates the config
+	status     string                                               // Result of the last edit or reload
 }
 
-func NewConfigModel(config *config.Config, theme Theme) *ConfigModel {
+func NewConfigModel(cfg *config.Config, theme Theme) *ConfigModel {
+	path := cfg.ConfigFile
+	if path == "" {
+		path = cfg.EditablePath()
+	}
+	if path == "" {
+		path = config.GetGlobalConfigPath()
+	}
+
 	return &ConfigModel{
-		config: config,
-		theme:  theme,
+		config:     cfg,
+		theme:      theme,
+		configPath: path,
+		load:       (*config.Config).Reload,
 	}
 }
 
@@ -946,10 +1221,69 @@ func (m *ConfigModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "e":
+			return m, m.editConfig()
+		case "r":
+			return m, m.reloadConfig()
+		}
+	case configEditedMsg:
+		if msg.Err != nil {
+			m.status = "Edit failed"
+			return m, showError("Edit Failed", fmt.Sprintf("Could not run editor: %v", msg.Err))
+		}
+		return m, m.reloadConfig()
+	case ConfigReloadedMsg:
+		if msg.Err != nil {
+			// Keep showing the last valid configuration
+			m.status = "Reload failed, showing previous configuration"
+			return m, showError("Invalid Configuration", msg.Err.Error())
+		}
+		msg.Config.ConfigFile = m.configPath
+		m.config = msg.Config
+		logging.SetLevel(m.config.LogLevel)
+		m.status = "Configuration reloaded"
 	}
 	return m, nil
 }
 
+// reloadConfig loads the configuration again the way it was loaded at
+// startup, with the same --config file, profile and environment
+func (m *ConfigModel) reloadConfig() tea.Cmd {
+	current, load := m.config, m.load
+	return func() tea.Msg {
+		cfg, err := load(current)
+		return ConfigReloadedMsg{Config: cfg, Err: err}
+	}
+}
+
+// editConfig suspends the TUI and opens the config file in $VISUAL or
+// $EDITOR, falling back to vi. The file is reloaded when the editor exits.
+func (m *ConfigModel) editConfig() tea.Cmd {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// Editors are often configured with arguments, e.g. "code --wait"
+	args := append(strings.Fields(editor), m.configPath)
+	cmd := exec.Command(args[0], args[1:]...)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return configEditedMsg{Err: err}
+	})
+}
+
+// showError returns a command asking the app to show an error modal
+func showError(title, message string) tea.Cmd {
+	return func() tea.Msg {
+		return ShowErrorMsg{Title: title, Message: message}
+	}
+}
+
 func (m *ConfigModel) View() string {
 	if m.width == 0 {
 		return "Loading configuration..."
@@ -963,18 +1297,19 @@ func (m *ConfigModel) View() string {
 			"Claude Enabled: %t\n"+
 			"TUI Theme: %s\n"+
 			"Auto Refresh: %ds",
-		m.config.ConfigFile,
+		m.configPath,
 		m.config.LogLevel,
 		m.config.Claude.Enabled,
 		m.config.TUI.Theme,
 		m.config.RefreshInterval,
 	)
 
-	return lipgloss.JoinVertical(lipgloss.Left,
-		header,
-		"",
-		m.theme.ContentStyle.Render(content),
-	)
+	sections := []string{header, "", m.theme.ContentStyle.Render(content)}
+	if m.status != "" {
+		sections = append(sections, m.theme.MutedStyle.Render(m.status))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
 func (m *ConfigModel) Title() string {
@@ -1039,7 +1374,10 @@ func (m *HelpModel) View() string {
 		"↑/k, ↓/j: Navigate",
 		"Enter: Attach to session",
 		"n: Create new session",
-		"d: Delete session",
+		"d: Kill session(s)",
+		"r: Resume/restart session",
+		"Tab: Multi-select, Space: Toggle, a: Select all",
+		"/: Search/filter",
 		"",
 		m.theme.TitleStyle.Render("Worktrees:"),
 		"↑/k, ↓/j: Navigate",
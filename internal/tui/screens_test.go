@@ -0,0 +1,621 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+func newInspectTestModel() *WorktreesModel {
+	m := NewWorktreesModel(nil, DefaultTheme())
+	m.width = 120
+	m.height = 40
+	m.worktrees = []WorktreeInfo{
+		{
+			Path:       "/repo/worktrees/feature-auth",
+			Branch:     "feature/auth",
+			LastAccess: time.Now(),
+			ActiveSessions: []SessionSummary{
+				{ID: "ccmgr-repo-feature_auth-main", Name: "ccmgr-repo-feature_auth-main", State: "active"},
+				{ID: "ccmgr-repo-feature_auth-debug", Name: "ccmgr-repo-feature_auth-debug", State: "idle"},
+			},
+			ClaudeStatus: ClaudeStatus{State: "busy", ProcessID: 4242},
+			GitStatus: GitWorktreeStatus{
+				Ahead:      2,
+				Behind:     1,
+				Staged:     3,
+				Modified:   4,
+				Untracked:  5,
+				Conflicted: 1,
+				LastCommit: "Add login form",
+			},
+		},
+	}
+	return m
+}
+
+func TestWorktreesModel_InspectPanel(t *testing.T) {
+	m := newInspectTestModel()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	assert.Nil(t, cmd)
+	require.True(t, m.inspectMode)
+
+	view := m.View()
+	assert.Contains(t, view, "Worktree Details")
+	assert.Contains(t, view, "/repo/worktrees/feature-auth")
+	assert.Contains(t, view, "Sessions (2)")
+	assert.Contains(t, view, "ccmgr-repo-feature_auth-main")
+	assert.Contains(t, view, "ccmgr-repo-feature_auth-debug")
+	assert.Contains(t, view, "↑2 ↓1")
+	assert.Contains(t, view, "3 staged, 4 modified, 5 untracked, 1 conflicted")
+	assert.Contains(t, view, "Add login form")
+	assert.Contains(t, view, "busy")
+	assert.Contains(t, view, "4242")
+
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.inspectMode)
+	assert.NotContains(t, m.View(), "Worktree Details")
+}
+
+func TestWorktreesModel_InspectPanelActions(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		expected tea.Msg
+	}{
+		{name: "new session", key: "n", expected: NewSessionRequestedMsg{}},
+		{name: "continue session", key: "c", expected: ContinueSessionRequestedMsg{}},
+		{name: "resume session", key: "r", expected: ResumeSessionRequestedMsg{}},
+		{name: "delete worktree", key: "d", expected: DeleteWorktreeRequestedMsg{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newInspectTestModel()
+			m.inspectMode = true
+
+			_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+			require.NotNil(t, cmd)
+
+			msg := cmd()
+			assert.IsType(t, tt.expected, msg)
+
+			switch msg := msg.(type) {
+			case NewSessionRequestedMsg:
+				require.Len(t, msg.Worktrees, 1)
+				assert.Equal(t, "feature/auth", msg.Worktrees[0].Branch)
+			case DeleteWorktreeRequestedMsg:
+				assert.Equal(t, "feature/auth", msg.Worktree.Branch)
+				assert.False(t, m.inspectMode)
+			}
+		})
+	}
+}
+
+func newSessionsTestModel(sessions ...SessionInfo) (*SessionsModel, *fakeTmuxManager) {
+	tmuxMgr := &fakeTmuxManager{}
+	integration := &Integration{tmuxMgr: tmuxMgr, sessions: sessions}
+	m := NewSessionsModel(integration, DefaultTheme())
+	m.width = 120
+	m.Update(RefreshDataMsg{})
+	return m, tmuxMgr
+}
+
+func TestSessionsModel_KillRequestsSelectedSession(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "ccmgr-app-main", Name: "ccmgr-app-main", Active: true},
+		SessionInfo{ID: "ccmgr-app-auth", Name: "ccmgr-app-auth", Active: true},
+	)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(KillSessionsRequestedMsg)
+	require.True(t, ok)
+	assert.Equal(t, []string{"ccmgr-app-auth"}, sessionIDs(msg.Sessions))
+}
+
+func TestSessionsModel_SelectAll(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "one", Name: "one"},
+		SessionInfo{ID: "two", Name: "two"},
+		SessionInfo{ID: "three", Name: "three"},
+	)
+	selectAll := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}
+
+	m.Update(selectAll)
+	assert.Empty(t, m.getSelectedSessions(), "select all needs multi-select mode")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m.Update(selectAll)
+	assert.Equal(t, []string{"one", "two", "three"}, sessionIDs(m.getSelectedSessions()))
+
+	m.Update(selectAll)
+	assert.Empty(t, m.getSelectedSessions(), "select all again deselects everything")
+
+	m.Update(selectAll)
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.selectionMode)
+	assert.Empty(t, m.getSelectedSessions(), "leaving multi-select clears the selection")
+}
+
+func TestSessionsModel_BulkKill(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "one", Name: "one"},
+		SessionInfo{ID: "two", Name: "two"},
+		SessionInfo{ID: "three", Name: "three"},
+	)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+	assert.Contains(t, m.View(), "2 selected")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(KillSessionsRequestedMsg)
+	require.True(t, ok)
+	assert.Equal(t, []string{"one", "three"}, sessionIDs(msg.Sessions))
+
+	// Selections follow their sessions when the list changes
+	m.integration.sessions = []SessionInfo{{ID: "three", Name: "three"}, {ID: "two", Name: "two"}}
+	m.Update(RefreshDataMsg{})
+	assert.Equal(t, []string{"three"}, sessionIDs(m.getSelectedSessions()))
+}
+
+func TestSessionsModel_BulkAttachInWindows(t *testing.T) {
+	m, tmuxMgr := newSessionsTestModel(
+		SessionInfo{ID: "one", Name: "one"},
+		SessionInfo{ID: "two", Name: "two"},
+	)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Equal(t, SessionsOpenedMsg{SessionIDs: []string{"one", "two"}}, cmd())
+	assert.Equal(t, []string{"one", "two"}, tmuxMgr.windows)
+}
+
+func TestSessionsModel_EmptyList(t *testing.T) {
+	m, _ := newSessionsTestModel()
+
+	for _, key := range []string{"d", "r", "enter"} {
+		var msg tea.KeyMsg
+		if key == "enter" {
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		} else {
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		_, cmd := m.Update(msg)
+		assert.Nil(t, cmd, "%s does nothing without sessions", key)
+	}
+}
+
+func TestSessionsModel_RestartStoppedSession(t *testing.T) {
+	m, tmuxMgr := newSessionsTestModel(SessionInfo{
+		ID:        "ccmgr-app-main",
+		Name:      "ccmgr-app-main",
+		Project:   "app",
+		Branch:    "main",
+		Directory: "/work/app",
+	})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	require.NotNil(t, cmd)
+	assert.Equal(t, SessionResumedMsg{SessionID: "ccmgr-app-main", Success: true}, cmd())
+
+	// Claude Code is restarted inside the existing session, not a new one
+	assert.Equal(t, []string{"ccmgr-app-main"}, tmuxMgr.started)
+	assert.Empty(t, tmuxMgr.created)
+}
+
+func TestSessionsModel_RefreshClampsCursor(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "one", Name: "one"},
+		SessionInfo{ID: "two", Name: "two"},
+	)
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	require.Equal(t, 1, m.cursor)
+
+	m.integration.sessions = m.integration.sessions[:1]
+	m.Update(RefreshDataMsg{})
+	assert.Equal(t, 0, m.cursor)
+}
+
+func TestSessionsModel_FilterByProject(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "ccmgr-api-main", Name: "ccmgr-api-main", Project: "api", Branch: "main", Directory: "/work/api"},
+		SessionInfo{ID: "ccmgr-web-main", Name: "ccmgr-web-main", Project: "web", Branch: "main", Directory: "/work/web"},
+		SessionInfo{ID: "ccmgr-web-auth", Name: "ccmgr-web-auth", Project: "web", Branch: "feature/auth", Directory: "/work/web-auth"},
+	)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	require.True(t, m.searchMode)
+	for _, r := range "web" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, m.searchMode)
+
+	assert.Equal(t, []int{1, 2}, m.getVisibleIndices())
+	view := m.View()
+	assert.Contains(t, view, "[FILTER: web]")
+	assert.NotContains(t, view, "ccmgr-api-main")
+
+	// Actions apply to the filtered list
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	require.NotNil(t, cmd)
+	msg := cmd().(KillSessionsRequestedMsg)
+	assert.Equal(t, []string{"ccmgr-web-auth"}, sessionIDs(msg.Sessions))
+
+	// Select all only selects matching sessions
+	m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	assert.Equal(t, []string{"ccmgr-web-main", "ccmgr-web-auth"}, sessionIDs(m.getSelectedSessions()))
+}
+
+func TestSessionsModel_FilterMatchesBranchAndDirectory(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "one", Name: "one", Branch: "feature/auth", Directory: "/work/app"},
+		SessionInfo{ID: "two", Name: "two", Branch: "main", Directory: "/srv/auth-service"},
+		SessionInfo{ID: "three", Name: "three", Branch: "main", Directory: "/work/app"},
+	)
+
+	m.filterText = "AUTH"
+	m.applyFilter()
+	assert.Equal(t, []int{0, 1}, m.filteredIndices)
+
+	m.filterText = "/^feature/"
+	m.applyFilter()
+	assert.Equal(t, []int{0}, m.filteredIndices)
+	assert.False(t, m.filterInvalid)
+}
+
+func TestSessionsModel_ClearFilter(t *testing.T) {
+	m, _ := newSessionsTestModel(
+		SessionInfo{ID: "ccmgr-api-main", Name: "ccmgr-api-main", Project: "api"},
+		SessionInfo{ID: "ccmgr-web-main", Name: "ccmgr-web-main", Project: "web"},
+	)
+	search := func(text string, keys ...tea.KeyMsg) {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+		for _, r := range text {
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+		for _, key := range keys {
+			m.Update(key)
+		}
+	}
+
+	t.Run("backspace", func(t *testing.T) {
+		search("xz")
+		assert.Contains(t, m.View(), "No sessions match filter: xz")
+
+		m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		assert.Equal(t, "", m.filterText)
+		assert.Len(t, m.getVisibleIndices(), 2)
+		m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	})
+
+	t.Run("esc after searching", func(t *testing.T) {
+		search("api", tea.KeyMsg{Type: tea.KeyEsc})
+		require.False(t, m.searchMode)
+		assert.Len(t, m.getVisibleIndices(), 1, "esc keeps the filter when leaving search")
+
+		m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		assert.Equal(t, "", m.filterText)
+		assert.Len(t, m.getVisibleIndices(), 2)
+	})
+
+	t.Run("ctrl+c while searching", func(t *testing.T) {
+		search("web", tea.KeyMsg{Type: tea.KeyCtrlC})
+		assert.False(t, m.searchMode)
+		assert.Equal(t, "", m.filterText)
+		assert.Len(t, m.getVisibleIndices(), 2)
+	})
+}
+
+// newSortTestModel builds 50 worktrees in a scrambled order. Repository holds
+// each worktree's original position so stability can be checked.
+func newSortTestModel() *WorktreesModel {
+	states := []string{"idle", "busy", "", "waiting", "error"}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := NewWorktreesModel(nil, DefaultTheme())
+	for i := 0; i < 50; i++ {
+		n := (i * 37) % 50
+		m.worktrees = append(m.worktrees, WorktreeInfo{
+			Path:         fmt.Sprintf("/repo/Worktree-%02d", n),
+			Branch:       fmt.Sprintf("feature/%d", n%7),
+			Repository:   fmt.Sprintf("%02d", i),
+			LastAccess:   base.Add(time.Duration(n%10) * time.Hour),
+			ClaudeStatus: ClaudeStatus{State: states[n%len(states)]},
+		})
+	}
+	return m
+}
+
+func TestWorktreesModel_SortWorktrees(t *testing.T) {
+	tests := []struct {
+		name string
+		mode WorktreeSortMode
+		// key returns the sort key; keys must be non-decreasing after sorting
+		key func(wt WorktreeInfo) string
+	}{
+		{"name", SortByName, func(wt WorktreeInfo) string { return strings.ToLower(wt.Path) }},
+		{"last access", SortByLastAccess, func(wt WorktreeInfo) string {
+			// Most recent first; all accesses fall within one day
+			return fmt.Sprint(9 - wt.LastAccess.Hour())
+		}},
+		{"branch", SortByBranch, func(wt WorktreeInfo) string { return strings.ToLower(wt.Branch) }},
+		{"status", SortByStatus, func(wt WorktreeInfo) string {
+			return fmt.Sprint(3 - claudeStatusPriority(wt.ClaudeStatus.State))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newSortTestModel()
+			m.sortMode = tt.mode
+			m.sortWorktrees()
+
+			require.Len(t, m.worktrees, 50)
+			for i := 1; i < len(m.worktrees); i++ {
+				prev, curr := m.worktrees[i-1], m.worktrees[i]
+				require.LessOrEqual(t, tt.key(prev), tt.key(curr), "position %d out of order", i)
+				if tt.key(prev) == tt.key(curr) {
+					assert.Less(t, prev.Repository, curr.Repository, "equal keys at %d lost their original order", i)
+				}
+			}
+		})
+	}
+}
+
+func TestWorktreesModel_SortByStatusOrder(t *testing.T) {
+	m := NewWorktreesModel(nil, DefaultTheme())
+	for _, state := range []string{"error", "waiting", "busy", "", "idle"} {
+		m.worktrees = append(m.worktrees, WorktreeInfo{Path: state, ClaudeStatus: ClaudeStatus{State: state}})
+	}
+
+	m.sortMode = SortByStatus
+	m.sortWorktrees()
+
+	var states []string
+	for _, wt := range m.worktrees {
+		states = append(states, wt.ClaudeStatus.State)
+	}
+	assert.Equal(t, []string{"busy", "idle", "waiting", "error", ""}, states)
+}
+
+func TestWorktreesModel_SortMatchesRenderedStatus(t *testing.T) {
+	var worktrees []WorktreeInfo
+	for _, state := range []string{"error", "waiting", "busy", "", "idle", "unknown"} {
+		name := state
+		if name == "" {
+			name = "none"
+		}
+		worktrees = append(worktrees, WorktreeInfo{
+			Path:         "/work/" + name,
+			Branch:       name,
+			ClaudeStatus: ClaudeStatus{State: state},
+		})
+	}
+
+	m := NewWorktreesModel(&Integration{worktrees: worktrees}, DefaultTheme())
+	m.width = 120
+	m.height = 40
+	m.sortMode = SortByStatus
+	m.refreshWorktreeData()
+
+	icons := map[string]string{"busy": "●", "idle": "●", "waiting": "◐", "error": "✗", "none": "○", "unknown": "○"}
+	var rendered, sorted []string
+	for _, line := range strings.Split(m.View(), "\n") {
+		for name, icon := range icons {
+			if strings.Contains(line, "/work/"+name+" ") {
+				assert.Contains(t, line, icon, "%s is rendered with its status", name)
+				rendered = append(rendered, name)
+			}
+		}
+	}
+	for _, wt := range m.worktrees {
+		sorted = append(sorted, wt.Branch)
+	}
+
+	assert.Equal(t, []string{"busy", "idle", "waiting", "error", "none", "unknown"}, sorted)
+	assert.Equal(t, sorted, rendered, "rows are rendered in sort order")
+	assert.Equal(t, "unknown", m.worktrees[4].ClaudeStatus.State,
+		"a worktree without a status sorts and renders as unknown")
+}
+
+func TestWorktreesModel_SortReversed(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	states := []string{"busy", "idle", "waiting", ""}
+
+	newModel := func() *WorktreesModel {
+		m := NewWorktreesModel(nil, DefaultTheme())
+		for _, n := range []int{2, 0, 3, 1} {
+			m.worktrees = append(m.worktrees, WorktreeInfo{
+				Path:         fmt.Sprintf("/repo/wt-%d", n),
+				Branch:       fmt.Sprintf("feature/%d", n),
+				LastAccess:   base.Add(time.Duration(n) * time.Hour),
+				ClaudeStatus: ClaudeStatus{State: states[n]},
+			})
+		}
+		return m
+	}
+	paths := func(m *WorktreesModel) []string {
+		var result []string
+		for _, wt := range m.worktrees {
+			result = append(result, wt.Path)
+		}
+		return result
+	}
+
+	for _, mode := range []WorktreeSortMode{SortByName, SortByLastAccess, SortByBranch, SortByStatus} {
+		forward := newModel()
+		forward.sortMode = mode
+		forward.sortWorktrees()
+
+		reversed := newModel()
+		reversed.sortMode = mode
+		reversed.toggleSortReversed()
+		assert.True(t, reversed.sortReversed)
+
+		want := paths(forward)
+		for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+			want[i], want[j] = want[j], want[i]
+		}
+		assert.Equal(t, want, paths(reversed), "sort mode %d", mode)
+	}
+}
+
+func TestWorktreesModel_SortDirectionIndicator(t *testing.T) {
+	m := newInspectTestModel()
+	m.sortMode = SortByBranch
+	assert.Contains(t, m.View(), "[SORT: Branch ↓]")
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	m = updated.(*WorktreesModel)
+	assert.True(t, m.sortReversed)
+	assert.Contains(t, m.View(), "[SORT: Branch ↑]")
+	assert.Contains(t, m.Help(), "S: Reverse sort order")
+}
+
+func newFilterTestModel() *WorktreesModel {
+	m := NewWorktreesModel(nil, DefaultTheme())
+	m.width = 120
+	m.height = 40
+	m.worktrees = []WorktreeInfo{
+		{Path: "/repo/wt/feature-auth", Branch: "feature/auth"},
+		{Path: "/repo/wt/feature-api", Branch: "feature/api"},
+		{Path: "/repo/wt/hotfix-login", Branch: "hotfix/login"},
+		{Path: "/repo/wt/docs", Branch: "docs/Feature-guide"},
+	}
+	return m
+}
+
+func TestWorktreesModel_ApplyFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected []int
+		invalid  bool
+	}{
+		{"substring", "FEATURE", []int{0, 1, 3}, false},
+		{"regex anchored", "/^feature/", []int{0, 1}, false},
+		{"regex alternation", "/(auth|login)$", []int{0, 2}, false},
+		{"regex no match", "/^release", []int{}, false},
+		{"invalid regex falls back to substring", "/hotfix(", []int{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newFilterTestModel()
+			m.filterText = tt.filter
+			m.applyFilter()
+
+			assert.Equal(t, tt.expected, m.filteredIndices)
+			assert.Equal(t, tt.invalid, m.filterInvalid)
+		})
+	}
+
+	t.Run("invalid regex matches remaining text as substring", func(t *testing.T) {
+		m := newFilterTestModel()
+		m.worktrees = append(m.worktrees, WorktreeInfo{Path: "/repo/wt/fix(1)", Branch: "fix(1)"})
+		m.filterText = "/fix("
+		m.applyFilter()
+
+		assert.True(t, m.filterInvalid)
+		assert.Equal(t, []int{4}, m.filteredIndices)
+		assert.Contains(t, m.View(), "invalid regex")
+	})
+}
+
+func TestWorktreesModel_FilterShrinksCursor(t *testing.T) {
+	m := newFilterTestModel()
+	m.cursor = 3
+
+	m.filterText = "/^feature/"
+	m.applyFilter()
+	assert.Equal(t, 1, m.cursor)
+	assert.Equal(t, "/repo/wt/feature-api", m.getCurrentWorktree().Path)
+
+	m.filterText = "/^release"
+	m.applyFilter()
+	assert.Equal(t, 0, m.cursor)
+	assert.Empty(t, m.getVisibleIndices())
+	assert.Nil(t, m.getCurrentWorktree())
+	assert.Contains(t, m.View(), "No worktrees match filter")
+}
+
+func TestConfigModel_Reload(t *testing.T) {
+	dir := t.TempDir()
+	global := filepath.Join(dir, "config.yaml")
+	require.NoError(t, config.Save(config.DefaultConfig(), global))
+	path := filepath.Join(dir, "override.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("log_level: info\n"), 0600))
+
+	cfg, err := config.LoadLayered(global, "", path)
+	require.NoError(t, err)
+
+	m := NewConfigModel(cfg, DefaultTheme())
+	m.width = 100
+	m.height = 40
+	assert.Equal(t, path, m.configPath, "the --config file is the one edited")
+
+	// reload runs the command the 'r' key returns and feeds its message back
+	reload := func() tea.Cmd {
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+		require.NotNil(t, cmd)
+		_, cmd = m.Update(cmd())
+		return cmd
+	}
+
+	t.Run("valid change", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("log_level: debug\n"), 0600))
+
+		assert.Nil(t, reload())
+		assert.Equal(t, "debug", m.config.LogLevel)
+		assert.Equal(t, path, m.config.ConfigFile)
+		assert.Contains(t, m.View(), "Log Level: debug")
+		assert.Contains(t, m.View(), "Configuration reloaded")
+	})
+
+	t.Run("invalid file keeps previous config", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("log_level: [unclosed\n"), 0600))
+
+		cmd := reload()
+		require.NotNil(t, cmd)
+		msg, ok := cmd().(ShowErrorMsg)
+		require.True(t, ok)
+		assert.Equal(t, "Invalid Configuration", msg.Title)
+		assert.Equal(t, "debug", m.config.LogLevel)
+		assert.Contains(t, m.View(), "Reload failed")
+	})
+}
+
+func TestConfigModel_EditFailureShowsError(t *testing.T) {
+	m := NewConfigModel(config.DefaultConfig(), DefaultTheme())
+
+	_, cmd := m.Update(configEditedMsg{Err: errors.New("editor not found")})
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(ShowErrorMsg)
+	require.True(t, ok)
+	assert.Contains(t, msg.Message, "editor not found")
+}
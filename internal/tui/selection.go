@@ -0,0 +1,63 @@
+package tui
+
+import "sort"
+
+// selection tracks the items picked in a screen's multi-selection mode by
+// their index in the screen's item list
+type selection struct {
+	selectedItems map[int]bool // multi-selection state
+	selectionMode bool         // toggle selection mode
+}
+
+func newSelection() selection {
+	return selection{selectedItems: make(map[int]bool)}
+}
+
+// toggleSelectionMode toggles between single and multi-selection mode
+func (s *selection) toggleSelectionMode() {
+	s.selectionMode = !s.selectionMode
+	if !s.selectionMode {
+		// Clear all selections when exiting selection mode
+		s.selectedItems = make(map[int]bool)
+	}
+}
+
+// toggleSelected toggles the selection state of the item at index
+func (s *selection) toggleSelected(index int) {
+	if !s.selectionMode {
+		return
+	}
+	s.selectedItems[index] = !s.selectedItems[index]
+}
+
+// toggleSelectAllOf selects all of indices, or deselects them when they are
+// all selected already
+func (s *selection) toggleSelectAllOf(indices []int) {
+	if !s.selectionMode {
+		return
+	}
+
+	allSelected := true
+	for _, idx := range indices {
+		if !s.selectedItems[idx] {
+			allSelected = false
+			break
+		}
+	}
+
+	for _, idx := range indices {
+		s.selectedItems[idx] = !allSelected
+	}
+}
+
+// selectedIndices returns the selected indices below n in ascending order
+func (s *selection) selectedIndices(n int) []int {
+	var indices []int
+	for idx, isSelected := range s.selectedItems {
+		if isSelected && idx < n {
+			indices = append(indices, idx)
+		}
+	}
+	sort.Ints(indices)
+	return indices
+}
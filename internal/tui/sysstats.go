@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"bufio"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// processSampler measures the CPU usage of this process between samples
+type processSampler struct {
+	mu         sync.Mutex
+	lastSample time.Time
+	lastCPU    time.Duration
+	cpuPercent float64
+}
+
+// sampleCPU returns the CPU used by this process since the previous call as
+// a percentage of one core. The first call only records a baseline and
+// returns 0.
+func (s *processSampler) sampleCPU(now time.Time) float64 {
+	cpu, err := processCPUTime()
+	if err != nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.lastSample.IsZero() {
+		if wall := now.Sub(s.lastSample); wall > 0 {
+			s.cpuPercent = float64(cpu-s.lastCPU) / float64(wall) * 100
+		}
+	}
+	s.lastSample = now
+	s.lastCPU = cpu
+
+	return s.cpuPercent
+}
+
+// processCPUTime returns the user and system CPU time consumed so far
+func processCPUTime() (time.Duration, error) {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0, err
+	}
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano()), nil
+}
+
+// processMemoryStats reports the memory this process obtained from the OS
+// against the host total. TotalMB and Percentage are 0 where the host total
+// cannot be read.
+func processMemoryStats() MemoryStats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	stats := MemoryStats{UsedMB: int(ms.Sys / (1024 * 1024))}
+	if total := hostMemoryTotalMB(); total > 0 {
+		stats.TotalMB = total
+		stats.Percentage = float64(stats.UsedMB) / float64(total) * 100
+	}
+	return stats
+}
+
+// hostMemoryTotalMB reads the host memory size from /proc/meminfo, returning
+// 0 on platforms without it
+func hostMemoryTotalMB() int {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return 0
+			}
+			return kb / 1024
+		}
+	}
+	return 0
+}
+
+// hostLoadAverage returns the one minute load average from /proc/loadavg,
+// or 0 on platforms without it
+func hostLoadAverage() float64 {
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return 0
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return 0
+	}
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	return load
+}
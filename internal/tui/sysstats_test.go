@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// burnCPU keeps the current goroutine busy for d
+func burnCPU(d time.Duration) int {
+	n := 0
+	for start := time.Now(); time.Since(start) < d; {
+		n++
+	}
+	return n
+}
+
+func TestProcessSampler_SampleCPU(t *testing.T) {
+	var sampler processSampler
+
+	now := time.Now()
+	assert.Equal(t, 0.0, sampler.sampleCPU(now), "first sample only records a baseline")
+
+	burnCPU(50 * time.Millisecond)
+	busy := sampler.sampleCPU(time.Now())
+	assert.Greater(t, busy, 0.0)
+
+	cpuBefore, err := processCPUTime()
+	assert.NoError(t, err)
+	burnCPU(10 * time.Millisecond)
+	cpuAfter, err := processCPUTime()
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, cpuAfter, cpuBefore, "process CPU time never decreases")
+}
+
+func TestProcessMemoryStats(t *testing.T) {
+	stats := processMemoryStats()
+	assert.Greater(t, stats.UsedMB, 0)
+
+	if runtime.GOOS == "linux" {
+		assert.Greater(t, stats.TotalMB, stats.UsedMB)
+		assert.Greater(t, stats.Percentage, 0.0)
+		assert.Less(t, stats.Percentage, 100.0)
+	}
+
+	// Holding more memory never reports less
+	hold := make([]byte, 32*1024*1024)
+	for i := range hold {
+		hold[i] = 1
+	}
+	assert.GreaterOrEqual(t, processMemoryStats().UsedMB, stats.UsedMB)
+	runtime.KeepAlive(hold)
+}
+
+func TestIntegration_PerformanceStatsHonourMonitoring(t *testing.T) {
+	cfg := config.DefaultConfig()
+	integration := &Integration{config: cfg, lastRefresh: time.Now().Add(-20 * time.Millisecond)}
+
+	integration.getPerformanceStats() // baseline
+	burnCPU(30 * time.Millisecond)
+	stats := integration.getPerformanceStats()
+	assert.Greater(t, stats.CPUPercent, 0.0)
+	assert.GreaterOrEqual(t, stats.ResponseTime, 20*time.Millisecond)
+	assert.Greater(t, integration.getMemoryStats().UsedMB, 0)
+
+	cfg.Analytics.Performance.EnableMonitoring = false
+	stats = integration.getPerformanceStats()
+	assert.Equal(t, 0.0, stats.CPUPercent)
+	assert.Equal(t, 0.0, stats.LoadAverage)
+	assert.Equal(t, MemoryStats{}, integration.getMemoryStats())
+}
@@ -0,0 +1,214 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// DefaultThemeName is the theme used when none, or an unknown one, is
+// configured
+const DefaultThemeName = "default"
+
+// themePalette holds the colors a Theme is built from
+type themePalette struct {
+	Primary    string
+	Secondary  string
+	Accent     string
+	Background string
+	Surface    string // background of the status line
+	Text       string
+	Muted      string
+	Success    string
+	Warning    string
+	Error      string
+	Info       string
+}
+
+// themePalettes are the named themes selectable with tui.theme
+var themePalettes = map[string]themePalette{
+	"default": {
+		Primary:    "#646CFF",
+		Secondary:  "#747BFF",
+		Accent:     "#42A5F5",
+		Background: "#1E1E2E",
+		Surface:    "#313244",
+		Text:       "#CDD6F4",
+		Muted:      "#6C7086",
+		Success:    "#A6E3A1",
+		Warning:    "#F9E2AF",
+		Error:      "#F38BA8",
+		Info:       "#89B4FA",
+	},
+	"light": {
+		Primary:    "#1E66F5",
+		Secondary:  "#7287FD",
+		Accent:     "#209FB5",
+		Background: "#EFF1F5",
+		Surface:    "#CCD0DA",
+		Text:       "#4C4F69",
+		Muted:      "#8C8FA1",
+		Success:    "#40A02B",
+		Warning:    "#DF8E1D",
+		Error:      "#D20F39",
+		Info:       "#04A5E5",
+	},
+	"high-contrast": {
+		Primary:    "#FFFF00",
+		Secondary:  "#00FFFF",
+		Accent:     "#FF00FF",
+		Background: "#000000",
+		Surface:    "#303030",
+		Text:       "#FFFFFF",
+		Muted:      "#C0C0C0",
+		Success:    "#00FF00",
+		Warning:    "#FFA500",
+		Error:      "#FF0000",
+		Info:       "#00BFFF",
+	},
+	"dracula": {
+		Primary:    "#BD93F9",
+		Secondary:  "#FF79C6",
+		Accent:     "#8BE9FD",
+		Background: "#282A36",
+		Surface:    "#44475A",
+		Text:       "#F8F8F2",
+		Muted:      "#6272A4",
+		Success:    "#50FA7B",
+		Warning:    "#F1FA8C",
+		Error:      "#FF5555",
+		Info:       "#8BE9FD",
+	},
+}
+
+// ThemeNames returns the names of the built-in themes in sorted order
+func ThemeNames() []string {
+	names := make([]string, 0, len(themePalettes))
+	for name := range themePalettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultTheme returns the default color theme
+func DefaultTheme() Theme {
+	return newTheme(themePalettes[DefaultThemeName])
+}
+
+// ThemeFromConfig returns the theme named by cfg.Theme with the colors in
+// cfg.ThemeColors applied on top. An unknown theme name falls back to the
+// default theme; the theme is still returned along with an error describing
+// the fallback so callers can warn about it.
+func ThemeFromConfig(cfg *config.TUIConfig) (Theme, error) {
+	if cfg == nil {
+		return DefaultTheme(), nil
+	}
+
+	var err error
+	name := cfg.Theme
+	if name == "" {
+		name = DefaultThemeName
+	}
+	palette, ok := themePalettes[name]
+	if !ok {
+		err = fmt.Errorf("unknown theme %q, using %q; available themes: %s",
+			cfg.Theme, DefaultThemeName, strings.Join(ThemeNames(), ", "))
+		palette = themePalettes[DefaultThemeName]
+	}
+
+	return newTheme(palette.withColors(cfg.ThemeColors)), err
+}
+
+// withColors returns the palette with every color set in colors replaced
+func (p themePalette) withColors(colors config.TUIThemeColors) themePalette {
+	override := func(color *string, value string) {
+		if value != "" {
+			*color = value
+		}
+	}
+
+	override(&p.Primary, colors.Primary)
+	override(&p.Secondary, colors.Secondary)
+	override(&p.Accent, colors.Accent)
+	override(&p.Background, colors.Background)
+	override(&p.Text, colors.Text)
+	override(&p.Muted, colors.Muted)
+	override(&p.Success, colors.Success)
+	override(&p.Warning, colors.Warning)
+	override(&p.Error, colors.Error)
+	override(&p.Info, colors.Info)
+	return p
+}
+
+// newTheme builds the colors and styles of a Theme from a palette
+func newTheme(p themePalette) Theme {
+	return Theme{
+		Primary:    lipgloss.Color(p.Primary),
+		Secondary:  lipgloss.Color(p.Secondary),
+		Accent:     lipgloss.Color(p.Accent),
+		Background: lipgloss.Color(p.Background),
+		Text:       lipgloss.Color(p.Text),
+		Muted:      lipgloss.Color(p.Muted),
+		Success:    lipgloss.Color(p.Success),
+		Warning:    lipgloss.Color(p.Warning),
+		Error:      lipgloss.Color(p.Error),
+		Info:       lipgloss.Color(p.Info),
+
+		BorderStyle: lipgloss.RoundedBorder(),
+
+		TitleStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Primary)).
+			Bold(true).
+			Padding(0, 1),
+
+		HeaderStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Text)).
+			Bold(true).
+			Padding(0, 1),
+
+		ContentStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Text)).
+			Padding(1),
+
+		FooterStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Muted)).
+			Padding(0, 1),
+
+		SelectedStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Background)).
+			Background(lipgloss.Color(p.Primary)).
+			Bold(true),
+
+		StatusStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Text)).
+			Background(lipgloss.Color(p.Surface)).
+			Padding(0, 1),
+
+		LabelStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Text)).
+			Bold(false),
+
+		FocusedStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Primary)).
+			Bold(true),
+
+		MutedStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Muted)),
+
+		SuccessStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Success)).
+			Bold(true),
+
+		ErrorStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Error)).
+			Bold(true),
+
+		WarningStyle: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(p.Warning)).
+			Bold(true),
+	}
+}
@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"context"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/unbracketed/ccmgr-ultra/internal/config"
+)
+
+// themeColors lists the colors of a theme in field order
+func themeColors(theme Theme) []lipgloss.Color {
+	return []lipgloss.Color{
+		theme.Primary, theme.Secondary, theme.Accent, theme.Background, theme.Text,
+		theme.Muted, theme.Success, theme.Warning, theme.Error, theme.Info,
+	}
+}
+
+func TestThemeFromConfig_NamedThemes(t *testing.T) {
+	assert.Equal(t, []string{"default", "dracula", "high-contrast", "light"}, ThemeNames())
+
+	seen := make(map[string]string)
+	for _, name := range ThemeNames() {
+		theme, err := ThemeFromConfig(&config.TUIConfig{Theme: name})
+		require.NoError(t, err, name)
+
+		for i, color := range themeColors(theme) {
+			assert.NotEmpty(t, color, "%s color %d", name, i)
+		}
+		assert.NotEqual(t, theme.Text, theme.Background, "%s text is readable", name)
+
+		key := string(theme.Primary) + string(theme.Background) + string(theme.Text)
+		if other, ok := seen[key]; ok {
+			t.Errorf("themes %s and %s have the same colors", name, other)
+		}
+		seen[key] = name
+	}
+}
+
+func TestThemeFromConfig_DefaultMatchesDefaultTheme(t *testing.T) {
+	for _, name := range []string{"", "default"} {
+		theme, err := ThemeFromConfig(&config.TUIConfig{Theme: name})
+		require.NoError(t, err)
+		assert.Equal(t, themeColors(DefaultTheme()), themeColors(theme))
+	}
+
+	theme, err := ThemeFromConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, themeColors(DefaultTheme()), themeColors(theme))
+}
+
+func TestThemeFromConfig_UnknownFallsBack(t *testing.T) {
+	theme, err := ThemeFromConfig(&config.TUIConfig{Theme: "solarized"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown theme "solarized"`)
+	assert.Contains(t, err.Error(), "dracula")
+	assert.Equal(t, themeColors(DefaultTheme()), themeColors(theme))
+}
+
+func TestThemeFromConfig_InlineColors(t *testing.T) {
+	theme, err := ThemeFromConfig(&config.TUIConfig{
+		Theme: "dracula",
+		ThemeColors: config.TUIThemeColors{
+			Primary: "#112233",
+			Error:   "#abc",
+		},
+	})
+	require.NoError(t, err)
+
+	dracula, err := ThemeFromConfig(&config.TUIConfig{Theme: "dracula"})
+	require.NoError(t, err)
+
+	assert.Equal(t, lipgloss.Color("#112233"), theme.Primary)
+	assert.Equal(t, lipgloss.Color("#abc"), theme.Error)
+	assert.Equal(t, dracula.Background, theme.Background, "unset colors keep the theme's")
+	assert.Equal(t, lipgloss.Color("#112233"), theme.TitleStyle.GetForeground(), "styles use the overridden colors")
+}
+
+func TestNewAppModel_Theme(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Theme = "light"
+
+	app, err := NewAppModel(context.Background(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, lipgloss.Color("#1E66F5"), app.theme.Primary)
+	assert.NoError(t, app.themeWarning)
+
+	cfg.TUI.Theme = "no-such-theme"
+	app, err = NewAppModel(context.Background(), cfg)
+	require.NoError(t, err, "an unknown theme is not fatal")
+	assert.Equal(t, DefaultTheme().Primary, app.theme.Primary)
+	assert.Error(t, app.themeWarning)
+}
@@ -28,9 +28,11 @@ func (f *WorkflowFactory) CreateSessionWizard() *workflows.SessionCreationWizard
 
 // CreateWorktreeWizard creates a worktree creation wizard
 func (f *WorkflowFactory) CreateWorktreeWizard() *workflows.WorktreeCreationWizard {
-	// Note: This would need to be implemented in workflows/worktrees.go
-	// For now, we'll return nil and handle this case
-	return nil
+	integration, ok := f.integration.(workflows.WorktreeIntegration)
+	if !ok {
+		return nil
+	}
+	return workflows.NewWorktreeCreationWizard(integration, f.theme)
 }
 
 // CreateSingleWorktreeSessionWizard creates session wizard for specific worktree
@@ -55,6 +55,7 @@ type ClaudeConfig struct {
 // SessionConfig represents the configuration for creating a new session
 type SessionConfig struct {
 	Name         string
+	ProjectName  string
 	ProjectPath  string
 	WorktreePath string
 	Branch       string
@@ -254,6 +255,10 @@ func (s *ProjectSelectionStep) loadData() {
 }
 
 func (s *ProjectSelectionStep) HandleKey(msg tea.KeyMsg, data map[string]interface{}) (map[string]interface{}, tea.Cmd, error) {
+	if !s.loaded {
+		s.loadData()
+	}
+
 	switch msg.String() {
 	case "tab":
 		if s.selectedType == "project" && len(s.worktrees) > 0 {
@@ -614,7 +619,7 @@ func (s *ConfirmationStep) Render(theme modals.Theme, width int, data map[string
 	confirmStyle := lipgloss.NewStyle().
 		Foreground(theme.Success).
 		Bold(true)
-	elements = append(elements, confirmStyle.Render("Press Ctrl+Enter to create session"))
+	elements = append(elements, confirmStyle.Render("Press Ctrl+N to create session"))
 
 	return strings.Join(elements, "\n")
 }
@@ -2,7 +2,6 @@ package workflows
 
 import (
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -20,11 +19,10 @@ type WorktreeCreationWizard struct {
 type WorktreeIntegration interface {
 	GetAvailableRepositories() ([]RepositoryInfo, error)
 	GetBranches(repoPath string) ([]BranchInfo, error)
-	GetDefaultWorktreeDir(repoPath string) (string, error)
+	GetDefaultWorktreePath(repoPath, branchName string) (string, error)
 	ValidateBranchName(name string) error
-	ValidateWorktreePath(path string) error
+	ValidateNewWorktreePath(path string) error
 	CreateWorktree(config WorktreeConfig) error
-	CheckRemoteExists(repoPath, branchName string) (bool, error)
 }
 
 // RepositoryInfo represents a Git repository
@@ -176,6 +174,10 @@ func (s *RepositorySelectionStep) loadRepositories() {
 }
 
 func (s *RepositorySelectionStep) HandleKey(msg tea.KeyMsg, data map[string]interface{}) (map[string]interface{}, tea.Cmd, error) {
+	if !s.loaded {
+		s.loadRepositories()
+	}
+
 	switch msg.String() {
 	case "up", "k":
 		if s.selectedIndex > 0 {
@@ -407,6 +409,10 @@ func (s *BranchSelectionStep) loadBranches(data map[string]interface{}) {
 }
 
 func (s *BranchSelectionStep) HandleKey(msg tea.KeyMsg, data map[string]interface{}) (map[string]interface{}, tea.Cmd, error) {
+	if !s.loaded {
+		s.loadBranches(data)
+	}
+
 	switch msg.String() {
 	case "tab":
 		if s.mode == "existing" {
@@ -478,12 +484,13 @@ func (s *BranchSelectionStep) IsComplete(data map[string]interface{}) bool {
 
 // WorktreePathStep handles worktree directory selection
 type WorktreePathStep struct {
-	wizard        *WorktreeCreationWizard
-	path          string
-	defaultPath   string
-	pathLoaded    bool
-	createSession bool
-	sessionName   string
+	wizard         *WorktreeCreationWizard
+	path           string
+	defaultPath    string
+	defaultPathErr error
+	branchName     string // branch the default path was resolved for
+	createSession  bool
+	sessionName    string
 }
 
 func (s *WorktreePathStep) Title() string {
@@ -495,9 +502,7 @@ func (s *WorktreePathStep) Description() string {
 }
 
 func (s *WorktreePathStep) Render(theme modals.Theme, width int, data map[string]interface{}) string {
-	if !s.pathLoaded {
-		s.loadDefaultPath(data)
-	}
+	s.loadDefaultPath(data)
 
 	var elements []string
 
@@ -518,6 +523,10 @@ func (s *WorktreePathStep) Render(theme modals.Theme, width int, data map[string
 
 	pathField := pathStyle.Render(displayPath + "│")
 	elements = append(elements, pathField)
+	if s.path == "" && s.defaultPathErr != nil {
+		errorStyle := lipgloss.NewStyle().Foreground(theme.Error)
+		elements = append(elements, errorStyle.Render("Default path unavailable: "+s.defaultPathErr.Error()))
+	}
 	elements = append(elements, "")
 
 	// Session creation option
@@ -563,20 +572,20 @@ func (s *WorktreePathStep) Render(theme modals.Theme, width int, data map[string
 	return strings.Join(elements, "\n")
 }
 
+// loadDefaultPath resolves the default worktree path for the selected
+// branch, again whenever a different branch is selected
 func (s *WorktreePathStep) loadDefaultPath(data map[string]interface{}) {
-	s.pathLoaded = true
-
 	repoPath, _ := data["repository_path"].(string)
 	branchName, _ := data["branch_name"].(string)
 
-	if repoPath != "" {
-		if defaultDir, err := s.wizard.integration.GetDefaultWorktreeDir(repoPath); err == nil {
-			if branchName != "" {
-				s.defaultPath = filepath.Join(defaultDir, branchName)
-			} else {
-				s.defaultPath = defaultDir
-			}
-		}
+	if branchName == s.branchName {
+		return
+	}
+	s.branchName = branchName
+
+	s.defaultPath, s.defaultPathErr = "", nil
+	if repoPath != "" && branchName != "" {
+		s.defaultPath, s.defaultPathErr = s.wizard.integration.GetDefaultWorktreePath(repoPath, branchName)
 	}
 
 	// Set default session name
@@ -586,6 +595,8 @@ func (s *WorktreePathStep) loadDefaultPath(data map[string]interface{}) {
 }
 
 func (s *WorktreePathStep) HandleKey(msg tea.KeyMsg, data map[string]interface{}) (map[string]interface{}, tea.Cmd, error) {
+	s.loadDefaultPath(data)
+
 	switch msg.String() {
 	case " ":
 		s.createSession = !s.createSession
@@ -608,20 +619,20 @@ func (s *WorktreePathStep) HandleKey(msg tea.KeyMsg, data map[string]interface{}
 	}
 
 	data["worktree_path"] = finalPath
+	data["auto_path"] = s.path == ""
 	data["create_session"] = s.createSession
 	data["session_name"] = s.sessionName
 
 	return data, nil, nil
 }
 
+// Validate checks a typed path. Without one the path comes from
+// worktree.directory_pattern when the worktree is created, and problems with
+// the pattern are reported then.
 func (s *WorktreePathStep) Validate(data map[string]interface{}) error {
-	path, ok := data["worktree_path"].(string)
-	if !ok || path == "" {
-		return fmt.Errorf("worktree path is required")
-	}
-
-	if err := s.wizard.integration.ValidateWorktreePath(path); err != nil {
-		return err
+	if auto, ok := data["auto_path"].(bool); ok && !auto {
+		path, _ := data["worktree_path"].(string)
+		return s.wizard.integration.ValidateNewWorktreePath(path)
 	}
 
 	return nil
@@ -669,8 +680,10 @@ func (s *WorktreeConfirmationStep) Render(theme modals.Theme, width int, data ma
 	}
 
 	// Path
-	if path, ok := data["worktree_path"].(string); ok {
+	if path, ok := data["worktree_path"].(string); ok && path != "" {
 		elements = append(elements, fmt.Sprintf("Path: %s", path))
+	} else {
+		elements = append(elements, "Path: from worktree.directory_pattern")
 	}
 
 	// Session
@@ -688,7 +701,7 @@ func (s *WorktreeConfirmationStep) Render(theme modals.Theme, width int, data ma
 	confirmStyle := lipgloss.NewStyle().
 		Foreground(theme.Success).
 		Bold(true)
-	elements = append(elements, confirmStyle.Render("Press Ctrl+Enter to create worktree"))
+	elements = append(elements, confirmStyle.Render("Press Ctrl+N to create worktree"))
 
 	return strings.Join(elements, "\n")
 }
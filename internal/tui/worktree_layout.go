@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Worktree list column widths. The prefix holds the cursor, selection box
+// and Claude status icon.
+const (
+	worktreeRowPrefixWidth   = 5
+	worktreeSessionsWidth    = 4
+	worktreeGitWidth         = 10
+	worktreeLastAccessWidth  = 12
+	worktreeMinBranchWidth   = 6
+	worktreeMaxBranchWidth   = 32
+	worktreeMinPathWidth     = 8
+	worktreeLastAccessMinRow = 78 // an 80 column terminal less content padding; narrower rows drop last access
+)
+
+// worktreeColumns is the column layout of the worktree list for one width
+type worktreeColumns struct {
+	path       int
+	branch     int
+	lastAccess bool
+}
+
+// layoutWorktreeColumns sizes the worktree list columns for a row of the
+// given width. Fixed columns keep their size; the path and branch share the
+// rest, with the path getting two thirds.
+func layoutWorktreeColumns(width int) worktreeColumns {
+	cols := worktreeColumns{lastAccess: width >= worktreeLastAccessMinRow}
+
+	// path, branch, sessions and git, separated by single spaces
+	flex := width - worktreeRowPrefixWidth - worktreeSessionsWidth - worktreeGitWidth - 3
+	if cols.lastAccess {
+		flex -= worktreeLastAccessWidth + 1
+	}
+
+	cols.branch = min(max(flex/3, worktreeMinBranchWidth), worktreeMaxBranchWidth)
+	cols.path = max(flex-cols.branch, worktreeMinPathWidth)
+	return cols
+}
+
+// formatWorktreeColumns renders the columns of a worktree row after the
+// prefix. Long paths are elided from the left, long branches from the right.
+func formatWorktreeColumns(wt WorktreeInfo, cols worktreeColumns) string {
+	sessions := ""
+	if count := len(wt.ActiveSessions); count > 0 {
+		sessions = fmt.Sprintf("[%d]", count)
+	}
+
+	fields := []string{
+		fitColumn(elidePath(wt.Path, cols.path), cols.path),
+		fitColumn(worktreeBranch(wt), cols.branch),
+		fitColumn(sessions, worktreeSessionsWidth),
+		fitColumn(worktreeGitIndicator(wt.GitStatus), worktreeGitWidth),
+	}
+	if cols.lastAccess {
+		fields = append(fields, fitColumn(wt.LastAccess.Format("Jan 2 15:04"), worktreeLastAccessWidth))
+	}
+
+	return strings.TrimRight(strings.Join(fields, " "), " ")
+}
+
+// worktreeBranch returns the branch shown for wt, marking a detached HEAD
+func worktreeBranch(wt WorktreeInfo) string {
+	if wt.Detached {
+		return "(detached HEAD)"
+	}
+	return wt.Branch
+}
+
+// worktreeGitIndicator summarizes local changes and upstream divergence,
+// e.g. "+3 ↑1↓0"
+func worktreeGitIndicator(status GitWorktreeStatus) string {
+	var parts []string
+	if !status.IsClean {
+		if changes := status.Modified + status.Staged + status.Untracked; changes > 0 {
+			parts = append(parts, fmt.Sprintf("+%d", changes))
+		}
+	}
+	if status.Ahead > 0 || status.Behind > 0 {
+		parts = append(parts, fmt.Sprintf("↑%d↓%d", status.Ahead, status.Behind))
+	}
+	return strings.Join(parts, " ")
+}
+
+// elidePath shortens path to width by dropping leading directories, so the
+// worktree name at the end stays visible, e.g. "…/worktrees/feature-auth"
+func elidePath(path string, width int) string {
+	if lipgloss.Width(path) <= width {
+		return path
+	}
+	if width <= 1 {
+		return "…"
+	}
+
+	parts := strings.Split(path, "/")
+	for i := 1; i < len(parts); i++ {
+		if tail := "…/" + strings.Join(parts[i:], "/"); lipgloss.Width(tail) <= width {
+			return tail
+		}
+	}
+
+	runes := []rune(path)
+	return "…" + string(runes[len(runes)-(width-1):])
+}
+
+// fitColumn pads s to width, truncating it with an ellipsis when too long
+func fitColumn(s string, width int) string {
+	if w := lipgloss.Width(s); w <= width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	if width <= 1 {
+		return strings.Repeat("…", width)
+	}
+	return string([]rune(s)[:width-1]) + "…"
+}
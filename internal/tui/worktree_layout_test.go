@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLayoutTestWorktree() WorktreeInfo {
+	return WorktreeInfo{
+		Path:           "/home/dev/projects/ccmgr-ultra/.worktrees/feature-authentication-flow",
+		Branch:         "feature/authentication-flow",
+		LastAccess:     time.Date(2025, 3, 4, 15, 4, 0, 0, time.UTC),
+		ActiveSessions: []SessionSummary{{ID: "a"}, {ID: "b"}},
+		GitStatus:      GitWorktreeStatus{Modified: 2, Staged: 1, Ahead: 2, Behind: 1},
+	}
+}
+
+func TestFormatWorktreeColumns_Golden(t *testing.T) {
+	tests := []struct {
+		width    int
+		expected string
+	}{
+		{60, "…ure-authentication-flow feature/aut… [2]  +3 ↑2↓1"},
+		{100, "…/.worktrees/feature-authentication-flow   feature/authenticati… [2]  +3 ↑2↓1    Mar 4 15:04"},
+		{160, "/home/dev/projects/ccmgr-ultra/.worktrees/feature-authentication-flow                       feature/authentication-flow      [2]  +3 ↑2↓1    Mar 4 15:04"},
+	}
+
+	for _, tt := range tests {
+		m := NewWorktreesModel(nil, DefaultTheme())
+		m.width = tt.width
+		m.height = 40
+		m.worktrees = []WorktreeInfo{newLayoutTestWorktree()}
+
+		cols := layoutWorktreeColumns(tt.width - m.theme.ContentStyle.GetHorizontalFrameSize())
+		assert.Equal(t, tt.expected, formatWorktreeColumns(m.worktrees[0], cols), "width %d", tt.width)
+
+		view := m.View()
+		assert.Contains(t, view, tt.expected, "width %d", tt.width)
+		// Rows are padded to the widest line of the view, so compare the
+		// rendered row without trailing padding
+		for _, line := range strings.Split(view, "\n") {
+			if strings.Contains(line, tt.expected) {
+				row := strings.TrimRight(line, " ")
+				assert.LessOrEqual(t, lipgloss.Width(row), tt.width, "width %d: %q", tt.width, row)
+			}
+		}
+	}
+}
+
+func TestFormatWorktreeColumns_Detached(t *testing.T) {
+	wt := newLayoutTestWorktree()
+	wt.Branch = ""
+	wt.Detached = true
+
+	row := formatWorktreeColumns(wt, layoutWorktreeColumns(160))
+	assert.Contains(t, row, "(detached HEAD)")
+}
+
+func TestLayoutWorktreeColumns_DropsLastAccessWhenNarrow(t *testing.T) {
+	assert.False(t, layoutWorktreeColumns(77).lastAccess)
+	assert.True(t, layoutWorktreeColumns(78).lastAccess)
+
+	cols := layoutWorktreeColumns(20)
+	assert.Equal(t, worktreeMinPathWidth, cols.path)
+	assert.Equal(t, worktreeMinBranchWidth, cols.branch)
+}
+
+func TestElidePath(t *testing.T) {
+	assert.Equal(t, "/repo/wt/feature", elidePath("/repo/wt/feature", 16))
+	assert.Equal(t, "…/wt/feature", elidePath("/repo/wt/feature", 15))
+	assert.Equal(t, "…/feature", elidePath("/repo/wt/feature", 10))
+	assert.Equal(t, "…ature", elidePath("/repo/wt/feature", 6))
+	assert.Equal(t, "…", elidePath("/repo/wt/feature", 1))
+}
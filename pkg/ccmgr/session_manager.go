@@ -2,6 +2,7 @@ package ccmgr
 
 import (
 	"github.com/unbracketed/ccmgr-ultra/internal/tui"
+	"github.com/unbracketed/ccmgr-ultra/internal/tui/workflows"
 )
 
 // sessionManager implements the SessionManager interface
@@ -33,7 +34,7 @@ func (sm *sessionManager) Active() ([]SessionInfo, error) {
 func (sm *sessionManager) Create(name, directory string) (string, error) {
 	// Use the integration layer's CreateSession method
 	// Note: This is simplified - in a real implementation, we'd handle the tea.Cmd properly
-	_ = sm.integration.CreateSession(name, directory)
+	_ = sm.integration.CreateSession(workflows.SessionConfig{Name: name, WorktreePath: directory})
 	return name, nil
 }
 
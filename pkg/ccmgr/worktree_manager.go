@@ -1,6 +1,7 @@
 package ccmgr
 
 import (
+	"github.com/unbracketed/ccmgr-ultra/internal/git"
 	"github.com/unbracketed/ccmgr-ultra/internal/tui"
 )
 
@@ -32,7 +33,12 @@ func (wm *worktreeManager) Recent() ([]WorktreeInfo, error) {
 // Create creates a new worktree
 func (wm *worktreeManager) Create(path, branch string) error {
 	// Use the integration layer's CreateWorktree method
-	_ = wm.integration.CreateWorktree(path, branch)
+	_ = wm.integration.CreateWorktree(git.WorktreeOptions{
+		Path:         path,
+		Branch:       branch,
+		CreateBranch: true,
+		Checkout:     true,
+	})
 	return nil
 }
 